@@ -65,11 +65,376 @@ func (x *_GenesisState_2_list) IsValid() bool {
 	return x.list != nil
 }
 
+var _ protoreflect.List = (*_GenesisState_4_list)(nil)
+
+type _GenesisState_4_list struct {
+	list *[]*ResourceRevision
+}
+
+func (x *_GenesisState_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ResourceRevision)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ResourceRevision)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_4_list) AppendMutable() protoreflect.Value {
+	v := new(ResourceRevision)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_4_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_4_list) NewElement() protoreflect.Value {
+	v := new(ResourceRevision)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_4_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_5_list)(nil)
+
+type _GenesisState_5_list struct {
+	list *[]*ResourceTransferOffer
+}
+
+func (x *_GenesisState_5_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_5_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_5_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ResourceTransferOffer)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_5_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ResourceTransferOffer)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_5_list) AppendMutable() protoreflect.Value {
+	v := new(ResourceTransferOffer)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_5_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_5_list) NewElement() protoreflect.Value {
+	v := new(ResourceTransferOffer)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_5_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_6_list)(nil)
+
+type _GenesisState_6_list struct {
+	list *[]*ResourceRoleGrant
+}
+
+func (x *_GenesisState_6_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_6_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_6_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ResourceRoleGrant)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_6_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ResourceRoleGrant)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_6_list) AppendMutable() protoreflect.Value {
+	v := new(ResourceRoleGrant)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_6_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_6_list) NewElement() protoreflect.Value {
+	v := new(ResourceRoleGrant)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_6_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_7_list)(nil)
+
+type _GenesisState_7_list struct {
+	list *[]*ResourceSchema
+}
+
+func (x *_GenesisState_7_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_7_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_7_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ResourceSchema)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_7_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ResourceSchema)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_7_list) AppendMutable() protoreflect.Value {
+	v := new(ResourceSchema)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_7_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_7_list) NewElement() protoreflect.Value {
+	v := new(ResourceSchema)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_7_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_8_list)(nil)
+
+type _GenesisState_8_list struct {
+	list *[]*Namespace
+}
+
+func (x *_GenesisState_8_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_8_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_8_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Namespace)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_8_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Namespace)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_8_list) AppendMutable() protoreflect.Value {
+	v := new(Namespace)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_8_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_8_list) NewElement() protoreflect.Value {
+	v := new(Namespace)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_8_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_10_list)(nil)
+
+type _GenesisState_10_list struct {
+	list *[]*ResourceReplication
+}
+
+func (x *_GenesisState_10_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_10_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_10_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ResourceReplication)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_10_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ResourceReplication)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_10_list) AppendMutable() protoreflect.Value {
+	v := new(ResourceReplication)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_10_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_10_list) NewElement() protoreflect.Value {
+	v := new(ResourceReplication)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_10_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_11_list)(nil)
+
+type _GenesisState_11_list struct {
+	list *[]*ResourceReplica
+}
+
+func (x *_GenesisState_11_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_11_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_11_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ResourceReplica)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_11_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ResourceReplica)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_11_list) AppendMutable() protoreflect.Value {
+	v := new(ResourceReplica)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_11_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_11_list) NewElement() protoreflect.Value {
+	v := new(ResourceReplica)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_11_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_GenesisState               protoreflect.MessageDescriptor
-	fd_GenesisState_params        protoreflect.FieldDescriptor
-	fd_GenesisState_resourceList  protoreflect.FieldDescriptor
-	fd_GenesisState_resourceCount protoreflect.FieldDescriptor
+	md_GenesisState                           protoreflect.MessageDescriptor
+	fd_GenesisState_params                    protoreflect.FieldDescriptor
+	fd_GenesisState_resourceList              protoreflect.FieldDescriptor
+	fd_GenesisState_resourceCount             protoreflect.FieldDescriptor
+	fd_GenesisState_resourceRevisionList      protoreflect.FieldDescriptor
+	fd_GenesisState_resourceTransferOfferList protoreflect.FieldDescriptor
+	fd_GenesisState_resourceRoleGrantList     protoreflect.FieldDescriptor
+	fd_GenesisState_resourceSchemaList        protoreflect.FieldDescriptor
+	fd_GenesisState_namespaceList             protoreflect.FieldDescriptor
+	fd_GenesisState_portId                    protoreflect.FieldDescriptor
+	fd_GenesisState_resourceReplicationList   protoreflect.FieldDescriptor
+	fd_GenesisState_resourceReplicaList       protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -78,6 +443,14 @@ func init() {
 	fd_GenesisState_params = md_GenesisState.Fields().ByName("params")
 	fd_GenesisState_resourceList = md_GenesisState.Fields().ByName("resourceList")
 	fd_GenesisState_resourceCount = md_GenesisState.Fields().ByName("resourceCount")
+	fd_GenesisState_resourceRevisionList = md_GenesisState.Fields().ByName("resourceRevisionList")
+	fd_GenesisState_resourceTransferOfferList = md_GenesisState.Fields().ByName("resourceTransferOfferList")
+	fd_GenesisState_resourceRoleGrantList = md_GenesisState.Fields().ByName("resourceRoleGrantList")
+	fd_GenesisState_resourceSchemaList = md_GenesisState.Fields().ByName("resourceSchemaList")
+	fd_GenesisState_namespaceList = md_GenesisState.Fields().ByName("namespaceList")
+	fd_GenesisState_portId = md_GenesisState.Fields().ByName("portId")
+	fd_GenesisState_resourceReplicationList = md_GenesisState.Fields().ByName("resourceReplicationList")
+	fd_GenesisState_resourceReplicaList = md_GenesisState.Fields().ByName("resourceReplicaList")
 }
 
 var _ protoreflect.Message = (*fastReflection_GenesisState)(nil)
@@ -163,10 +536,58 @@ func (x *fastReflection_GenesisState) Range(f func(protoreflect.FieldDescriptor,
 			return
 		}
 	}
-}
-
-// Has reports whether a field is populated.
-//
+	if len(x.ResourceRevisionList) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_4_list{list: &x.ResourceRevisionList})
+		if !f(fd_GenesisState_resourceRevisionList, value) {
+			return
+		}
+	}
+	if len(x.ResourceTransferOfferList) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_5_list{list: &x.ResourceTransferOfferList})
+		if !f(fd_GenesisState_resourceTransferOfferList, value) {
+			return
+		}
+	}
+	if len(x.ResourceRoleGrantList) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_6_list{list: &x.ResourceRoleGrantList})
+		if !f(fd_GenesisState_resourceRoleGrantList, value) {
+			return
+		}
+	}
+	if len(x.ResourceSchemaList) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_7_list{list: &x.ResourceSchemaList})
+		if !f(fd_GenesisState_resourceSchemaList, value) {
+			return
+		}
+	}
+	if len(x.NamespaceList) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_8_list{list: &x.NamespaceList})
+		if !f(fd_GenesisState_namespaceList, value) {
+			return
+		}
+	}
+	if x.PortId != "" {
+		value := protoreflect.ValueOfString(x.PortId)
+		if !f(fd_GenesisState_portId, value) {
+			return
+		}
+	}
+	if len(x.ResourceReplicationList) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_10_list{list: &x.ResourceReplicationList})
+		if !f(fd_GenesisState_resourceReplicationList, value) {
+			return
+		}
+	}
+	if len(x.ResourceReplicaList) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_11_list{list: &x.ResourceReplicaList})
+		if !f(fd_GenesisState_resourceReplicaList, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
 // Some fields have the property of nullability where it is possible to
 // distinguish between the default value of a field and whether the field
 // was explicitly populated with the default value. Singular message fields,
@@ -184,6 +605,22 @@ func (x *fastReflection_GenesisState) Has(fd protoreflect.FieldDescriptor) bool
 		return len(x.ResourceList) != 0
 	case "crude.crude.GenesisState.resourceCount":
 		return x.ResourceCount != uint64(0)
+	case "crude.crude.GenesisState.resourceRevisionList":
+		return len(x.ResourceRevisionList) != 0
+	case "crude.crude.GenesisState.resourceTransferOfferList":
+		return len(x.ResourceTransferOfferList) != 0
+	case "crude.crude.GenesisState.resourceRoleGrantList":
+		return len(x.ResourceRoleGrantList) != 0
+	case "crude.crude.GenesisState.resourceSchemaList":
+		return len(x.ResourceSchemaList) != 0
+	case "crude.crude.GenesisState.namespaceList":
+		return len(x.NamespaceList) != 0
+	case "crude.crude.GenesisState.portId":
+		return x.PortId != ""
+	case "crude.crude.GenesisState.resourceReplicationList":
+		return len(x.ResourceReplicationList) != 0
+	case "crude.crude.GenesisState.resourceReplicaList":
+		return len(x.ResourceReplicaList) != 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: crude.crude.GenesisState"))
@@ -206,6 +643,22 @@ func (x *fastReflection_GenesisState) Clear(fd protoreflect.FieldDescriptor) {
 		x.ResourceList = nil
 	case "crude.crude.GenesisState.resourceCount":
 		x.ResourceCount = uint64(0)
+	case "crude.crude.GenesisState.resourceRevisionList":
+		x.ResourceRevisionList = nil
+	case "crude.crude.GenesisState.resourceTransferOfferList":
+		x.ResourceTransferOfferList = nil
+	case "crude.crude.GenesisState.resourceRoleGrantList":
+		x.ResourceRoleGrantList = nil
+	case "crude.crude.GenesisState.resourceSchemaList":
+		x.ResourceSchemaList = nil
+	case "crude.crude.GenesisState.namespaceList":
+		x.NamespaceList = nil
+	case "crude.crude.GenesisState.portId":
+		x.PortId = ""
+	case "crude.crude.GenesisState.resourceReplicationList":
+		x.ResourceReplicationList = nil
+	case "crude.crude.GenesisState.resourceReplicaList":
+		x.ResourceReplicaList = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: crude.crude.GenesisState"))
@@ -234,6 +687,51 @@ func (x *fastReflection_GenesisState) Get(descriptor protoreflect.FieldDescripto
 	case "crude.crude.GenesisState.resourceCount":
 		value := x.ResourceCount
 		return protoreflect.ValueOfUint64(value)
+	case "crude.crude.GenesisState.resourceRevisionList":
+		if len(x.ResourceRevisionList) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_4_list{})
+		}
+		listValue := &_GenesisState_4_list{list: &x.ResourceRevisionList}
+		return protoreflect.ValueOfList(listValue)
+	case "crude.crude.GenesisState.resourceTransferOfferList":
+		if len(x.ResourceTransferOfferList) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_5_list{})
+		}
+		listValue := &_GenesisState_5_list{list: &x.ResourceTransferOfferList}
+		return protoreflect.ValueOfList(listValue)
+	case "crude.crude.GenesisState.resourceRoleGrantList":
+		if len(x.ResourceRoleGrantList) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_6_list{})
+		}
+		listValue := &_GenesisState_6_list{list: &x.ResourceRoleGrantList}
+		return protoreflect.ValueOfList(listValue)
+	case "crude.crude.GenesisState.resourceSchemaList":
+		if len(x.ResourceSchemaList) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_7_list{})
+		}
+		listValue := &_GenesisState_7_list{list: &x.ResourceSchemaList}
+		return protoreflect.ValueOfList(listValue)
+	case "crude.crude.GenesisState.namespaceList":
+		if len(x.NamespaceList) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_8_list{})
+		}
+		listValue := &_GenesisState_8_list{list: &x.NamespaceList}
+		return protoreflect.ValueOfList(listValue)
+	case "crude.crude.GenesisState.portId":
+		value := x.PortId
+		return protoreflect.ValueOfString(value)
+	case "crude.crude.GenesisState.resourceReplicationList":
+		if len(x.ResourceReplicationList) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_10_list{})
+		}
+		listValue := &_GenesisState_10_list{list: &x.ResourceReplicationList}
+		return protoreflect.ValueOfList(listValue)
+	case "crude.crude.GenesisState.resourceReplicaList":
+		if len(x.ResourceReplicaList) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_11_list{})
+		}
+		listValue := &_GenesisState_11_list{list: &x.ResourceReplicaList}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: crude.crude.GenesisState"))
@@ -262,6 +760,36 @@ func (x *fastReflection_GenesisState) Set(fd protoreflect.FieldDescriptor, value
 		x.ResourceList = *clv.list
 	case "crude.crude.GenesisState.resourceCount":
 		x.ResourceCount = value.Uint()
+	case "crude.crude.GenesisState.resourceRevisionList":
+		lv := value.List()
+		clv := lv.(*_GenesisState_4_list)
+		x.ResourceRevisionList = *clv.list
+	case "crude.crude.GenesisState.resourceTransferOfferList":
+		lv := value.List()
+		clv := lv.(*_GenesisState_5_list)
+		x.ResourceTransferOfferList = *clv.list
+	case "crude.crude.GenesisState.resourceRoleGrantList":
+		lv := value.List()
+		clv := lv.(*_GenesisState_6_list)
+		x.ResourceRoleGrantList = *clv.list
+	case "crude.crude.GenesisState.resourceSchemaList":
+		lv := value.List()
+		clv := lv.(*_GenesisState_7_list)
+		x.ResourceSchemaList = *clv.list
+	case "crude.crude.GenesisState.namespaceList":
+		lv := value.List()
+		clv := lv.(*_GenesisState_8_list)
+		x.NamespaceList = *clv.list
+	case "crude.crude.GenesisState.portId":
+		x.PortId = value.Interface().(string)
+	case "crude.crude.GenesisState.resourceReplicationList":
+		lv := value.List()
+		clv := lv.(*_GenesisState_10_list)
+		x.ResourceReplicationList = *clv.list
+	case "crude.crude.GenesisState.resourceReplicaList":
+		lv := value.List()
+		clv := lv.(*_GenesisState_11_list)
+		x.ResourceReplicaList = *clv.list
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: crude.crude.GenesisState"))
@@ -293,8 +821,52 @@ func (x *fastReflection_GenesisState) Mutable(fd protoreflect.FieldDescriptor) p
 		}
 		value := &_GenesisState_2_list{list: &x.ResourceList}
 		return protoreflect.ValueOfList(value)
+	case "crude.crude.GenesisState.resourceRevisionList":
+		if x.ResourceRevisionList == nil {
+			x.ResourceRevisionList = []*ResourceRevision{}
+		}
+		value := &_GenesisState_4_list{list: &x.ResourceRevisionList}
+		return protoreflect.ValueOfList(value)
+	case "crude.crude.GenesisState.resourceTransferOfferList":
+		if x.ResourceTransferOfferList == nil {
+			x.ResourceTransferOfferList = []*ResourceTransferOffer{}
+		}
+		value := &_GenesisState_5_list{list: &x.ResourceTransferOfferList}
+		return protoreflect.ValueOfList(value)
+	case "crude.crude.GenesisState.resourceRoleGrantList":
+		if x.ResourceRoleGrantList == nil {
+			x.ResourceRoleGrantList = []*ResourceRoleGrant{}
+		}
+		value := &_GenesisState_6_list{list: &x.ResourceRoleGrantList}
+		return protoreflect.ValueOfList(value)
+	case "crude.crude.GenesisState.resourceSchemaList":
+		if x.ResourceSchemaList == nil {
+			x.ResourceSchemaList = []*ResourceSchema{}
+		}
+		value := &_GenesisState_7_list{list: &x.ResourceSchemaList}
+		return protoreflect.ValueOfList(value)
+	case "crude.crude.GenesisState.namespaceList":
+		if x.NamespaceList == nil {
+			x.NamespaceList = []*Namespace{}
+		}
+		value := &_GenesisState_8_list{list: &x.NamespaceList}
+		return protoreflect.ValueOfList(value)
+	case "crude.crude.GenesisState.resourceReplicationList":
+		if x.ResourceReplicationList == nil {
+			x.ResourceReplicationList = []*ResourceReplication{}
+		}
+		value := &_GenesisState_10_list{list: &x.ResourceReplicationList}
+		return protoreflect.ValueOfList(value)
+	case "crude.crude.GenesisState.resourceReplicaList":
+		if x.ResourceReplicaList == nil {
+			x.ResourceReplicaList = []*ResourceReplica{}
+		}
+		value := &_GenesisState_11_list{list: &x.ResourceReplicaList}
+		return protoreflect.ValueOfList(value)
 	case "crude.crude.GenesisState.resourceCount":
 		panic(fmt.Errorf("field resourceCount of message crude.crude.GenesisState is not mutable"))
+	case "crude.crude.GenesisState.portId":
+		panic(fmt.Errorf("field portId of message crude.crude.GenesisState is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: crude.crude.GenesisState"))
@@ -316,6 +888,29 @@ func (x *fastReflection_GenesisState) NewField(fd protoreflect.FieldDescriptor)
 		return protoreflect.ValueOfList(&_GenesisState_2_list{list: &list})
 	case "crude.crude.GenesisState.resourceCount":
 		return protoreflect.ValueOfUint64(uint64(0))
+	case "crude.crude.GenesisState.resourceRevisionList":
+		list := []*ResourceRevision{}
+		return protoreflect.ValueOfList(&_GenesisState_4_list{list: &list})
+	case "crude.crude.GenesisState.resourceTransferOfferList":
+		list := []*ResourceTransferOffer{}
+		return protoreflect.ValueOfList(&_GenesisState_5_list{list: &list})
+	case "crude.crude.GenesisState.resourceRoleGrantList":
+		list := []*ResourceRoleGrant{}
+		return protoreflect.ValueOfList(&_GenesisState_6_list{list: &list})
+	case "crude.crude.GenesisState.resourceSchemaList":
+		list := []*ResourceSchema{}
+		return protoreflect.ValueOfList(&_GenesisState_7_list{list: &list})
+	case "crude.crude.GenesisState.namespaceList":
+		list := []*Namespace{}
+		return protoreflect.ValueOfList(&_GenesisState_8_list{list: &list})
+	case "crude.crude.GenesisState.portId":
+		return protoreflect.ValueOfString("")
+	case "crude.crude.GenesisState.resourceReplicationList":
+		list := []*ResourceReplication{}
+		return protoreflect.ValueOfList(&_GenesisState_10_list{list: &list})
+	case "crude.crude.GenesisState.resourceReplicaList":
+		list := []*ResourceReplica{}
+		return protoreflect.ValueOfList(&_GenesisState_11_list{list: &list})
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: crude.crude.GenesisState"))
@@ -398,6 +993,52 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 		if x.ResourceCount != 0 {
 			n += 1 + runtime.Sov(uint64(x.ResourceCount))
 		}
+		if len(x.ResourceRevisionList) > 0 {
+			for _, e := range x.ResourceRevisionList {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.ResourceTransferOfferList) > 0 {
+			for _, e := range x.ResourceTransferOfferList {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.ResourceRoleGrantList) > 0 {
+			for _, e := range x.ResourceRoleGrantList {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.ResourceSchemaList) > 0 {
+			for _, e := range x.ResourceSchemaList {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.NamespaceList) > 0 {
+			for _, e := range x.NamespaceList {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		l = len(x.PortId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.ResourceReplicationList) > 0 {
+			for _, e := range x.ResourceReplicationList {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.ResourceReplicaList) > 0 {
+			for _, e := range x.ResourceReplicaList {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -427,6 +1068,125 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.ResourceReplicaList) > 0 {
+			for iNdEx := len(x.ResourceReplicaList) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.ResourceReplicaList[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x5a
+			}
+		}
+		if len(x.ResourceReplicationList) > 0 {
+			for iNdEx := len(x.ResourceReplicationList) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.ResourceReplicationList[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x52
+			}
+		}
+		if len(x.PortId) > 0 {
+			i -= len(x.PortId)
+			copy(dAtA[i:], x.PortId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.PortId)))
+			i--
+			dAtA[i] = 0x4a
+		}
+		if len(x.NamespaceList) > 0 {
+			for iNdEx := len(x.NamespaceList) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.NamespaceList[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x42
+			}
+		}
+		if len(x.ResourceSchemaList) > 0 {
+			for iNdEx := len(x.ResourceSchemaList) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.ResourceSchemaList[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x3a
+			}
+		}
+		if len(x.ResourceRoleGrantList) > 0 {
+			for iNdEx := len(x.ResourceRoleGrantList) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.ResourceRoleGrantList[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x32
+			}
+		}
+		if len(x.ResourceTransferOfferList) > 0 {
+			for iNdEx := len(x.ResourceTransferOfferList) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.ResourceTransferOfferList[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x2a
+			}
+		}
+		if len(x.ResourceRevisionList) > 0 {
+			for iNdEx := len(x.ResourceRevisionList) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.ResourceRevisionList[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x22
+			}
+		}
 		if x.ResourceCount != 0 {
 			i = runtime.EncodeVarint(dAtA, i, uint64(x.ResourceCount))
 			i--
@@ -441,79 +1201,202 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 						Buf:               input.Buf,
 					}, err
 				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0x12
-			}
-		}
-		if x.Params != nil {
-			encoded, err := options.Marshal(x.Params)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-			i--
-			dAtA[i] = 0xa
-		}
-		if input.Buf != nil {
-			input.Buf = append(input.Buf, dAtA...)
-		} else {
-			input.Buf = dAtA
-		}
-		return protoiface.MarshalOutput{
-			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-			Buf:               input.Buf,
-		}, nil
-	}
-	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*GenesisState)
-		if x == nil {
-			return protoiface.UnmarshalOutput{
-				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-				Flags:             input.Flags,
-			}, nil
-		}
-		options := runtime.UnmarshalInputToOptions(input)
-		_ = options
-		dAtA := input.Buf
-		l := len(dAtA)
-		iNdEx := 0
-		for iNdEx < l {
-			preIndex := iNdEx
-			var wire uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
+		if x.Params != nil {
+			encoded, err := options.Marshal(x.Params)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*GenesisState)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Params == nil {
+					x.Params = &Params{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Params); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ResourceList", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ResourceList = append(x.ResourceList, &Resource{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ResourceList[len(x.ResourceList)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ResourceCount", wireType)
+				}
+				x.ResourceCount = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ResourceCount |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ResourceRevisionList", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				if iNdEx >= l {
+				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				wire |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				x.ResourceRevisionList = append(x.ResourceRevisionList, &ResourceRevision{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ResourceRevisionList[len(x.ResourceRevisionList)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-			}
-			fieldNum := int32(wire >> 3)
-			wireType := int(wire & 0x7)
-			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
-			}
-			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
-			}
-			switch fieldNum {
-			case 1:
+				iNdEx = postIndex
+			case 5:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ResourceTransferOfferList", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -540,16 +1423,48 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Params == nil {
-					x.Params = &Params{}
+				x.ResourceTransferOfferList = append(x.ResourceTransferOfferList, &ResourceTransferOffer{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ResourceTransferOfferList[len(x.ResourceTransferOfferList)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Params); err != nil {
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ResourceRoleGrantList", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ResourceRoleGrantList = append(x.ResourceRoleGrantList, &ResourceRoleGrant{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ResourceRoleGrantList[len(x.ResourceRoleGrantList)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 2:
+			case 7:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ResourceList", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ResourceSchemaList", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -576,16 +1491,16 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.ResourceList = append(x.ResourceList, &Resource{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ResourceList[len(x.ResourceList)-1]); err != nil {
+				x.ResourceSchemaList = append(x.ResourceSchemaList, &ResourceSchema{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ResourceSchemaList[len(x.ResourceSchemaList)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 3:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ResourceCount", wireType)
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NamespaceList", wireType)
 				}
-				x.ResourceCount = 0
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -595,11 +1510,126 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.ResourceCount |= uint64(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.NamespaceList = append(x.NamespaceList, &Namespace{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.NamespaceList[len(x.NamespaceList)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 9:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PortId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 10:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ResourceReplicationList", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ResourceReplicationList = append(x.ResourceReplicationList, &ResourceReplication{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ResourceReplicationList[len(x.ResourceReplicationList)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 11:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ResourceReplicaList", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ResourceReplicaList = append(x.ResourceReplicaList, &ResourceReplica{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ResourceReplicaList[len(x.ResourceReplicaList)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -655,9 +1685,17 @@ type GenesisState struct {
 	unknownFields protoimpl.UnknownFields
 
 	// params defines all the parameters of the module.
-	Params        *Params     `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
-	ResourceList  []*Resource `protobuf:"bytes,2,rep,name=resourceList,proto3" json:"resourceList,omitempty"`
-	ResourceCount uint64      `protobuf:"varint,3,opt,name=resourceCount,proto3" json:"resourceCount,omitempty"`
+	Params                    *Params                  `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
+	ResourceList              []*Resource              `protobuf:"bytes,2,rep,name=resourceList,proto3" json:"resourceList,omitempty"`
+	ResourceCount             uint64                   `protobuf:"varint,3,opt,name=resourceCount,proto3" json:"resourceCount,omitempty"`
+	ResourceRevisionList      []*ResourceRevision      `protobuf:"bytes,4,rep,name=resourceRevisionList,proto3" json:"resourceRevisionList,omitempty"`
+	ResourceTransferOfferList []*ResourceTransferOffer `protobuf:"bytes,5,rep,name=resourceTransferOfferList,proto3" json:"resourceTransferOfferList,omitempty"`
+	ResourceRoleGrantList     []*ResourceRoleGrant     `protobuf:"bytes,6,rep,name=resourceRoleGrantList,proto3" json:"resourceRoleGrantList,omitempty"`
+	ResourceSchemaList        []*ResourceSchema        `protobuf:"bytes,7,rep,name=resourceSchemaList,proto3" json:"resourceSchemaList,omitempty"`
+	NamespaceList             []*Namespace             `protobuf:"bytes,8,rep,name=namespaceList,proto3" json:"namespaceList,omitempty"`
+	PortId                    string                   `protobuf:"bytes,9,opt,name=portId,proto3" json:"portId,omitempty"`
+	ResourceReplicationList   []*ResourceReplication   `protobuf:"bytes,10,rep,name=resourceReplicationList,proto3" json:"resourceReplicationList,omitempty"`
+	ResourceReplicaList       []*ResourceReplica       `protobuf:"bytes,11,rep,name=resourceReplicaList,proto3" json:"resourceReplicaList,omitempty"`
 }
 
 func (x *GenesisState) Reset() {
@@ -701,6 +1739,62 @@ func (x *GenesisState) GetResourceCount() uint64 {
 	return 0
 }
 
+func (x *GenesisState) GetResourceRevisionList() []*ResourceRevision {
+	if x != nil {
+		return x.ResourceRevisionList
+	}
+	return nil
+}
+
+func (x *GenesisState) GetResourceTransferOfferList() []*ResourceTransferOffer {
+	if x != nil {
+		return x.ResourceTransferOfferList
+	}
+	return nil
+}
+
+func (x *GenesisState) GetResourceRoleGrantList() []*ResourceRoleGrant {
+	if x != nil {
+		return x.ResourceRoleGrantList
+	}
+	return nil
+}
+
+func (x *GenesisState) GetResourceSchemaList() []*ResourceSchema {
+	if x != nil {
+		return x.ResourceSchemaList
+	}
+	return nil
+}
+
+func (x *GenesisState) GetNamespaceList() []*Namespace {
+	if x != nil {
+		return x.NamespaceList
+	}
+	return nil
+}
+
+func (x *GenesisState) GetPortId() string {
+	if x != nil {
+		return x.PortId
+	}
+	return ""
+}
+
+func (x *GenesisState) GetResourceReplicationList() []*ResourceReplication {
+	if x != nil {
+		return x.ResourceReplicationList
+	}
+	return nil
+}
+
+func (x *GenesisState) GetResourceReplicaList() []*ResourceReplica {
+	if x != nil {
+		return x.ResourceReplicaList
+	}
+	return nil
+}
+
 var File_crude_crude_genesis_proto protoreflect.FileDescriptor
 
 var file_crude_crude_genesis_proto_rawDesc = []byte{
@@ -712,7 +1806,7 @@ var file_crude_crude_genesis_proto_rawDesc = []byte{
 	0x6f, 0x1a, 0x18, 0x63, 0x72, 0x75, 0x64, 0x65, 0x2f, 0x63, 0x72, 0x75, 0x64, 0x65, 0x2f, 0x70,
 	0x61, 0x72, 0x61, 0x6d, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1a, 0x63, 0x72, 0x75,
 	0x64, 0x65, 0x2f, 0x63, 0x72, 0x75, 0x64, 0x65, 0x2f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xad, 0x01, 0x0a, 0x0c, 0x47, 0x65, 0x6e, 0x65,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xb1, 0x06, 0x0a, 0x0c, 0x47, 0x65, 0x6e, 0x65,
 	0x73, 0x69, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x36, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61,
 	0x6d, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x65,
 	0x2e, 0x63, 0x72, 0x75, 0x64, 0x65, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0x09, 0xc8,
@@ -723,16 +1817,56 @@ var file_crude_crude_genesis_proto_rawDesc = []byte{
 	0xde, 0x1f, 0x00, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4c, 0x69, 0x73,
 	0x74, 0x12, 0x24, 0x0a, 0x0d, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x6f, 0x75,
 	0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72,
-	0x63, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x83, 0x01, 0x0a, 0x0f, 0x63, 0x6f, 0x6d, 0x2e,
-	0x63, 0x72, 0x75, 0x64, 0x65, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x65, 0x42, 0x0c, 0x47, 0x65, 0x6e,
-	0x65, 0x73, 0x69, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x15, 0x63, 0x72, 0x75,
-	0x64, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x72, 0x75, 0x64, 0x65, 0x2f, 0x63, 0x72, 0x75,
-	0x64, 0x65, 0xa2, 0x02, 0x03, 0x43, 0x43, 0x58, 0xaa, 0x02, 0x0b, 0x43, 0x72, 0x75, 0x64, 0x65,
-	0x2e, 0x43, 0x72, 0x75, 0x64, 0x65, 0xca, 0x02, 0x0b, 0x43, 0x72, 0x75, 0x64, 0x65, 0x5c, 0x43,
-	0x72, 0x75, 0x64, 0x65, 0xe2, 0x02, 0x17, 0x43, 0x72, 0x75, 0x64, 0x65, 0x5c, 0x43, 0x72, 0x75,
-	0x64, 0x65, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02,
-	0x0c, 0x43, 0x72, 0x75, 0x64, 0x65, 0x3a, 0x3a, 0x43, 0x72, 0x75, 0x64, 0x65, 0x62, 0x06, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x63, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x57, 0x0a, 0x14, 0x72, 0x65, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x18,
+	0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x65, 0x2e, 0x63, 0x72,
+	0x75, 0x64, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x76, 0x69,
+	0x73, 0x69, 0x6f, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x14, 0x72, 0x65, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74,
+	0x12, 0x66, 0x0a, 0x19, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x66, 0x65, 0x72, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x05, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x65, 0x2e, 0x63, 0x72, 0x75, 0x64,
+	0x65, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66,
+	0x65, 0x72, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x19, 0x72,
+	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x4f,
+	0x66, 0x66, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x5a, 0x0a, 0x15, 0x72, 0x65, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x4c, 0x69, 0x73,
+	0x74, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x65, 0x2e,
+	0x63, 0x72, 0x75, 0x64, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x6f,
+	0x6c, 0x65, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x15, 0x72,
+	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x47, 0x72, 0x61, 0x6e, 0x74,
+	0x4c, 0x69, 0x73, 0x74, 0x12, 0x51, 0x0a, 0x12, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1b, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x65, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x65, 0x2e, 0x52,
+	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x42, 0x04, 0xc8,
+	0xde, 0x1f, 0x00, 0x52, 0x12, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x53, 0x63, 0x68,
+	0x65, 0x6d, 0x61, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x42, 0x0a, 0x0d, 0x6e, 0x61, 0x6d, 0x65, 0x73,
+	0x70, 0x61, 0x63, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16,
+	0x2e, 0x63, 0x72, 0x75, 0x64, 0x65, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x65, 0x2e, 0x4e, 0x61, 0x6d,
+	0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0d, 0x6e, 0x61,
+	0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x70,
+	0x6f, 0x72, 0x74, 0x49, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x6f, 0x72,
+	0x74, 0x49, 0x64, 0x12, 0x60, 0x0a, 0x17, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52,
+	0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x0a,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x65, 0x2e, 0x63, 0x72, 0x75,
+	0x64, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x17, 0x72, 0x65,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x54, 0x0a, 0x13, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x0b, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x65, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x65,
+	0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61,
+	0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x13, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x83, 0x01, 0x0a, 0x0f,
+	0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x65, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x65, 0x42,
+	0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a,
+	0x15, 0x63, 0x72, 0x75, 0x64, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x72, 0x75, 0x64, 0x65,
+	0x2f, 0x63, 0x72, 0x75, 0x64, 0x65, 0xa2, 0x02, 0x03, 0x43, 0x43, 0x58, 0xaa, 0x02, 0x0b, 0x43,
+	0x72, 0x75, 0x64, 0x65, 0x2e, 0x43, 0x72, 0x75, 0x64, 0x65, 0xca, 0x02, 0x0b, 0x43, 0x72, 0x75,
+	0x64, 0x65, 0x5c, 0x43, 0x72, 0x75, 0x64, 0x65, 0xe2, 0x02, 0x17, 0x43, 0x72, 0x75, 0x64, 0x65,
+	0x5c, 0x43, 0x72, 0x75, 0x64, 0x65, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0xea, 0x02, 0x0c, 0x43, 0x72, 0x75, 0x64, 0x65, 0x3a, 0x3a, 0x43, 0x72, 0x75, 0x64,
+	0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -749,18 +1883,32 @@ func file_crude_crude_genesis_proto_rawDescGZIP() []byte {
 
 var file_crude_crude_genesis_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
 var file_crude_crude_genesis_proto_goTypes = []interface{}{
-	(*GenesisState)(nil), // 0: crude.crude.GenesisState
-	(*Params)(nil),       // 1: crude.crude.Params
-	(*Resource)(nil),     // 2: crude.crude.Resource
+	(*GenesisState)(nil),          // 0: crude.crude.GenesisState
+	(*Params)(nil),                // 1: crude.crude.Params
+	(*Resource)(nil),              // 2: crude.crude.Resource
+	(*ResourceRevision)(nil),      // 3: crude.crude.ResourceRevision
+	(*ResourceTransferOffer)(nil), // 4: crude.crude.ResourceTransferOffer
+	(*ResourceRoleGrant)(nil),     // 5: crude.crude.ResourceRoleGrant
+	(*ResourceSchema)(nil),        // 6: crude.crude.ResourceSchema
+	(*Namespace)(nil),             // 7: crude.crude.Namespace
+	(*ResourceReplication)(nil),   // 8: crude.crude.ResourceReplication
+	(*ResourceReplica)(nil),       // 9: crude.crude.ResourceReplica
 }
 var file_crude_crude_genesis_proto_depIdxs = []int32{
 	1, // 0: crude.crude.GenesisState.params:type_name -> crude.crude.Params
 	2, // 1: crude.crude.GenesisState.resourceList:type_name -> crude.crude.Resource
-	2, // [2:2] is the sub-list for method output_type
-	2, // [2:2] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	3, // 2: crude.crude.GenesisState.resourceRevisionList:type_name -> crude.crude.ResourceRevision
+	4, // 3: crude.crude.GenesisState.resourceTransferOfferList:type_name -> crude.crude.ResourceTransferOffer
+	5, // 4: crude.crude.GenesisState.resourceRoleGrantList:type_name -> crude.crude.ResourceRoleGrant
+	6, // 5: crude.crude.GenesisState.resourceSchemaList:type_name -> crude.crude.ResourceSchema
+	7, // 6: crude.crude.GenesisState.namespaceList:type_name -> crude.crude.Namespace
+	8, // 7: crude.crude.GenesisState.resourceReplicationList:type_name -> crude.crude.ResourceReplication
+	9, // 8: crude.crude.GenesisState.resourceReplicaList:type_name -> crude.crude.ResourceReplica
+	9, // [9:9] is the sub-list for method output_type
+	9, // [9:9] is the sub-list for method input_type
+	9, // [9:9] is the sub-list for extension type_name
+	9, // [9:9] is the sub-list for extension extendee
+	0, // [0:9] is the sub-list for field type_name
 }
 
 func init() { file_crude_crude_genesis_proto_init() }
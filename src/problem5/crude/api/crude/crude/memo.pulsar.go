@@ -0,0 +1,776 @@
+// Code generated by protoc-gen-go-pulsar. DO NOT EDIT.
+package crude
+
+import (
+	fmt "fmt"
+	runtime "github.com/cosmos/cosmos-proto/runtime"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoiface "google.golang.org/protobuf/runtime/protoiface"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	io "io"
+	reflect "reflect"
+	sync "sync"
+)
+
+var (
+	md_TransferMemo        protoreflect.MessageDescriptor
+	fd_TransferMemo_create protoreflect.FieldDescriptor
+	fd_TransferMemo_update protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_crude_crude_memo_proto_init()
+	md_TransferMemo = File_crude_crude_memo_proto.Messages().ByName("TransferMemo")
+	fd_TransferMemo_create = md_TransferMemo.Fields().ByName("create")
+	fd_TransferMemo_update = md_TransferMemo.Fields().ByName("update")
+}
+
+var _ protoreflect.Message = (*fastReflection_TransferMemo)(nil)
+
+type fastReflection_TransferMemo TransferMemo
+
+func (x *TransferMemo) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_TransferMemo)(x)
+}
+
+func (x *TransferMemo) slowProtoReflect() protoreflect.Message {
+	mi := &file_crude_crude_memo_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_TransferMemo_messageType fastReflection_TransferMemo_messageType
+var _ protoreflect.MessageType = fastReflection_TransferMemo_messageType{}
+
+type fastReflection_TransferMemo_messageType struct{}
+
+func (x fastReflection_TransferMemo_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_TransferMemo)(nil)
+}
+func (x fastReflection_TransferMemo_messageType) New() protoreflect.Message {
+	return new(fastReflection_TransferMemo)
+}
+func (x fastReflection_TransferMemo_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_TransferMemo
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_TransferMemo) Descriptor() protoreflect.MessageDescriptor {
+	return md_TransferMemo
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_TransferMemo) Type() protoreflect.MessageType {
+	return _fastReflection_TransferMemo_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_TransferMemo) New() protoreflect.Message {
+	return new(fastReflection_TransferMemo)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_TransferMemo) Interface() protoreflect.ProtoMessage {
+	return (*TransferMemo)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_TransferMemo) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Operation != nil {
+		switch o := x.Operation.(type) {
+		case *TransferMemo_Create:
+			v := o.Create
+			value := protoreflect.ValueOfMessage(v.ProtoReflect())
+			if !f(fd_TransferMemo_create, value) {
+				return
+			}
+		case *TransferMemo_Update:
+			v := o.Update
+			value := protoreflect.ValueOfMessage(v.ProtoReflect())
+			if !f(fd_TransferMemo_update, value) {
+				return
+			}
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_TransferMemo) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "crude.crude.TransferMemo.create":
+		if x.Operation == nil {
+			return false
+		} else if _, ok := x.Operation.(*TransferMemo_Create); ok {
+			return true
+		} else {
+			return false
+		}
+	case "crude.crude.TransferMemo.update":
+		if x.Operation == nil {
+			return false
+		} else if _, ok := x.Operation.(*TransferMemo_Update); ok {
+			return true
+		} else {
+			return false
+		}
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: crude.crude.TransferMemo"))
+		}
+		panic(fmt.Errorf("message crude.crude.TransferMemo does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TransferMemo) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "crude.crude.TransferMemo.create":
+		x.Operation = nil
+	case "crude.crude.TransferMemo.update":
+		x.Operation = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: crude.crude.TransferMemo"))
+		}
+		panic(fmt.Errorf("message crude.crude.TransferMemo does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_TransferMemo) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "crude.crude.TransferMemo.create":
+		if x.Operation == nil {
+			return protoreflect.ValueOfMessage((*MsgCreateResource)(nil).ProtoReflect())
+		} else if v, ok := x.Operation.(*TransferMemo_Create); ok {
+			return protoreflect.ValueOfMessage(v.Create.ProtoReflect())
+		} else {
+			return protoreflect.ValueOfMessage((*MsgCreateResource)(nil).ProtoReflect())
+		}
+	case "crude.crude.TransferMemo.update":
+		if x.Operation == nil {
+			return protoreflect.ValueOfMessage((*MsgUpdateResource)(nil).ProtoReflect())
+		} else if v, ok := x.Operation.(*TransferMemo_Update); ok {
+			return protoreflect.ValueOfMessage(v.Update.ProtoReflect())
+		} else {
+			return protoreflect.ValueOfMessage((*MsgUpdateResource)(nil).ProtoReflect())
+		}
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: crude.crude.TransferMemo"))
+		}
+		panic(fmt.Errorf("message crude.crude.TransferMemo does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TransferMemo) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "crude.crude.TransferMemo.create":
+		cv := value.Message().Interface().(*MsgCreateResource)
+		x.Operation = &TransferMemo_Create{Create: cv}
+	case "crude.crude.TransferMemo.update":
+		cv := value.Message().Interface().(*MsgUpdateResource)
+		x.Operation = &TransferMemo_Update{Update: cv}
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: crude.crude.TransferMemo"))
+		}
+		panic(fmt.Errorf("message crude.crude.TransferMemo does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TransferMemo) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "crude.crude.TransferMemo.create":
+		if x.Operation == nil {
+			value := &MsgCreateResource{}
+			oneofValue := &TransferMemo_Create{Create: value}
+			x.Operation = oneofValue
+			return protoreflect.ValueOfMessage(value.ProtoReflect())
+		}
+		switch m := x.Operation.(type) {
+		case *TransferMemo_Create:
+			return protoreflect.ValueOfMessage(m.Create.ProtoReflect())
+		default:
+			value := &MsgCreateResource{}
+			oneofValue := &TransferMemo_Create{Create: value}
+			x.Operation = oneofValue
+			return protoreflect.ValueOfMessage(value.ProtoReflect())
+		}
+	case "crude.crude.TransferMemo.update":
+		if x.Operation == nil {
+			value := &MsgUpdateResource{}
+			oneofValue := &TransferMemo_Update{Update: value}
+			x.Operation = oneofValue
+			return protoreflect.ValueOfMessage(value.ProtoReflect())
+		}
+		switch m := x.Operation.(type) {
+		case *TransferMemo_Update:
+			return protoreflect.ValueOfMessage(m.Update.ProtoReflect())
+		default:
+			value := &MsgUpdateResource{}
+			oneofValue := &TransferMemo_Update{Update: value}
+			x.Operation = oneofValue
+			return protoreflect.ValueOfMessage(value.ProtoReflect())
+		}
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: crude.crude.TransferMemo"))
+		}
+		panic(fmt.Errorf("message crude.crude.TransferMemo does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_TransferMemo) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "crude.crude.TransferMemo.create":
+		value := &MsgCreateResource{}
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "crude.crude.TransferMemo.update":
+		value := &MsgUpdateResource{}
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: crude.crude.TransferMemo"))
+		}
+		panic(fmt.Errorf("message crude.crude.TransferMemo does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_TransferMemo) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	case "crude.crude.TransferMemo.operation":
+		if x.Operation == nil {
+			return nil
+		}
+		switch x.Operation.(type) {
+		case *TransferMemo_Create:
+			return x.Descriptor().Fields().ByName("create")
+		case *TransferMemo_Update:
+			return x.Descriptor().Fields().ByName("update")
+		}
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in crude.crude.TransferMemo", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_TransferMemo) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TransferMemo) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_TransferMemo) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_TransferMemo) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*TransferMemo)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		switch x := x.Operation.(type) {
+		case *TransferMemo_Create:
+			if x == nil {
+				break
+			}
+			l = options.Size(x.Create)
+			n += 1 + l + runtime.Sov(uint64(l))
+		case *TransferMemo_Update:
+			if x == nil {
+				break
+			}
+			l = options.Size(x.Update)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*TransferMemo)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		switch x := x.Operation.(type) {
+		case *TransferMemo_Create:
+			encoded, err := options.Marshal(x.Create)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		case *TransferMemo_Update:
+			encoded, err := options.Marshal(x.Update)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*TransferMemo)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TransferMemo: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TransferMemo: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Create", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				v := &MsgCreateResource{}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], v); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				x.Operation = &TransferMemo_Create{v}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Update", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				v := &MsgUpdateResource{}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], v); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				x.Operation = &TransferMemo_Update{v}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: crude/crude/memo.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// TransferMemo is the value of the crude field of the memo of an ICS-20
+// transfer, the resource operation to run once the tokens are received.
+// Exactly one operation is set, its creator is left empty: the operation runs
+// on behalf of the intermediary account derived from the channel and the
+// sender, which receives the tokens.
+type TransferMemo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Operation:
+	//	*TransferMemo_Create
+	//	*TransferMemo_Update
+	Operation isTransferMemo_Operation `protobuf_oneof:"operation"`
+}
+
+func (x *TransferMemo) Reset() {
+	*x = TransferMemo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_crude_crude_memo_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TransferMemo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferMemo) ProtoMessage() {}
+
+// Deprecated: Use TransferMemo.ProtoReflect.Descriptor instead.
+func (*TransferMemo) Descriptor() ([]byte, []int) {
+	return file_crude_crude_memo_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *TransferMemo) GetOperation() isTransferMemo_Operation {
+	if x != nil {
+		return x.Operation
+	}
+	return nil
+}
+
+func (x *TransferMemo) GetCreate() *MsgCreateResource {
+	if x, ok := x.GetOperation().(*TransferMemo_Create); ok {
+		return x.Create
+	}
+	return nil
+}
+
+func (x *TransferMemo) GetUpdate() *MsgUpdateResource {
+	if x, ok := x.GetOperation().(*TransferMemo_Update); ok {
+		return x.Update
+	}
+	return nil
+}
+
+type isTransferMemo_Operation interface {
+	isTransferMemo_Operation()
+}
+
+type TransferMemo_Create struct {
+	Create *MsgCreateResource `protobuf:"bytes,1,opt,name=create,proto3,oneof"`
+}
+
+type TransferMemo_Update struct {
+	Update *MsgUpdateResource `protobuf:"bytes,2,opt,name=update,proto3,oneof"`
+}
+
+func (*TransferMemo_Create) isTransferMemo_Operation() {}
+
+func (*TransferMemo_Update) isTransferMemo_Operation() {}
+
+var File_crude_crude_memo_proto protoreflect.FileDescriptor
+
+var file_crude_crude_memo_proto_rawDesc = []byte{
+	0x0a, 0x16, 0x63, 0x72, 0x75, 0x64, 0x65, 0x2f, 0x63, 0x72, 0x75, 0x64, 0x65, 0x2f, 0x6d, 0x65,
+	0x6d, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0b, 0x63, 0x72, 0x75, 0x64, 0x65, 0x2e,
+	0x63, 0x72, 0x75, 0x64, 0x65, 0x1a, 0x14, 0x63, 0x72, 0x75, 0x64, 0x65, 0x2f, 0x63, 0x72, 0x75,
+	0x64, 0x65, 0x2f, 0x74, 0x78, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x8f, 0x01, 0x0a, 0x0c,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x4d, 0x65, 0x6d, 0x6f, 0x12, 0x38, 0x0a, 0x06,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x63,
+	0x72, 0x75, 0x64, 0x65, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x65, 0x2e, 0x4d, 0x73, 0x67, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x48, 0x00, 0x52, 0x06,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x38, 0x0a, 0x06, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x65, 0x2e, 0x63,
+	0x72, 0x75, 0x64, 0x65, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x48, 0x00, 0x52, 0x06, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x42, 0x0b, 0x0a, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x80, 0x01,
+	0x0a, 0x0f, 0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x65, 0x2e, 0x63, 0x72, 0x75, 0x64,
+	0x65, 0x42, 0x09, 0x4d, 0x65, 0x6d, 0x6f, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x15,
+	0x63, 0x72, 0x75, 0x64, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x72, 0x75, 0x64, 0x65, 0x2f,
+	0x63, 0x72, 0x75, 0x64, 0x65, 0xa2, 0x02, 0x03, 0x43, 0x43, 0x58, 0xaa, 0x02, 0x0b, 0x43, 0x72,
+	0x75, 0x64, 0x65, 0x2e, 0x43, 0x72, 0x75, 0x64, 0x65, 0xca, 0x02, 0x0b, 0x43, 0x72, 0x75, 0x64,
+	0x65, 0x5c, 0x43, 0x72, 0x75, 0x64, 0x65, 0xe2, 0x02, 0x17, 0x43, 0x72, 0x75, 0x64, 0x65, 0x5c,
+	0x43, 0x72, 0x75, 0x64, 0x65, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0xea, 0x02, 0x0c, 0x43, 0x72, 0x75, 0x64, 0x65, 0x3a, 0x3a, 0x43, 0x72, 0x75, 0x64, 0x65,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_crude_crude_memo_proto_rawDescOnce sync.Once
+	file_crude_crude_memo_proto_rawDescData = file_crude_crude_memo_proto_rawDesc
+)
+
+func file_crude_crude_memo_proto_rawDescGZIP() []byte {
+	file_crude_crude_memo_proto_rawDescOnce.Do(func() {
+		file_crude_crude_memo_proto_rawDescData = protoimpl.X.CompressGZIP(file_crude_crude_memo_proto_rawDescData)
+	})
+	return file_crude_crude_memo_proto_rawDescData
+}
+
+var file_crude_crude_memo_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_crude_crude_memo_proto_goTypes = []interface{}{
+	(*TransferMemo)(nil),      // 0: crude.crude.TransferMemo
+	(*MsgCreateResource)(nil), // 1: crude.crude.MsgCreateResource
+	(*MsgUpdateResource)(nil), // 2: crude.crude.MsgUpdateResource
+}
+var file_crude_crude_memo_proto_depIdxs = []int32{
+	1, // 0: crude.crude.TransferMemo.create:type_name -> crude.crude.MsgCreateResource
+	2, // 1: crude.crude.TransferMemo.update:type_name -> crude.crude.MsgUpdateResource
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_crude_crude_memo_proto_init() }
+func file_crude_crude_memo_proto_init() {
+	if File_crude_crude_memo_proto != nil {
+		return
+	}
+	file_crude_crude_tx_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_crude_crude_memo_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TransferMemo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_crude_crude_memo_proto_msgTypes[0].OneofWrappers = []interface{}{
+		(*TransferMemo_Create)(nil),
+		(*TransferMemo_Update)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_crude_crude_memo_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_crude_crude_memo_proto_goTypes,
+		DependencyIndexes: file_crude_crude_memo_proto_depIdxs,
+		MessageInfos:      file_crude_crude_memo_proto_msgTypes,
+	}.Build()
+	File_crude_crude_memo_proto = out.File
+	file_crude_crude_memo_proto_rawDesc = nil
+	file_crude_crude_memo_proto_goTypes = nil
+	file_crude_crude_memo_proto_depIdxs = nil
+}
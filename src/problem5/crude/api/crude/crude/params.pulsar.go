@@ -3,6 +3,7 @@ package crude
 
 import (
 	_ "cosmossdk.io/api/amino"
+	v1beta1 "cosmossdk.io/api/cosmos/base/v1beta1"
 	fmt "fmt"
 	runtime "github.com/cosmos/cosmos-proto/runtime"
 	_ "github.com/cosmos/gogoproto/gogoproto"
@@ -14,13 +15,139 @@ import (
 	sync "sync"
 )
 
+var _ protoreflect.List = (*_Params_4_list)(nil)
+
+type _Params_4_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_Params_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Params_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Params_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Params_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Params_4_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Params_4_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Params_4_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Params_4_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_Params_5_list)(nil)
+
+type _Params_5_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_Params_5_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Params_5_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Params_5_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Params_5_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Params_5_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Params_5_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Params_5_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Params_5_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_Params protoreflect.MessageDescriptor
+	md_Params                          protoreflect.MessageDescriptor
+	fd_Params_maxNameLength            protoreflect.FieldDescriptor
+	fd_Params_nameCharset              protoreflect.FieldDescriptor
+	fd_Params_maxResourcesPerCreator   protoreflect.FieldDescriptor
+	fd_Params_creationFee              protoreflect.FieldDescriptor
+	fd_Params_depositPerByte           protoreflect.FieldDescriptor
+	fd_Params_maxPrunedPerBlock        protoreflect.FieldDescriptor
+	fd_Params_maxAttributeKeyLength    protoreflect.FieldDescriptor
+	fd_Params_maxAttributeValueLength  protoreflect.FieldDescriptor
+	fd_Params_maxAttributesPerResource protoreflect.FieldDescriptor
+	fd_Params_maxSchemaLength          protoreflect.FieldDescriptor
+	fd_Params_onlyApprovedSchemas      protoreflect.FieldDescriptor
+	fd_Params_uniqueNames              protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_crude_crude_params_proto_init()
 	md_Params = File_crude_crude_params_proto.Messages().ByName("Params")
+	fd_Params_maxNameLength = md_Params.Fields().ByName("maxNameLength")
+	fd_Params_nameCharset = md_Params.Fields().ByName("nameCharset")
+	fd_Params_maxResourcesPerCreator = md_Params.Fields().ByName("maxResourcesPerCreator")
+	fd_Params_creationFee = md_Params.Fields().ByName("creationFee")
+	fd_Params_depositPerByte = md_Params.Fields().ByName("depositPerByte")
+	fd_Params_maxPrunedPerBlock = md_Params.Fields().ByName("maxPrunedPerBlock")
+	fd_Params_maxAttributeKeyLength = md_Params.Fields().ByName("maxAttributeKeyLength")
+	fd_Params_maxAttributeValueLength = md_Params.Fields().ByName("maxAttributeValueLength")
+	fd_Params_maxAttributesPerResource = md_Params.Fields().ByName("maxAttributesPerResource")
+	fd_Params_maxSchemaLength = md_Params.Fields().ByName("maxSchemaLength")
+	fd_Params_onlyApprovedSchemas = md_Params.Fields().ByName("onlyApprovedSchemas")
+	fd_Params_uniqueNames = md_Params.Fields().ByName("uniqueNames")
 }
 
 var _ protoreflect.Message = (*fastReflection_Params)(nil)
@@ -88,6 +215,78 @@ func (x *fastReflection_Params) Interface() protoreflect.ProtoMessage {
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
 func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.MaxNameLength != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxNameLength)
+		if !f(fd_Params_maxNameLength, value) {
+			return
+		}
+	}
+	if x.NameCharset != "" {
+		value := protoreflect.ValueOfString(x.NameCharset)
+		if !f(fd_Params_nameCharset, value) {
+			return
+		}
+	}
+	if x.MaxResourcesPerCreator != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxResourcesPerCreator)
+		if !f(fd_Params_maxResourcesPerCreator, value) {
+			return
+		}
+	}
+	if len(x.CreationFee) != 0 {
+		value := protoreflect.ValueOfList(&_Params_4_list{list: &x.CreationFee})
+		if !f(fd_Params_creationFee, value) {
+			return
+		}
+	}
+	if len(x.DepositPerByte) != 0 {
+		value := protoreflect.ValueOfList(&_Params_5_list{list: &x.DepositPerByte})
+		if !f(fd_Params_depositPerByte, value) {
+			return
+		}
+	}
+	if x.MaxPrunedPerBlock != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxPrunedPerBlock)
+		if !f(fd_Params_maxPrunedPerBlock, value) {
+			return
+		}
+	}
+	if x.MaxAttributeKeyLength != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxAttributeKeyLength)
+		if !f(fd_Params_maxAttributeKeyLength, value) {
+			return
+		}
+	}
+	if x.MaxAttributeValueLength != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxAttributeValueLength)
+		if !f(fd_Params_maxAttributeValueLength, value) {
+			return
+		}
+	}
+	if x.MaxAttributesPerResource != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxAttributesPerResource)
+		if !f(fd_Params_maxAttributesPerResource, value) {
+			return
+		}
+	}
+	if x.MaxSchemaLength != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxSchemaLength)
+		if !f(fd_Params_maxSchemaLength, value) {
+			return
+		}
+	}
+	if x.OnlyApprovedSchemas != false {
+		value := protoreflect.ValueOfBool(x.OnlyApprovedSchemas)
+		if !f(fd_Params_onlyApprovedSchemas, value) {
+			return
+		}
+	}
+	if x.UniqueNames != false {
+		value := protoreflect.ValueOfBool(x.UniqueNames)
+		if !f(fd_Params_uniqueNames, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -103,6 +302,30 @@ func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, proto
 // a repeated field is populated if it is non-empty.
 func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
+	case "crude.crude.Params.maxNameLength":
+		return x.MaxNameLength != uint64(0)
+	case "crude.crude.Params.nameCharset":
+		return x.NameCharset != ""
+	case "crude.crude.Params.maxResourcesPerCreator":
+		return x.MaxResourcesPerCreator != uint64(0)
+	case "crude.crude.Params.creationFee":
+		return len(x.CreationFee) != 0
+	case "crude.crude.Params.depositPerByte":
+		return len(x.DepositPerByte) != 0
+	case "crude.crude.Params.maxPrunedPerBlock":
+		return x.MaxPrunedPerBlock != uint64(0)
+	case "crude.crude.Params.maxAttributeKeyLength":
+		return x.MaxAttributeKeyLength != uint64(0)
+	case "crude.crude.Params.maxAttributeValueLength":
+		return x.MaxAttributeValueLength != uint64(0)
+	case "crude.crude.Params.maxAttributesPerResource":
+		return x.MaxAttributesPerResource != uint64(0)
+	case "crude.crude.Params.maxSchemaLength":
+		return x.MaxSchemaLength != uint64(0)
+	case "crude.crude.Params.onlyApprovedSchemas":
+		return x.OnlyApprovedSchemas != false
+	case "crude.crude.Params.uniqueNames":
+		return x.UniqueNames != false
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: crude.crude.Params"))
@@ -119,6 +342,30 @@ func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
 // Clear is a mutating operation and unsafe for concurrent use.
 func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
+	case "crude.crude.Params.maxNameLength":
+		x.MaxNameLength = uint64(0)
+	case "crude.crude.Params.nameCharset":
+		x.NameCharset = ""
+	case "crude.crude.Params.maxResourcesPerCreator":
+		x.MaxResourcesPerCreator = uint64(0)
+	case "crude.crude.Params.creationFee":
+		x.CreationFee = nil
+	case "crude.crude.Params.depositPerByte":
+		x.DepositPerByte = nil
+	case "crude.crude.Params.maxPrunedPerBlock":
+		x.MaxPrunedPerBlock = uint64(0)
+	case "crude.crude.Params.maxAttributeKeyLength":
+		x.MaxAttributeKeyLength = uint64(0)
+	case "crude.crude.Params.maxAttributeValueLength":
+		x.MaxAttributeValueLength = uint64(0)
+	case "crude.crude.Params.maxAttributesPerResource":
+		x.MaxAttributesPerResource = uint64(0)
+	case "crude.crude.Params.maxSchemaLength":
+		x.MaxSchemaLength = uint64(0)
+	case "crude.crude.Params.onlyApprovedSchemas":
+		x.OnlyApprovedSchemas = false
+	case "crude.crude.Params.uniqueNames":
+		x.UniqueNames = false
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: crude.crude.Params"))
@@ -135,6 +382,48 @@ func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
 // of the value; to obtain a mutable reference, use Mutable.
 func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
+	case "crude.crude.Params.maxNameLength":
+		value := x.MaxNameLength
+		return protoreflect.ValueOfUint64(value)
+	case "crude.crude.Params.nameCharset":
+		value := x.NameCharset
+		return protoreflect.ValueOfString(value)
+	case "crude.crude.Params.maxResourcesPerCreator":
+		value := x.MaxResourcesPerCreator
+		return protoreflect.ValueOfUint64(value)
+	case "crude.crude.Params.creationFee":
+		if len(x.CreationFee) == 0 {
+			return protoreflect.ValueOfList(&_Params_4_list{})
+		}
+		listValue := &_Params_4_list{list: &x.CreationFee}
+		return protoreflect.ValueOfList(listValue)
+	case "crude.crude.Params.depositPerByte":
+		if len(x.DepositPerByte) == 0 {
+			return protoreflect.ValueOfList(&_Params_5_list{})
+		}
+		listValue := &_Params_5_list{list: &x.DepositPerByte}
+		return protoreflect.ValueOfList(listValue)
+	case "crude.crude.Params.maxPrunedPerBlock":
+		value := x.MaxPrunedPerBlock
+		return protoreflect.ValueOfUint64(value)
+	case "crude.crude.Params.maxAttributeKeyLength":
+		value := x.MaxAttributeKeyLength
+		return protoreflect.ValueOfUint64(value)
+	case "crude.crude.Params.maxAttributeValueLength":
+		value := x.MaxAttributeValueLength
+		return protoreflect.ValueOfUint64(value)
+	case "crude.crude.Params.maxAttributesPerResource":
+		value := x.MaxAttributesPerResource
+		return protoreflect.ValueOfUint64(value)
+	case "crude.crude.Params.maxSchemaLength":
+		value := x.MaxSchemaLength
+		return protoreflect.ValueOfUint64(value)
+	case "crude.crude.Params.onlyApprovedSchemas":
+		value := x.OnlyApprovedSchemas
+		return protoreflect.ValueOfBool(value)
+	case "crude.crude.Params.uniqueNames":
+		value := x.UniqueNames
+		return protoreflect.ValueOfBool(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: crude.crude.Params"))
@@ -155,6 +444,34 @@ func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) pro
 // Set is a mutating operation and unsafe for concurrent use.
 func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
+	case "crude.crude.Params.maxNameLength":
+		x.MaxNameLength = value.Uint()
+	case "crude.crude.Params.nameCharset":
+		x.NameCharset = value.Interface().(string)
+	case "crude.crude.Params.maxResourcesPerCreator":
+		x.MaxResourcesPerCreator = value.Uint()
+	case "crude.crude.Params.creationFee":
+		lv := value.List()
+		clv := lv.(*_Params_4_list)
+		x.CreationFee = *clv.list
+	case "crude.crude.Params.depositPerByte":
+		lv := value.List()
+		clv := lv.(*_Params_5_list)
+		x.DepositPerByte = *clv.list
+	case "crude.crude.Params.maxPrunedPerBlock":
+		x.MaxPrunedPerBlock = value.Uint()
+	case "crude.crude.Params.maxAttributeKeyLength":
+		x.MaxAttributeKeyLength = value.Uint()
+	case "crude.crude.Params.maxAttributeValueLength":
+		x.MaxAttributeValueLength = value.Uint()
+	case "crude.crude.Params.maxAttributesPerResource":
+		x.MaxAttributesPerResource = value.Uint()
+	case "crude.crude.Params.maxSchemaLength":
+		x.MaxSchemaLength = value.Uint()
+	case "crude.crude.Params.onlyApprovedSchemas":
+		x.OnlyApprovedSchemas = value.Bool()
+	case "crude.crude.Params.uniqueNames":
+		x.UniqueNames = value.Bool()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: crude.crude.Params"))
@@ -175,6 +492,38 @@ func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value proto
 // Mutable is a mutating operation and unsafe for concurrent use.
 func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "crude.crude.Params.creationFee":
+		if x.CreationFee == nil {
+			x.CreationFee = []*v1beta1.Coin{}
+		}
+		value := &_Params_4_list{list: &x.CreationFee}
+		return protoreflect.ValueOfList(value)
+	case "crude.crude.Params.depositPerByte":
+		if x.DepositPerByte == nil {
+			x.DepositPerByte = []*v1beta1.Coin{}
+		}
+		value := &_Params_5_list{list: &x.DepositPerByte}
+		return protoreflect.ValueOfList(value)
+	case "crude.crude.Params.maxNameLength":
+		panic(fmt.Errorf("field maxNameLength of message crude.crude.Params is not mutable"))
+	case "crude.crude.Params.nameCharset":
+		panic(fmt.Errorf("field nameCharset of message crude.crude.Params is not mutable"))
+	case "crude.crude.Params.maxResourcesPerCreator":
+		panic(fmt.Errorf("field maxResourcesPerCreator of message crude.crude.Params is not mutable"))
+	case "crude.crude.Params.maxPrunedPerBlock":
+		panic(fmt.Errorf("field maxPrunedPerBlock of message crude.crude.Params is not mutable"))
+	case "crude.crude.Params.maxAttributeKeyLength":
+		panic(fmt.Errorf("field maxAttributeKeyLength of message crude.crude.Params is not mutable"))
+	case "crude.crude.Params.maxAttributeValueLength":
+		panic(fmt.Errorf("field maxAttributeValueLength of message crude.crude.Params is not mutable"))
+	case "crude.crude.Params.maxAttributesPerResource":
+		panic(fmt.Errorf("field maxAttributesPerResource of message crude.crude.Params is not mutable"))
+	case "crude.crude.Params.maxSchemaLength":
+		panic(fmt.Errorf("field maxSchemaLength of message crude.crude.Params is not mutable"))
+	case "crude.crude.Params.onlyApprovedSchemas":
+		panic(fmt.Errorf("field onlyApprovedSchemas of message crude.crude.Params is not mutable"))
+	case "crude.crude.Params.uniqueNames":
+		panic(fmt.Errorf("field uniqueNames of message crude.crude.Params is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: crude.crude.Params"))
@@ -188,6 +537,32 @@ func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protore
 // For lists, maps, and messages, this returns a new, empty, mutable value.
 func (x *fastReflection_Params) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "crude.crude.Params.maxNameLength":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "crude.crude.Params.nameCharset":
+		return protoreflect.ValueOfString("")
+	case "crude.crude.Params.maxResourcesPerCreator":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "crude.crude.Params.creationFee":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_Params_4_list{list: &list})
+	case "crude.crude.Params.depositPerByte":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_Params_5_list{list: &list})
+	case "crude.crude.Params.maxPrunedPerBlock":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "crude.crude.Params.maxAttributeKeyLength":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "crude.crude.Params.maxAttributeValueLength":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "crude.crude.Params.maxAttributesPerResource":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "crude.crude.Params.maxSchemaLength":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "crude.crude.Params.onlyApprovedSchemas":
+		return protoreflect.ValueOfBool(false)
+	case "crude.crude.Params.uniqueNames":
+		return protoreflect.ValueOfBool(false)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: crude.crude.Params"))
@@ -257,6 +632,49 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
+		if x.MaxNameLength != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxNameLength))
+		}
+		l = len(x.NameCharset)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.MaxResourcesPerCreator != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxResourcesPerCreator))
+		}
+		if len(x.CreationFee) > 0 {
+			for _, e := range x.CreationFee {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.DepositPerByte) > 0 {
+			for _, e := range x.DepositPerByte {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.MaxPrunedPerBlock != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxPrunedPerBlock))
+		}
+		if x.MaxAttributeKeyLength != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxAttributeKeyLength))
+		}
+		if x.MaxAttributeValueLength != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxAttributeValueLength))
+		}
+		if x.MaxAttributesPerResource != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxAttributesPerResource))
+		}
+		if x.MaxSchemaLength != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxSchemaLength))
+		}
+		if x.OnlyApprovedSchemas {
+			n += 2
+		}
+		if x.UniqueNames {
+			n += 2
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -286,6 +704,100 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.UniqueNames {
+			i--
+			if x.UniqueNames {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x60
+		}
+		if x.OnlyApprovedSchemas {
+			i--
+			if x.OnlyApprovedSchemas {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x58
+		}
+		if x.MaxSchemaLength != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxSchemaLength))
+			i--
+			dAtA[i] = 0x50
+		}
+		if x.MaxAttributesPerResource != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxAttributesPerResource))
+			i--
+			dAtA[i] = 0x48
+		}
+		if x.MaxAttributeValueLength != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxAttributeValueLength))
+			i--
+			dAtA[i] = 0x40
+		}
+		if x.MaxAttributeKeyLength != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxAttributeKeyLength))
+			i--
+			dAtA[i] = 0x38
+		}
+		if x.MaxPrunedPerBlock != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxPrunedPerBlock))
+			i--
+			dAtA[i] = 0x30
+		}
+		if len(x.DepositPerByte) > 0 {
+			for iNdEx := len(x.DepositPerByte) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.DepositPerByte[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x2a
+			}
+		}
+		if len(x.CreationFee) > 0 {
+			for iNdEx := len(x.CreationFee) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.CreationFee[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x22
+			}
+		}
+		if x.MaxResourcesPerCreator != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxResourcesPerCreator))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.NameCharset) > 0 {
+			i -= len(x.NameCharset)
+			copy(dAtA[i:], x.NameCharset)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.NameCharset)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.MaxNameLength != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxNameLength))
+			i--
+			dAtA[i] = 0x8
+		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
 		} else {
@@ -335,6 +847,279 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxNameLength", wireType)
+				}
+				x.MaxNameLength = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxNameLength |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NameCharset", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.NameCharset = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxResourcesPerCreator", wireType)
+				}
+				x.MaxResourcesPerCreator = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxResourcesPerCreator |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CreationFee", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.CreationFee = append(x.CreationFee, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.CreationFee[len(x.CreationFee)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DepositPerByte", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.DepositPerByte = append(x.DepositPerByte, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.DepositPerByte[len(x.DepositPerByte)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxPrunedPerBlock", wireType)
+				}
+				x.MaxPrunedPerBlock = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxPrunedPerBlock |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxAttributeKeyLength", wireType)
+				}
+				x.MaxAttributeKeyLength = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxAttributeKeyLength |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 8:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxAttributeValueLength", wireType)
+				}
+				x.MaxAttributeValueLength = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxAttributeValueLength |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 9:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxAttributesPerResource", wireType)
+				}
+				x.MaxAttributesPerResource = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxAttributesPerResource |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 10:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxSchemaLength", wireType)
+				}
+				x.MaxSchemaLength = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxSchemaLength |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 11:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OnlyApprovedSchemas", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.OnlyApprovedSchemas = bool(v != 0)
+			case 12:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field UniqueNames", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.UniqueNames = bool(v != 0)
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -388,6 +1173,43 @@ type Params struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// maxNameLength is the maximum length in bytes of a resource name, zero
+	// disables the check.
+	MaxNameLength uint64 `protobuf:"varint,1,opt,name=maxNameLength,proto3" json:"maxNameLength,omitempty"`
+	// nameCharset lists the characters allowed in a resource name, an empty
+	// charset allows any character.
+	NameCharset string `protobuf:"bytes,2,opt,name=nameCharset,proto3" json:"nameCharset,omitempty"`
+	// maxResourcesPerCreator caps the number of live resources created by a
+	// single account, zero disables the quota.
+	MaxResourcesPerCreator uint64 `protobuf:"varint,3,opt,name=maxResourcesPerCreator,proto3" json:"maxResourcesPerCreator,omitempty"`
+	// creationFee is charged to the creator and sent to the fee collector each
+	// time a resource is created.
+	CreationFee []*v1beta1.Coin `protobuf:"bytes,4,rep,name=creationFee,proto3" json:"creationFee,omitempty"`
+	// depositPerByte is escrowed in the module account for each byte of an
+	// encoded resource and refunded once the resource is deleted.
+	DepositPerByte []*v1beta1.Coin `protobuf:"bytes,5,rep,name=depositPerByte,proto3" json:"depositPerByte,omitempty"`
+	// maxPrunedPerBlock caps the number of expired resources removed at the end
+	// of each block, zero disables pruning.
+	MaxPrunedPerBlock uint64 `protobuf:"varint,6,opt,name=maxPrunedPerBlock,proto3" json:"maxPrunedPerBlock,omitempty"`
+	// maxAttributeKeyLength is the maximum length in bytes of an attribute key,
+	// zero disables the check.
+	MaxAttributeKeyLength uint64 `protobuf:"varint,7,opt,name=maxAttributeKeyLength,proto3" json:"maxAttributeKeyLength,omitempty"`
+	// maxAttributeValueLength is the maximum length in bytes of an attribute
+	// value, zero disables the check.
+	MaxAttributeValueLength uint64 `protobuf:"varint,8,opt,name=maxAttributeValueLength,proto3" json:"maxAttributeValueLength,omitempty"`
+	// maxAttributesPerResource caps the number of attributes of a resource,
+	// zero disables the cap.
+	MaxAttributesPerResource uint64 `protobuf:"varint,9,opt,name=maxAttributesPerResource,proto3" json:"maxAttributesPerResource,omitempty"`
+	// maxSchemaLength is the maximum length in bytes of a resource schema
+	// document, zero disables the check.
+	MaxSchemaLength uint64 `protobuf:"varint,10,opt,name=maxSchemaLength,proto3" json:"maxSchemaLength,omitempty"`
+	// onlyApprovedSchemas restricts resources to the schemas approved by the
+	// authority.
+	OnlyApprovedSchemas bool `protobuf:"varint,11,opt,name=onlyApprovedSchemas,proto3" json:"onlyApprovedSchemas,omitempty"`
+	// uniqueNames rejects creating a resource, or renaming one, with a name
+	// another resource already has.
+	UniqueNames bool `protobuf:"varint,12,opt,name=uniqueNames,proto3" json:"uniqueNames,omitempty"`
 }
 
 func (x *Params) Reset() {
@@ -410,6 +1232,90 @@ func (*Params) Descriptor() ([]byte, []int) {
 	return file_crude_crude_params_proto_rawDescGZIP(), []int{0}
 }
 
+func (x *Params) GetMaxNameLength() uint64 {
+	if x != nil {
+		return x.MaxNameLength
+	}
+	return 0
+}
+
+func (x *Params) GetNameCharset() string {
+	if x != nil {
+		return x.NameCharset
+	}
+	return ""
+}
+
+func (x *Params) GetMaxResourcesPerCreator() uint64 {
+	if x != nil {
+		return x.MaxResourcesPerCreator
+	}
+	return 0
+}
+
+func (x *Params) GetCreationFee() []*v1beta1.Coin {
+	if x != nil {
+		return x.CreationFee
+	}
+	return nil
+}
+
+func (x *Params) GetDepositPerByte() []*v1beta1.Coin {
+	if x != nil {
+		return x.DepositPerByte
+	}
+	return nil
+}
+
+func (x *Params) GetMaxPrunedPerBlock() uint64 {
+	if x != nil {
+		return x.MaxPrunedPerBlock
+	}
+	return 0
+}
+
+func (x *Params) GetMaxAttributeKeyLength() uint64 {
+	if x != nil {
+		return x.MaxAttributeKeyLength
+	}
+	return 0
+}
+
+func (x *Params) GetMaxAttributeValueLength() uint64 {
+	if x != nil {
+		return x.MaxAttributeValueLength
+	}
+	return 0
+}
+
+func (x *Params) GetMaxAttributesPerResource() uint64 {
+	if x != nil {
+		return x.MaxAttributesPerResource
+	}
+	return 0
+}
+
+func (x *Params) GetMaxSchemaLength() uint64 {
+	if x != nil {
+		return x.MaxSchemaLength
+	}
+	return 0
+}
+
+func (x *Params) GetOnlyApprovedSchemas() bool {
+	if x != nil {
+		return x.OnlyApprovedSchemas
+	}
+	return false
+}
+
+func (x *Params) GetUniqueNames() bool {
+	if x != nil {
+		return x.UniqueNames
+	}
+	return false
+}
+
 var File_crude_crude_params_proto protoreflect.FileDescriptor
 
 var file_crude_crude_params_proto_rawDesc = []byte{
@@ -418,18 +1324,67 @@ var file_crude_crude_params_proto_rawDesc = []byte{
 	0x65, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x65, 0x1a, 0x11, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2f, 0x61,
 	0x6d, 0x69, 0x6e, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f,
 	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x22, 0x27, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x3a, 0x1d, 0xe8, 0xa0, 0x1f, 0x01,
-	0x8a, 0xe7, 0xb0, 0x2a, 0x14, 0x63, 0x72, 0x75, 0x64, 0x65, 0x2f, 0x78, 0x2f, 0x63, 0x72, 0x75,
-	0x64, 0x65, 0x2f, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0x82, 0x01, 0x0a, 0x0f, 0x63, 0x6f,
-	0x6d, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x65, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x65, 0x42, 0x0b, 0x50,
-	0x61, 0x72, 0x61, 0x6d, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x15, 0x63, 0x72,
-	0x75, 0x64, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x72, 0x75, 0x64, 0x65, 0x2f, 0x63, 0x72,
-	0x75, 0x64, 0x65, 0xa2, 0x02, 0x03, 0x43, 0x43, 0x58, 0xaa, 0x02, 0x0b, 0x43, 0x72, 0x75, 0x64,
-	0x65, 0x2e, 0x43, 0x72, 0x75, 0x64, 0x65, 0xca, 0x02, 0x0b, 0x43, 0x72, 0x75, 0x64, 0x65, 0x5c,
-	0x43, 0x72, 0x75, 0x64, 0x65, 0xe2, 0x02, 0x17, 0x43, 0x72, 0x75, 0x64, 0x65, 0x5c, 0x43, 0x72,
-	0x75, 0x64, 0x65, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea,
-	0x02, 0x0c, 0x43, 0x72, 0x75, 0x64, 0x65, 0x3a, 0x3a, 0x43, 0x72, 0x75, 0x64, 0x65, 0x62, 0x06,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x1a, 0x1e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x62, 0x61, 0x73, 0x65, 0x2f, 0x76, 0x31,
+	0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x63, 0x6f, 0x69, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x22, 0x91, 0x06, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x6d,
+	0x61, 0x78, 0x4e, 0x61, 0x6d, 0x65, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0d, 0x6d, 0x61, 0x78, 0x4e, 0x61, 0x6d, 0x65, 0x4c, 0x65, 0x6e, 0x67, 0x74,
+	0x68, 0x12, 0x20, 0x0a, 0x0b, 0x6e, 0x61, 0x6d, 0x65, 0x43, 0x68, 0x61, 0x72, 0x73, 0x65, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6e, 0x61, 0x6d, 0x65, 0x43, 0x68, 0x61, 0x72,
+	0x73, 0x65, 0x74, 0x12, 0x36, 0x0a, 0x16, 0x6d, 0x61, 0x78, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x73, 0x50, 0x65, 0x72, 0x43, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x16, 0x6d, 0x61, 0x78, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x73, 0x50, 0x65, 0x72, 0x43, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x83, 0x01, 0x0a, 0x0b,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x65, 0x65, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e,
+	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x46, 0xc8, 0xde,
+	0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73,
+	0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x9a, 0xe7,
+	0xb0, 0x2a, 0x0c, 0x6c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x5f, 0x63, 0x6f, 0x69, 0x6e, 0x73, 0xa8,
+	0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0b, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x65,
+	0x65, 0x12, 0x89, 0x01, 0x0a, 0x0e, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x50, 0x65, 0x72,
+	0x42, 0x79, 0x74, 0x65, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
+	0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x46, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x9a, 0xe7, 0xb0, 0x2a, 0x0c, 0x6c, 0x65, 0x67, 0x61,
+	0x63, 0x79, 0x5f, 0x63, 0x6f, 0x69, 0x6e, 0x73, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0e, 0x64,
+	0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x50, 0x65, 0x72, 0x42, 0x79, 0x74, 0x65, 0x12, 0x2c, 0x0a,
+	0x11, 0x6d, 0x61, 0x78, 0x50, 0x72, 0x75, 0x6e, 0x65, 0x64, 0x50, 0x65, 0x72, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x6d, 0x61, 0x78, 0x50, 0x72, 0x75,
+	0x6e, 0x65, 0x64, 0x50, 0x65, 0x72, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x34, 0x0a, 0x15, 0x6d,
+	0x61, 0x78, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x4c, 0x65,
+	0x6e, 0x67, 0x74, 0x68, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x15, 0x6d, 0x61, 0x78, 0x41,
+	0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x4c, 0x65, 0x6e, 0x67, 0x74,
+	0x68, 0x12, 0x38, 0x0a, 0x17, 0x6d, 0x61, 0x78, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74,
+	0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x17, 0x6d, 0x61, 0x78, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x12, 0x3a, 0x0a, 0x18, 0x6d,
+	0x61, 0x78, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x50, 0x65, 0x72, 0x52,
+	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x18, 0x6d,
+	0x61, 0x78, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x50, 0x65, 0x72, 0x52,
+	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x28, 0x0a, 0x0f, 0x6d, 0x61, 0x78, 0x53, 0x63,
+	0x68, 0x65, 0x6d, 0x61, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0f, 0x6d, 0x61, 0x78, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x4c, 0x65, 0x6e, 0x67, 0x74,
+	0x68, 0x12, 0x30, 0x0a, 0x13, 0x6f, 0x6e, 0x6c, 0x79, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65,
+	0x64, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x13,
+	0x6f, 0x6e, 0x6c, 0x79, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x53, 0x63, 0x68, 0x65,
+	0x6d, 0x61, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x4e, 0x61, 0x6d,
+	0x65, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65,
+	0x4e, 0x61, 0x6d, 0x65, 0x73, 0x3a, 0x1d, 0xe8, 0xa0, 0x1f, 0x01, 0x8a, 0xe7, 0xb0, 0x2a, 0x14,
+	0x63, 0x72, 0x75, 0x64, 0x65, 0x2f, 0x78, 0x2f, 0x63, 0x72, 0x75, 0x64, 0x65, 0x2f, 0x50, 0x61,
+	0x72, 0x61, 0x6d, 0x73, 0x42, 0x82, 0x01, 0x0a, 0x0f, 0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x72, 0x75,
+	0x64, 0x65, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x65, 0x42, 0x0b, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73,
+	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x15, 0x63, 0x72, 0x75, 0x64, 0x65, 0x2f, 0x61,
+	0x70, 0x69, 0x2f, 0x63, 0x72, 0x75, 0x64, 0x65, 0x2f, 0x63, 0x72, 0x75, 0x64, 0x65, 0xa2, 0x02,
+	0x03, 0x43, 0x43, 0x58, 0xaa, 0x02, 0x0b, 0x43, 0x72, 0x75, 0x64, 0x65, 0x2e, 0x43, 0x72, 0x75,
+	0x64, 0x65, 0xca, 0x02, 0x0b, 0x43, 0x72, 0x75, 0x64, 0x65, 0x5c, 0x43, 0x72, 0x75, 0x64, 0x65,
+	0xe2, 0x02, 0x17, 0x43, 0x72, 0x75, 0x64, 0x65, 0x5c, 0x43, 0x72, 0x75, 0x64, 0x65, 0x5c, 0x47,
+	0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0c, 0x43, 0x72, 0x75,
+	0x64, 0x65, 0x3a, 0x3a, 0x43, 0x72, 0x75, 0x64, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
 }
 
 var (
@@ -446,14 +1401,17 @@ func file_crude_crude_params_proto_rawDescGZIP() []byte {
 
 var file_crude_crude_params_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
 var file_crude_crude_params_proto_goTypes = []interface{}{
-	(*Params)(nil), // 0: crude.crude.Params
+	(*Params)(nil),       // 0: crude.crude.Params
+	(*v1beta1.Coin)(nil), // 1: cosmos.base.v1beta1.Coin
 }
 var file_crude_crude_params_proto_depIdxs = []int32{
-	0, // [0:0] is the sub-list for method output_type
-	0, // [0:0] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	1, // 0: crude.crude.Params.creationFee:type_name -> cosmos.base.v1beta1.Coin
+	1, // 1: crude.crude.Params.depositPerByte:type_name -> cosmos.base.v1beta1.Coin
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
 }
 
 func init() { file_crude_crude_params_proto_init() }
@@ -19,9 +19,30 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Query_Params_FullMethodName      = "/crude.crude.Query/Params"
-	Query_Resource_FullMethodName    = "/crude.crude.Query/Resource"
-	Query_ResourceAll_FullMethodName = "/crude.crude.Query/ResourceAll"
+	Query_Params_FullMethodName                   = "/crude.crude.Query/Params"
+	Query_Resource_FullMethodName                 = "/crude.crude.Query/Resource"
+	Query_ResourceAll_FullMethodName              = "/crude.crude.Query/ResourceAll"
+	Query_ResourcesByCreator_FullMethodName       = "/crude.crude.Query/ResourcesByCreator"
+	Query_ResourcesByOwner_FullMethodName         = "/crude.crude.Query/ResourcesByOwner"
+	Query_ResourceHistory_FullMethodName          = "/crude.crude.Query/ResourceHistory"
+	Query_ResourceAtRevision_FullMethodName       = "/crude.crude.Query/ResourceAtRevision"
+	Query_ResourceTransferOffer_FullMethodName    = "/crude.crude.Query/ResourceTransferOffer"
+	Query_ResourceTransferOfferAll_FullMethodName = "/crude.crude.Query/ResourceTransferOfferAll"
+	Query_ResourcesByAttribute_FullMethodName     = "/crude.crude.Query/ResourcesByAttribute"
+	Query_ResourcesByTag_FullMethodName           = "/crude.crude.Query/ResourcesByTag"
+	Query_ResourceRoles_FullMethodName            = "/crude.crude.Query/ResourceRoles"
+	Query_EditableResources_FullMethodName        = "/crude.crude.Query/EditableResources"
+	Query_ResourceSchema_FullMethodName           = "/crude.crude.Query/ResourceSchema"
+	Query_ResourceSchemaAll_FullMethodName        = "/crude.crude.Query/ResourceSchemaAll"
+	Query_Namespace_FullMethodName                = "/crude.crude.Query/Namespace"
+	Query_NamespaceAll_FullMethodName             = "/crude.crude.Query/NamespaceAll"
+	Query_ResourceByName_FullMethodName           = "/crude.crude.Query/ResourceByName"
+	Query_ResourcesByNamePrefix_FullMethodName    = "/crude.crude.Query/ResourcesByNamePrefix"
+	Query_ResourcesInNamespace_FullMethodName     = "/crude.crude.Query/ResourcesInNamespace"
+	Query_ResourceWithProof_FullMethodName        = "/crude.crude.Query/ResourceWithProof"
+	Query_ResourceStats_FullMethodName            = "/crude.crude.Query/ResourceStats"
+	Query_ResourceReplications_FullMethodName     = "/crude.crude.Query/ResourceReplications"
+	Query_ResourceReplica_FullMethodName          = "/crude.crude.Query/ResourceReplica"
 )
 
 // QueryClient is the client API for Query service.
@@ -33,6 +54,49 @@ type QueryClient interface {
 	// Queries a list of Resource items.
 	Resource(ctx context.Context, in *QueryGetResourceRequest, opts ...grpc.CallOption) (*QueryGetResourceResponse, error)
 	ResourceAll(ctx context.Context, in *QueryAllResourceRequest, opts ...grpc.CallOption) (*QueryAllResourceResponse, error)
+	// Queries a list of Resource items originally created by an address.
+	ResourcesByCreator(ctx context.Context, in *QueryResourcesByCreatorRequest, opts ...grpc.CallOption) (*QueryResourcesByCreatorResponse, error)
+	// Queries a list of Resource items currently owned by an address.
+	ResourcesByOwner(ctx context.Context, in *QueryResourcesByOwnerRequest, opts ...grpc.CallOption) (*QueryResourcesByOwnerResponse, error)
+	// Queries the revision history of a resource.
+	ResourceHistory(ctx context.Context, in *QueryResourceHistoryRequest, opts ...grpc.CallOption) (*QueryResourceHistoryResponse, error)
+	// Queries a single revision of a resource.
+	ResourceAtRevision(ctx context.Context, in *QueryResourceAtRevisionRequest, opts ...grpc.CallOption) (*QueryResourceAtRevisionResponse, error)
+	// Queries a list of pending ResourceTransferOffer items.
+	ResourceTransferOffer(ctx context.Context, in *QueryGetResourceTransferOfferRequest, opts ...grpc.CallOption) (*QueryGetResourceTransferOfferResponse, error)
+	ResourceTransferOfferAll(ctx context.Context, in *QueryAllResourceTransferOfferRequest, opts ...grpc.CallOption) (*QueryAllResourceTransferOfferResponse, error)
+	// Queries a list of Resource items carrying an attribute key/value pair.
+	ResourcesByAttribute(ctx context.Context, in *QueryResourcesByAttributeRequest, opts ...grpc.CallOption) (*QueryResourcesByAttributeResponse, error)
+	// Queries a list of Resource items carrying a tag.
+	ResourcesByTag(ctx context.Context, in *QueryResourcesByTagRequest, opts ...grpc.CallOption) (*QueryResourcesByTagResponse, error)
+	// Queries the roles granted on a resource.
+	ResourceRoles(ctx context.Context, in *QueryResourceRolesRequest, opts ...grpc.CallOption) (*QueryResourceRolesResponse, error)
+	// Queries the resources an address has been granted edit access to.
+	EditableResources(ctx context.Context, in *QueryEditableResourcesRequest, opts ...grpc.CallOption) (*QueryEditableResourcesResponse, error)
+	// Queries a version of a ResourceSchema, the latest one if version is zero.
+	ResourceSchema(ctx context.Context, in *QueryGetResourceSchemaRequest, opts ...grpc.CallOption) (*QueryGetResourceSchemaResponse, error)
+	ResourceSchemaAll(ctx context.Context, in *QueryAllResourceSchemaRequest, opts ...grpc.CallOption) (*QueryAllResourceSchemaResponse, error)
+	// Queries a list of Namespace items.
+	Namespace(ctx context.Context, in *QueryGetNamespaceRequest, opts ...grpc.CallOption) (*QueryGetNamespaceResponse, error)
+	NamespaceAll(ctx context.Context, in *QueryAllNamespaceRequest, opts ...grpc.CallOption) (*QueryAllNamespaceResponse, error)
+	// Queries a resource by name, the one with the lowest id if several share
+	// the name.
+	ResourceByName(ctx context.Context, in *QueryResourceByNameRequest, opts ...grpc.CallOption) (*QueryResourceByNameResponse, error)
+	// Queries a list of Resource items whose name starts with a prefix, in name
+	// order.
+	ResourcesByNamePrefix(ctx context.Context, in *QueryResourcesByNamePrefixRequest, opts ...grpc.CallOption) (*QueryResourcesByNamePrefixResponse, error)
+	// Queries a list of Resource items of a namespace.
+	ResourcesInNamespace(ctx context.Context, in *QueryResourcesInNamespaceRequest, opts ...grpc.CallOption) (*QueryResourcesInNamespaceResponse, error)
+	// Queries a resource along with a Merkle proof of its presence, or of its
+	// absence, against the app hash.
+	ResourceWithProof(ctx context.Context, in *QueryResourceWithProofRequest, opts ...grpc.CallOption) (*QueryResourceWithProofResponse, error)
+	// Queries aggregate statistics over the live resources.
+	ResourceStats(ctx context.Context, in *QueryResourceStatsRequest, opts ...grpc.CallOption) (*QueryResourceStatsResponse, error)
+	// Queries the IBC channels a resource is replicated on.
+	ResourceReplications(ctx context.Context, in *QueryResourceReplicationsRequest, opts ...grpc.CallOption) (*QueryResourceReplicationsResponse, error)
+	// Queries the replica received over an IBC channel of a resource of the
+	// counterparty chain.
+	ResourceReplica(ctx context.Context, in *QueryResourceReplicaRequest, opts ...grpc.CallOption) (*QueryResourceReplicaResponse, error)
 }
 
 type queryClient struct {
@@ -70,6 +134,195 @@ func (c *queryClient) ResourceAll(ctx context.Context, in *QueryAllResourceReque
 	return out, nil
 }
 
+func (c *queryClient) ResourcesByCreator(ctx context.Context, in *QueryResourcesByCreatorRequest, opts ...grpc.CallOption) (*QueryResourcesByCreatorResponse, error) {
+	out := new(QueryResourcesByCreatorResponse)
+	err := c.cc.Invoke(ctx, Query_ResourcesByCreator_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ResourcesByOwner(ctx context.Context, in *QueryResourcesByOwnerRequest, opts ...grpc.CallOption) (*QueryResourcesByOwnerResponse, error) {
+	out := new(QueryResourcesByOwnerResponse)
+	err := c.cc.Invoke(ctx, Query_ResourcesByOwner_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ResourceHistory(ctx context.Context, in *QueryResourceHistoryRequest, opts ...grpc.CallOption) (*QueryResourceHistoryResponse, error) {
+	out := new(QueryResourceHistoryResponse)
+	err := c.cc.Invoke(ctx, Query_ResourceHistory_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ResourceAtRevision(ctx context.Context, in *QueryResourceAtRevisionRequest, opts ...grpc.CallOption) (*QueryResourceAtRevisionResponse, error) {
+	out := new(QueryResourceAtRevisionResponse)
+	err := c.cc.Invoke(ctx, Query_ResourceAtRevision_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ResourceTransferOffer(ctx context.Context, in *QueryGetResourceTransferOfferRequest, opts ...grpc.CallOption) (*QueryGetResourceTransferOfferResponse, error) {
+	out := new(QueryGetResourceTransferOfferResponse)
+	err := c.cc.Invoke(ctx, Query_ResourceTransferOffer_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ResourceTransferOfferAll(ctx context.Context, in *QueryAllResourceTransferOfferRequest, opts ...grpc.CallOption) (*QueryAllResourceTransferOfferResponse, error) {
+	out := new(QueryAllResourceTransferOfferResponse)
+	err := c.cc.Invoke(ctx, Query_ResourceTransferOfferAll_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ResourcesByAttribute(ctx context.Context, in *QueryResourcesByAttributeRequest, opts ...grpc.CallOption) (*QueryResourcesByAttributeResponse, error) {
+	out := new(QueryResourcesByAttributeResponse)
+	err := c.cc.Invoke(ctx, Query_ResourcesByAttribute_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ResourcesByTag(ctx context.Context, in *QueryResourcesByTagRequest, opts ...grpc.CallOption) (*QueryResourcesByTagResponse, error) {
+	out := new(QueryResourcesByTagResponse)
+	err := c.cc.Invoke(ctx, Query_ResourcesByTag_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ResourceRoles(ctx context.Context, in *QueryResourceRolesRequest, opts ...grpc.CallOption) (*QueryResourceRolesResponse, error) {
+	out := new(QueryResourceRolesResponse)
+	err := c.cc.Invoke(ctx, Query_ResourceRoles_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EditableResources(ctx context.Context, in *QueryEditableResourcesRequest, opts ...grpc.CallOption) (*QueryEditableResourcesResponse, error) {
+	out := new(QueryEditableResourcesResponse)
+	err := c.cc.Invoke(ctx, Query_EditableResources_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ResourceSchema(ctx context.Context, in *QueryGetResourceSchemaRequest, opts ...grpc.CallOption) (*QueryGetResourceSchemaResponse, error) {
+	out := new(QueryGetResourceSchemaResponse)
+	err := c.cc.Invoke(ctx, Query_ResourceSchema_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ResourceSchemaAll(ctx context.Context, in *QueryAllResourceSchemaRequest, opts ...grpc.CallOption) (*QueryAllResourceSchemaResponse, error) {
+	out := new(QueryAllResourceSchemaResponse)
+	err := c.cc.Invoke(ctx, Query_ResourceSchemaAll_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Namespace(ctx context.Context, in *QueryGetNamespaceRequest, opts ...grpc.CallOption) (*QueryGetNamespaceResponse, error) {
+	out := new(QueryGetNamespaceResponse)
+	err := c.cc.Invoke(ctx, Query_Namespace_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) NamespaceAll(ctx context.Context, in *QueryAllNamespaceRequest, opts ...grpc.CallOption) (*QueryAllNamespaceResponse, error) {
+	out := new(QueryAllNamespaceResponse)
+	err := c.cc.Invoke(ctx, Query_NamespaceAll_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ResourceByName(ctx context.Context, in *QueryResourceByNameRequest, opts ...grpc.CallOption) (*QueryResourceByNameResponse, error) {
+	out := new(QueryResourceByNameResponse)
+	err := c.cc.Invoke(ctx, Query_ResourceByName_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ResourcesByNamePrefix(ctx context.Context, in *QueryResourcesByNamePrefixRequest, opts ...grpc.CallOption) (*QueryResourcesByNamePrefixResponse, error) {
+	out := new(QueryResourcesByNamePrefixResponse)
+	err := c.cc.Invoke(ctx, Query_ResourcesByNamePrefix_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ResourcesInNamespace(ctx context.Context, in *QueryResourcesInNamespaceRequest, opts ...grpc.CallOption) (*QueryResourcesInNamespaceResponse, error) {
+	out := new(QueryResourcesInNamespaceResponse)
+	err := c.cc.Invoke(ctx, Query_ResourcesInNamespace_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ResourceWithProof(ctx context.Context, in *QueryResourceWithProofRequest, opts ...grpc.CallOption) (*QueryResourceWithProofResponse, error) {
+	out := new(QueryResourceWithProofResponse)
+	err := c.cc.Invoke(ctx, Query_ResourceWithProof_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ResourceStats(ctx context.Context, in *QueryResourceStatsRequest, opts ...grpc.CallOption) (*QueryResourceStatsResponse, error) {
+	out := new(QueryResourceStatsResponse)
+	err := c.cc.Invoke(ctx, Query_ResourceStats_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ResourceReplications(ctx context.Context, in *QueryResourceReplicationsRequest, opts ...grpc.CallOption) (*QueryResourceReplicationsResponse, error) {
+	out := new(QueryResourceReplicationsResponse)
+	err := c.cc.Invoke(ctx, Query_ResourceReplications_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ResourceReplica(ctx context.Context, in *QueryResourceReplicaRequest, opts ...grpc.CallOption) (*QueryResourceReplicaResponse, error) {
+	out := new(QueryResourceReplicaResponse)
+	err := c.cc.Invoke(ctx, Query_ResourceReplica_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -79,6 +332,49 @@ type QueryServer interface {
 	// Queries a list of Resource items.
 	Resource(context.Context, *QueryGetResourceRequest) (*QueryGetResourceResponse, error)
 	ResourceAll(context.Context, *QueryAllResourceRequest) (*QueryAllResourceResponse, error)
+	// Queries a list of Resource items originally created by an address.
+	ResourcesByCreator(context.Context, *QueryResourcesByCreatorRequest) (*QueryResourcesByCreatorResponse, error)
+	// Queries a list of Resource items currently owned by an address.
+	ResourcesByOwner(context.Context, *QueryResourcesByOwnerRequest) (*QueryResourcesByOwnerResponse, error)
+	// Queries the revision history of a resource.
+	ResourceHistory(context.Context, *QueryResourceHistoryRequest) (*QueryResourceHistoryResponse, error)
+	// Queries a single revision of a resource.
+	ResourceAtRevision(context.Context, *QueryResourceAtRevisionRequest) (*QueryResourceAtRevisionResponse, error)
+	// Queries a list of pending ResourceTransferOffer items.
+	ResourceTransferOffer(context.Context, *QueryGetResourceTransferOfferRequest) (*QueryGetResourceTransferOfferResponse, error)
+	ResourceTransferOfferAll(context.Context, *QueryAllResourceTransferOfferRequest) (*QueryAllResourceTransferOfferResponse, error)
+	// Queries a list of Resource items carrying an attribute key/value pair.
+	ResourcesByAttribute(context.Context, *QueryResourcesByAttributeRequest) (*QueryResourcesByAttributeResponse, error)
+	// Queries a list of Resource items carrying a tag.
+	ResourcesByTag(context.Context, *QueryResourcesByTagRequest) (*QueryResourcesByTagResponse, error)
+	// Queries the roles granted on a resource.
+	ResourceRoles(context.Context, *QueryResourceRolesRequest) (*QueryResourceRolesResponse, error)
+	// Queries the resources an address has been granted edit access to.
+	EditableResources(context.Context, *QueryEditableResourcesRequest) (*QueryEditableResourcesResponse, error)
+	// Queries a version of a ResourceSchema, the latest one if version is zero.
+	ResourceSchema(context.Context, *QueryGetResourceSchemaRequest) (*QueryGetResourceSchemaResponse, error)
+	ResourceSchemaAll(context.Context, *QueryAllResourceSchemaRequest) (*QueryAllResourceSchemaResponse, error)
+	// Queries a list of Namespace items.
+	Namespace(context.Context, *QueryGetNamespaceRequest) (*QueryGetNamespaceResponse, error)
+	NamespaceAll(context.Context, *QueryAllNamespaceRequest) (*QueryAllNamespaceResponse, error)
+	// Queries a resource by name, the one with the lowest id if several share
+	// the name.
+	ResourceByName(context.Context, *QueryResourceByNameRequest) (*QueryResourceByNameResponse, error)
+	// Queries a list of Resource items whose name starts with a prefix, in name
+	// order.
+	ResourcesByNamePrefix(context.Context, *QueryResourcesByNamePrefixRequest) (*QueryResourcesByNamePrefixResponse, error)
+	// Queries a list of Resource items of a namespace.
+	ResourcesInNamespace(context.Context, *QueryResourcesInNamespaceRequest) (*QueryResourcesInNamespaceResponse, error)
+	// Queries a resource along with a Merkle proof of its presence, or of its
+	// absence, against the app hash.
+	ResourceWithProof(context.Context, *QueryResourceWithProofRequest) (*QueryResourceWithProofResponse, error)
+	// Queries aggregate statistics over the live resources.
+	ResourceStats(context.Context, *QueryResourceStatsRequest) (*QueryResourceStatsResponse, error)
+	// Queries the IBC channels a resource is replicated on.
+	ResourceReplications(context.Context, *QueryResourceReplicationsRequest) (*QueryResourceReplicationsResponse, error)
+	// Queries the replica received over an IBC channel of a resource of the
+	// counterparty chain.
+	ResourceReplica(context.Context, *QueryResourceReplicaRequest) (*QueryResourceReplicaResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -95,6 +391,69 @@ func (UnimplementedQueryServer) Resource(context.Context, *QueryGetResourceReque
 func (UnimplementedQueryServer) ResourceAll(context.Context, *QueryAllResourceRequest) (*QueryAllResourceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ResourceAll not implemented")
 }
+func (UnimplementedQueryServer) ResourcesByCreator(context.Context, *QueryResourcesByCreatorRequest) (*QueryResourcesByCreatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResourcesByCreator not implemented")
+}
+func (UnimplementedQueryServer) ResourcesByOwner(context.Context, *QueryResourcesByOwnerRequest) (*QueryResourcesByOwnerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResourcesByOwner not implemented")
+}
+func (UnimplementedQueryServer) ResourceHistory(context.Context, *QueryResourceHistoryRequest) (*QueryResourceHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResourceHistory not implemented")
+}
+func (UnimplementedQueryServer) ResourceAtRevision(context.Context, *QueryResourceAtRevisionRequest) (*QueryResourceAtRevisionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResourceAtRevision not implemented")
+}
+func (UnimplementedQueryServer) ResourceTransferOffer(context.Context, *QueryGetResourceTransferOfferRequest) (*QueryGetResourceTransferOfferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResourceTransferOffer not implemented")
+}
+func (UnimplementedQueryServer) ResourceTransferOfferAll(context.Context, *QueryAllResourceTransferOfferRequest) (*QueryAllResourceTransferOfferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResourceTransferOfferAll not implemented")
+}
+func (UnimplementedQueryServer) ResourcesByAttribute(context.Context, *QueryResourcesByAttributeRequest) (*QueryResourcesByAttributeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResourcesByAttribute not implemented")
+}
+func (UnimplementedQueryServer) ResourcesByTag(context.Context, *QueryResourcesByTagRequest) (*QueryResourcesByTagResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResourcesByTag not implemented")
+}
+func (UnimplementedQueryServer) ResourceRoles(context.Context, *QueryResourceRolesRequest) (*QueryResourceRolesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResourceRoles not implemented")
+}
+func (UnimplementedQueryServer) EditableResources(context.Context, *QueryEditableResourcesRequest) (*QueryEditableResourcesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EditableResources not implemented")
+}
+func (UnimplementedQueryServer) ResourceSchema(context.Context, *QueryGetResourceSchemaRequest) (*QueryGetResourceSchemaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResourceSchema not implemented")
+}
+func (UnimplementedQueryServer) ResourceSchemaAll(context.Context, *QueryAllResourceSchemaRequest) (*QueryAllResourceSchemaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResourceSchemaAll not implemented")
+}
+func (UnimplementedQueryServer) Namespace(context.Context, *QueryGetNamespaceRequest) (*QueryGetNamespaceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Namespace not implemented")
+}
+func (UnimplementedQueryServer) NamespaceAll(context.Context, *QueryAllNamespaceRequest) (*QueryAllNamespaceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NamespaceAll not implemented")
+}
+func (UnimplementedQueryServer) ResourceByName(context.Context, *QueryResourceByNameRequest) (*QueryResourceByNameResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResourceByName not implemented")
+}
+func (UnimplementedQueryServer) ResourcesByNamePrefix(context.Context, *QueryResourcesByNamePrefixRequest) (*QueryResourcesByNamePrefixResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResourcesByNamePrefix not implemented")
+}
+func (UnimplementedQueryServer) ResourcesInNamespace(context.Context, *QueryResourcesInNamespaceRequest) (*QueryResourcesInNamespaceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResourcesInNamespace not implemented")
+}
+func (UnimplementedQueryServer) ResourceWithProof(context.Context, *QueryResourceWithProofRequest) (*QueryResourceWithProofResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResourceWithProof not implemented")
+}
+func (UnimplementedQueryServer) ResourceStats(context.Context, *QueryResourceStatsRequest) (*QueryResourceStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResourceStats not implemented")
+}
+func (UnimplementedQueryServer) ResourceReplications(context.Context, *QueryResourceReplicationsRequest) (*QueryResourceReplicationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResourceReplications not implemented")
+}
+func (UnimplementedQueryServer) ResourceReplica(context.Context, *QueryResourceReplicaRequest) (*QueryResourceReplicaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResourceReplica not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -162,6 +521,384 @@ func _Query_ResourceAll_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ResourcesByCreator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryResourcesByCreatorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ResourcesByCreator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ResourcesByCreator_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ResourcesByCreator(ctx, req.(*QueryResourcesByCreatorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ResourcesByOwner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryResourcesByOwnerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ResourcesByOwner(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ResourcesByOwner_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ResourcesByOwner(ctx, req.(*QueryResourcesByOwnerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ResourceHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryResourceHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ResourceHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ResourceHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ResourceHistory(ctx, req.(*QueryResourceHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ResourceAtRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryResourceAtRevisionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ResourceAtRevision(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ResourceAtRevision_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ResourceAtRevision(ctx, req.(*QueryResourceAtRevisionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ResourceTransferOffer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetResourceTransferOfferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ResourceTransferOffer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ResourceTransferOffer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ResourceTransferOffer(ctx, req.(*QueryGetResourceTransferOfferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ResourceTransferOfferAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllResourceTransferOfferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ResourceTransferOfferAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ResourceTransferOfferAll_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ResourceTransferOfferAll(ctx, req.(*QueryAllResourceTransferOfferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ResourcesByAttribute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryResourcesByAttributeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ResourcesByAttribute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ResourcesByAttribute_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ResourcesByAttribute(ctx, req.(*QueryResourcesByAttributeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ResourcesByTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryResourcesByTagRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ResourcesByTag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ResourcesByTag_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ResourcesByTag(ctx, req.(*QueryResourcesByTagRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ResourceRoles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryResourceRolesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ResourceRoles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ResourceRoles_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ResourceRoles(ctx, req.(*QueryResourceRolesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EditableResources_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEditableResourcesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EditableResources(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_EditableResources_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EditableResources(ctx, req.(*QueryEditableResourcesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ResourceSchema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetResourceSchemaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ResourceSchema(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ResourceSchema_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ResourceSchema(ctx, req.(*QueryGetResourceSchemaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ResourceSchemaAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllResourceSchemaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ResourceSchemaAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ResourceSchemaAll_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ResourceSchemaAll(ctx, req.(*QueryAllResourceSchemaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Namespace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetNamespaceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Namespace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Namespace_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Namespace(ctx, req.(*QueryGetNamespaceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_NamespaceAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllNamespaceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).NamespaceAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_NamespaceAll_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).NamespaceAll(ctx, req.(*QueryAllNamespaceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ResourceByName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryResourceByNameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ResourceByName(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ResourceByName_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ResourceByName(ctx, req.(*QueryResourceByNameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ResourcesByNamePrefix_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryResourcesByNamePrefixRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ResourcesByNamePrefix(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ResourcesByNamePrefix_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ResourcesByNamePrefix(ctx, req.(*QueryResourcesByNamePrefixRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ResourcesInNamespace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryResourcesInNamespaceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ResourcesInNamespace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ResourcesInNamespace_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ResourcesInNamespace(ctx, req.(*QueryResourcesInNamespaceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ResourceWithProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryResourceWithProofRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ResourceWithProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ResourceWithProof_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ResourceWithProof(ctx, req.(*QueryResourceWithProofRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ResourceStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryResourceStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ResourceStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ResourceStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ResourceStats(ctx, req.(*QueryResourceStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ResourceReplications_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryResourceReplicationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ResourceReplications(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ResourceReplications_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ResourceReplications(ctx, req.(*QueryResourceReplicationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ResourceReplica_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryResourceReplicaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ResourceReplica(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ResourceReplica_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ResourceReplica(ctx, req.(*QueryResourceReplicaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -181,6 +918,90 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ResourceAll",
 			Handler:    _Query_ResourceAll_Handler,
 		},
+		{
+			MethodName: "ResourcesByCreator",
+			Handler:    _Query_ResourcesByCreator_Handler,
+		},
+		{
+			MethodName: "ResourcesByOwner",
+			Handler:    _Query_ResourcesByOwner_Handler,
+		},
+		{
+			MethodName: "ResourceHistory",
+			Handler:    _Query_ResourceHistory_Handler,
+		},
+		{
+			MethodName: "ResourceAtRevision",
+			Handler:    _Query_ResourceAtRevision_Handler,
+		},
+		{
+			MethodName: "ResourceTransferOffer",
+			Handler:    _Query_ResourceTransferOffer_Handler,
+		},
+		{
+			MethodName: "ResourceTransferOfferAll",
+			Handler:    _Query_ResourceTransferOfferAll_Handler,
+		},
+		{
+			MethodName: "ResourcesByAttribute",
+			Handler:    _Query_ResourcesByAttribute_Handler,
+		},
+		{
+			MethodName: "ResourcesByTag",
+			Handler:    _Query_ResourcesByTag_Handler,
+		},
+		{
+			MethodName: "ResourceRoles",
+			Handler:    _Query_ResourceRoles_Handler,
+		},
+		{
+			MethodName: "EditableResources",
+			Handler:    _Query_EditableResources_Handler,
+		},
+		{
+			MethodName: "ResourceSchema",
+			Handler:    _Query_ResourceSchema_Handler,
+		},
+		{
+			MethodName: "ResourceSchemaAll",
+			Handler:    _Query_ResourceSchemaAll_Handler,
+		},
+		{
+			MethodName: "Namespace",
+			Handler:    _Query_Namespace_Handler,
+		},
+		{
+			MethodName: "NamespaceAll",
+			Handler:    _Query_NamespaceAll_Handler,
+		},
+		{
+			MethodName: "ResourceByName",
+			Handler:    _Query_ResourceByName_Handler,
+		},
+		{
+			MethodName: "ResourcesByNamePrefix",
+			Handler:    _Query_ResourcesByNamePrefix_Handler,
+		},
+		{
+			MethodName: "ResourcesInNamespace",
+			Handler:    _Query_ResourcesInNamespace_Handler,
+		},
+		{
+			MethodName: "ResourceWithProof",
+			Handler:    _Query_ResourceWithProof_Handler,
+		},
+		{
+			MethodName: "ResourceStats",
+			Handler:    _Query_ResourceStats_Handler,
+		},
+		{
+			MethodName: "ResourceReplications",
+			Handler:    _Query_ResourceReplications_Handler,
+		},
+		{
+			MethodName: "ResourceReplica",
+			Handler:    _Query_ResourceReplica_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "crude/crude/query.proto",
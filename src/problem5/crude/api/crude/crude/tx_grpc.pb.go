@@ -19,10 +19,26 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Msg_UpdateParams_FullMethodName   = "/crude.crude.Msg/UpdateParams"
-	Msg_CreateResource_FullMethodName = "/crude.crude.Msg/CreateResource"
-	Msg_UpdateResource_FullMethodName = "/crude.crude.Msg/UpdateResource"
-	Msg_DeleteResource_FullMethodName = "/crude.crude.Msg/DeleteResource"
+	Msg_UpdateParams_FullMethodName            = "/crude.crude.Msg/UpdateParams"
+	Msg_CreateResource_FullMethodName          = "/crude.crude.Msg/CreateResource"
+	Msg_UpdateResource_FullMethodName          = "/crude.crude.Msg/UpdateResource"
+	Msg_DeleteResource_FullMethodName          = "/crude.crude.Msg/DeleteResource"
+	Msg_RevertResource_FullMethodName          = "/crude.crude.Msg/RevertResource"
+	Msg_OfferResourceTransfer_FullMethodName   = "/crude.crude.Msg/OfferResourceTransfer"
+	Msg_AcceptResourceTransfer_FullMethodName  = "/crude.crude.Msg/AcceptResourceTransfer"
+	Msg_CancelResourceTransfer_FullMethodName  = "/crude.crude.Msg/CancelResourceTransfer"
+	Msg_GrantResourceRole_FullMethodName       = "/crude.crude.Msg/GrantResourceRole"
+	Msg_RevokeResourceRole_FullMethodName      = "/crude.crude.Msg/RevokeResourceRole"
+	Msg_RenewResource_FullMethodName           = "/crude.crude.Msg/RenewResource"
+	Msg_BatchResourceOps_FullMethodName        = "/crude.crude.Msg/BatchResourceOps"
+	Msg_AdjustResourceValue_FullMethodName     = "/crude.crude.Msg/AdjustResourceValue"
+	Msg_SetResourceAttribute_FullMethodName    = "/crude.crude.Msg/SetResourceAttribute"
+	Msg_RemoveResourceAttribute_FullMethodName = "/crude.crude.Msg/RemoveResourceAttribute"
+	Msg_RegisterResourceSchema_FullMethodName  = "/crude.crude.Msg/RegisterResourceSchema"
+	Msg_CreateNamespace_FullMethodName         = "/crude.crude.Msg/CreateNamespace"
+	Msg_UpdateNamespace_FullMethodName         = "/crude.crude.Msg/UpdateNamespace"
+	Msg_ReplicateResource_FullMethodName       = "/crude.crude.Msg/ReplicateResource"
+	Msg_ApproveResourceSchema_FullMethodName   = "/crude.crude.Msg/ApproveResourceSchema"
 )
 
 // MsgClient is the client API for Msg service.
@@ -35,6 +51,24 @@ type MsgClient interface {
 	CreateResource(ctx context.Context, in *MsgCreateResource, opts ...grpc.CallOption) (*MsgCreateResourceResponse, error)
 	UpdateResource(ctx context.Context, in *MsgUpdateResource, opts ...grpc.CallOption) (*MsgUpdateResourceResponse, error)
 	DeleteResource(ctx context.Context, in *MsgDeleteResource, opts ...grpc.CallOption) (*MsgDeleteResourceResponse, error)
+	RevertResource(ctx context.Context, in *MsgRevertResource, opts ...grpc.CallOption) (*MsgRevertResourceResponse, error)
+	OfferResourceTransfer(ctx context.Context, in *MsgOfferResourceTransfer, opts ...grpc.CallOption) (*MsgOfferResourceTransferResponse, error)
+	AcceptResourceTransfer(ctx context.Context, in *MsgAcceptResourceTransfer, opts ...grpc.CallOption) (*MsgAcceptResourceTransferResponse, error)
+	CancelResourceTransfer(ctx context.Context, in *MsgCancelResourceTransfer, opts ...grpc.CallOption) (*MsgCancelResourceTransferResponse, error)
+	GrantResourceRole(ctx context.Context, in *MsgGrantResourceRole, opts ...grpc.CallOption) (*MsgGrantResourceRoleResponse, error)
+	RevokeResourceRole(ctx context.Context, in *MsgRevokeResourceRole, opts ...grpc.CallOption) (*MsgRevokeResourceRoleResponse, error)
+	RenewResource(ctx context.Context, in *MsgRenewResource, opts ...grpc.CallOption) (*MsgRenewResourceResponse, error)
+	BatchResourceOps(ctx context.Context, in *MsgBatchResourceOps, opts ...grpc.CallOption) (*MsgBatchResourceOpsResponse, error)
+	AdjustResourceValue(ctx context.Context, in *MsgAdjustResourceValue, opts ...grpc.CallOption) (*MsgAdjustResourceValueResponse, error)
+	SetResourceAttribute(ctx context.Context, in *MsgSetResourceAttribute, opts ...grpc.CallOption) (*MsgSetResourceAttributeResponse, error)
+	RemoveResourceAttribute(ctx context.Context, in *MsgRemoveResourceAttribute, opts ...grpc.CallOption) (*MsgRemoveResourceAttributeResponse, error)
+	RegisterResourceSchema(ctx context.Context, in *MsgRegisterResourceSchema, opts ...grpc.CallOption) (*MsgRegisterResourceSchemaResponse, error)
+	CreateNamespace(ctx context.Context, in *MsgCreateNamespace, opts ...grpc.CallOption) (*MsgCreateNamespaceResponse, error)
+	UpdateNamespace(ctx context.Context, in *MsgUpdateNamespace, opts ...grpc.CallOption) (*MsgUpdateNamespaceResponse, error)
+	ReplicateResource(ctx context.Context, in *MsgReplicateResource, opts ...grpc.CallOption) (*MsgReplicateResourceResponse, error)
+	// ApproveResourceSchema defines a (governance) operation for approving a
+	// version of a resource schema.
+	ApproveResourceSchema(ctx context.Context, in *MsgApproveResourceSchema, opts ...grpc.CallOption) (*MsgApproveResourceSchemaResponse, error)
 }
 
 type msgClient struct {
@@ -81,6 +115,150 @@ func (c *msgClient) DeleteResource(ctx context.Context, in *MsgDeleteResource, o
 	return out, nil
 }
 
+func (c *msgClient) RevertResource(ctx context.Context, in *MsgRevertResource, opts ...grpc.CallOption) (*MsgRevertResourceResponse, error) {
+	out := new(MsgRevertResourceResponse)
+	err := c.cc.Invoke(ctx, Msg_RevertResource_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) OfferResourceTransfer(ctx context.Context, in *MsgOfferResourceTransfer, opts ...grpc.CallOption) (*MsgOfferResourceTransferResponse, error) {
+	out := new(MsgOfferResourceTransferResponse)
+	err := c.cc.Invoke(ctx, Msg_OfferResourceTransfer_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AcceptResourceTransfer(ctx context.Context, in *MsgAcceptResourceTransfer, opts ...grpc.CallOption) (*MsgAcceptResourceTransferResponse, error) {
+	out := new(MsgAcceptResourceTransferResponse)
+	err := c.cc.Invoke(ctx, Msg_AcceptResourceTransfer_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelResourceTransfer(ctx context.Context, in *MsgCancelResourceTransfer, opts ...grpc.CallOption) (*MsgCancelResourceTransferResponse, error) {
+	out := new(MsgCancelResourceTransferResponse)
+	err := c.cc.Invoke(ctx, Msg_CancelResourceTransfer_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) GrantResourceRole(ctx context.Context, in *MsgGrantResourceRole, opts ...grpc.CallOption) (*MsgGrantResourceRoleResponse, error) {
+	out := new(MsgGrantResourceRoleResponse)
+	err := c.cc.Invoke(ctx, Msg_GrantResourceRole_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RevokeResourceRole(ctx context.Context, in *MsgRevokeResourceRole, opts ...grpc.CallOption) (*MsgRevokeResourceRoleResponse, error) {
+	out := new(MsgRevokeResourceRoleResponse)
+	err := c.cc.Invoke(ctx, Msg_RevokeResourceRole_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RenewResource(ctx context.Context, in *MsgRenewResource, opts ...grpc.CallOption) (*MsgRenewResourceResponse, error) {
+	out := new(MsgRenewResourceResponse)
+	err := c.cc.Invoke(ctx, Msg_RenewResource_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) BatchResourceOps(ctx context.Context, in *MsgBatchResourceOps, opts ...grpc.CallOption) (*MsgBatchResourceOpsResponse, error) {
+	out := new(MsgBatchResourceOpsResponse)
+	err := c.cc.Invoke(ctx, Msg_BatchResourceOps_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AdjustResourceValue(ctx context.Context, in *MsgAdjustResourceValue, opts ...grpc.CallOption) (*MsgAdjustResourceValueResponse, error) {
+	out := new(MsgAdjustResourceValueResponse)
+	err := c.cc.Invoke(ctx, Msg_AdjustResourceValue_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetResourceAttribute(ctx context.Context, in *MsgSetResourceAttribute, opts ...grpc.CallOption) (*MsgSetResourceAttributeResponse, error) {
+	out := new(MsgSetResourceAttributeResponse)
+	err := c.cc.Invoke(ctx, Msg_SetResourceAttribute_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveResourceAttribute(ctx context.Context, in *MsgRemoveResourceAttribute, opts ...grpc.CallOption) (*MsgRemoveResourceAttributeResponse, error) {
+	out := new(MsgRemoveResourceAttributeResponse)
+	err := c.cc.Invoke(ctx, Msg_RemoveResourceAttribute_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RegisterResourceSchema(ctx context.Context, in *MsgRegisterResourceSchema, opts ...grpc.CallOption) (*MsgRegisterResourceSchemaResponse, error) {
+	out := new(MsgRegisterResourceSchemaResponse)
+	err := c.cc.Invoke(ctx, Msg_RegisterResourceSchema_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CreateNamespace(ctx context.Context, in *MsgCreateNamespace, opts ...grpc.CallOption) (*MsgCreateNamespaceResponse, error) {
+	out := new(MsgCreateNamespaceResponse)
+	err := c.cc.Invoke(ctx, Msg_CreateNamespace_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateNamespace(ctx context.Context, in *MsgUpdateNamespace, opts ...grpc.CallOption) (*MsgUpdateNamespaceResponse, error) {
+	out := new(MsgUpdateNamespaceResponse)
+	err := c.cc.Invoke(ctx, Msg_UpdateNamespace_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ReplicateResource(ctx context.Context, in *MsgReplicateResource, opts ...grpc.CallOption) (*MsgReplicateResourceResponse, error) {
+	out := new(MsgReplicateResourceResponse)
+	err := c.cc.Invoke(ctx, Msg_ReplicateResource_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ApproveResourceSchema(ctx context.Context, in *MsgApproveResourceSchema, opts ...grpc.CallOption) (*MsgApproveResourceSchemaResponse, error) {
+	out := new(MsgApproveResourceSchemaResponse)
+	err := c.cc.Invoke(ctx, Msg_ApproveResourceSchema_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -91,6 +269,24 @@ type MsgServer interface {
 	CreateResource(context.Context, *MsgCreateResource) (*MsgCreateResourceResponse, error)
 	UpdateResource(context.Context, *MsgUpdateResource) (*MsgUpdateResourceResponse, error)
 	DeleteResource(context.Context, *MsgDeleteResource) (*MsgDeleteResourceResponse, error)
+	RevertResource(context.Context, *MsgRevertResource) (*MsgRevertResourceResponse, error)
+	OfferResourceTransfer(context.Context, *MsgOfferResourceTransfer) (*MsgOfferResourceTransferResponse, error)
+	AcceptResourceTransfer(context.Context, *MsgAcceptResourceTransfer) (*MsgAcceptResourceTransferResponse, error)
+	CancelResourceTransfer(context.Context, *MsgCancelResourceTransfer) (*MsgCancelResourceTransferResponse, error)
+	GrantResourceRole(context.Context, *MsgGrantResourceRole) (*MsgGrantResourceRoleResponse, error)
+	RevokeResourceRole(context.Context, *MsgRevokeResourceRole) (*MsgRevokeResourceRoleResponse, error)
+	RenewResource(context.Context, *MsgRenewResource) (*MsgRenewResourceResponse, error)
+	BatchResourceOps(context.Context, *MsgBatchResourceOps) (*MsgBatchResourceOpsResponse, error)
+	AdjustResourceValue(context.Context, *MsgAdjustResourceValue) (*MsgAdjustResourceValueResponse, error)
+	SetResourceAttribute(context.Context, *MsgSetResourceAttribute) (*MsgSetResourceAttributeResponse, error)
+	RemoveResourceAttribute(context.Context, *MsgRemoveResourceAttribute) (*MsgRemoveResourceAttributeResponse, error)
+	RegisterResourceSchema(context.Context, *MsgRegisterResourceSchema) (*MsgRegisterResourceSchemaResponse, error)
+	CreateNamespace(context.Context, *MsgCreateNamespace) (*MsgCreateNamespaceResponse, error)
+	UpdateNamespace(context.Context, *MsgUpdateNamespace) (*MsgUpdateNamespaceResponse, error)
+	ReplicateResource(context.Context, *MsgReplicateResource) (*MsgReplicateResourceResponse, error)
+	// ApproveResourceSchema defines a (governance) operation for approving a
+	// version of a resource schema.
+	ApproveResourceSchema(context.Context, *MsgApproveResourceSchema) (*MsgApproveResourceSchemaResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -110,6 +306,54 @@ func (UnimplementedMsgServer) UpdateResource(context.Context, *MsgUpdateResource
 func (UnimplementedMsgServer) DeleteResource(context.Context, *MsgDeleteResource) (*MsgDeleteResourceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteResource not implemented")
 }
+func (UnimplementedMsgServer) RevertResource(context.Context, *MsgRevertResource) (*MsgRevertResourceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevertResource not implemented")
+}
+func (UnimplementedMsgServer) OfferResourceTransfer(context.Context, *MsgOfferResourceTransfer) (*MsgOfferResourceTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OfferResourceTransfer not implemented")
+}
+func (UnimplementedMsgServer) AcceptResourceTransfer(context.Context, *MsgAcceptResourceTransfer) (*MsgAcceptResourceTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptResourceTransfer not implemented")
+}
+func (UnimplementedMsgServer) CancelResourceTransfer(context.Context, *MsgCancelResourceTransfer) (*MsgCancelResourceTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelResourceTransfer not implemented")
+}
+func (UnimplementedMsgServer) GrantResourceRole(context.Context, *MsgGrantResourceRole) (*MsgGrantResourceRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GrantResourceRole not implemented")
+}
+func (UnimplementedMsgServer) RevokeResourceRole(context.Context, *MsgRevokeResourceRole) (*MsgRevokeResourceRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeResourceRole not implemented")
+}
+func (UnimplementedMsgServer) RenewResource(context.Context, *MsgRenewResource) (*MsgRenewResourceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenewResource not implemented")
+}
+func (UnimplementedMsgServer) BatchResourceOps(context.Context, *MsgBatchResourceOps) (*MsgBatchResourceOpsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchResourceOps not implemented")
+}
+func (UnimplementedMsgServer) AdjustResourceValue(context.Context, *MsgAdjustResourceValue) (*MsgAdjustResourceValueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdjustResourceValue not implemented")
+}
+func (UnimplementedMsgServer) SetResourceAttribute(context.Context, *MsgSetResourceAttribute) (*MsgSetResourceAttributeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetResourceAttribute not implemented")
+}
+func (UnimplementedMsgServer) RemoveResourceAttribute(context.Context, *MsgRemoveResourceAttribute) (*MsgRemoveResourceAttributeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveResourceAttribute not implemented")
+}
+func (UnimplementedMsgServer) RegisterResourceSchema(context.Context, *MsgRegisterResourceSchema) (*MsgRegisterResourceSchemaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterResourceSchema not implemented")
+}
+func (UnimplementedMsgServer) CreateNamespace(context.Context, *MsgCreateNamespace) (*MsgCreateNamespaceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateNamespace not implemented")
+}
+func (UnimplementedMsgServer) UpdateNamespace(context.Context, *MsgUpdateNamespace) (*MsgUpdateNamespaceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateNamespace not implemented")
+}
+func (UnimplementedMsgServer) ReplicateResource(context.Context, *MsgReplicateResource) (*MsgReplicateResourceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReplicateResource not implemented")
+}
+func (UnimplementedMsgServer) ApproveResourceSchema(context.Context, *MsgApproveResourceSchema) (*MsgApproveResourceSchemaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveResourceSchema not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -195,6 +439,294 @@ func _Msg_DeleteResource_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_RevertResource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRevertResource)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RevertResource(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RevertResource_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RevertResource(ctx, req.(*MsgRevertResource))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_OfferResourceTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgOfferResourceTransfer)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).OfferResourceTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_OfferResourceTransfer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).OfferResourceTransfer(ctx, req.(*MsgOfferResourceTransfer))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AcceptResourceTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAcceptResourceTransfer)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AcceptResourceTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_AcceptResourceTransfer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AcceptResourceTransfer(ctx, req.(*MsgAcceptResourceTransfer))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelResourceTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelResourceTransfer)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelResourceTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_CancelResourceTransfer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelResourceTransfer(ctx, req.(*MsgCancelResourceTransfer))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_GrantResourceRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgGrantResourceRole)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).GrantResourceRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_GrantResourceRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).GrantResourceRole(ctx, req.(*MsgGrantResourceRole))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RevokeResourceRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRevokeResourceRole)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RevokeResourceRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RevokeResourceRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RevokeResourceRole(ctx, req.(*MsgRevokeResourceRole))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RenewResource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRenewResource)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RenewResource(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RenewResource_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RenewResource(ctx, req.(*MsgRenewResource))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_BatchResourceOps_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBatchResourceOps)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).BatchResourceOps(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_BatchResourceOps_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).BatchResourceOps(ctx, req.(*MsgBatchResourceOps))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AdjustResourceValue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAdjustResourceValue)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AdjustResourceValue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_AdjustResourceValue_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AdjustResourceValue(ctx, req.(*MsgAdjustResourceValue))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetResourceAttribute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetResourceAttribute)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetResourceAttribute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetResourceAttribute_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetResourceAttribute(ctx, req.(*MsgSetResourceAttribute))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveResourceAttribute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveResourceAttribute)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveResourceAttribute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RemoveResourceAttribute_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveResourceAttribute(ctx, req.(*MsgRemoveResourceAttribute))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RegisterResourceSchema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegisterResourceSchema)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterResourceSchema(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RegisterResourceSchema_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterResourceSchema(ctx, req.(*MsgRegisterResourceSchema))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CreateNamespace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateNamespace)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateNamespace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_CreateNamespace_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateNamespace(ctx, req.(*MsgCreateNamespace))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateNamespace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateNamespace)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateNamespace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdateNamespace_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateNamespace(ctx, req.(*MsgUpdateNamespace))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ReplicateResource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgReplicateResource)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ReplicateResource(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ReplicateResource_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ReplicateResource(ctx, req.(*MsgReplicateResource))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ApproveResourceSchema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgApproveResourceSchema)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ApproveResourceSchema(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ApproveResourceSchema_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ApproveResourceSchema(ctx, req.(*MsgApproveResourceSchema))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -218,6 +750,70 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteResource",
 			Handler:    _Msg_DeleteResource_Handler,
 		},
+		{
+			MethodName: "RevertResource",
+			Handler:    _Msg_RevertResource_Handler,
+		},
+		{
+			MethodName: "OfferResourceTransfer",
+			Handler:    _Msg_OfferResourceTransfer_Handler,
+		},
+		{
+			MethodName: "AcceptResourceTransfer",
+			Handler:    _Msg_AcceptResourceTransfer_Handler,
+		},
+		{
+			MethodName: "CancelResourceTransfer",
+			Handler:    _Msg_CancelResourceTransfer_Handler,
+		},
+		{
+			MethodName: "GrantResourceRole",
+			Handler:    _Msg_GrantResourceRole_Handler,
+		},
+		{
+			MethodName: "RevokeResourceRole",
+			Handler:    _Msg_RevokeResourceRole_Handler,
+		},
+		{
+			MethodName: "RenewResource",
+			Handler:    _Msg_RenewResource_Handler,
+		},
+		{
+			MethodName: "BatchResourceOps",
+			Handler:    _Msg_BatchResourceOps_Handler,
+		},
+		{
+			MethodName: "AdjustResourceValue",
+			Handler:    _Msg_AdjustResourceValue_Handler,
+		},
+		{
+			MethodName: "SetResourceAttribute",
+			Handler:    _Msg_SetResourceAttribute_Handler,
+		},
+		{
+			MethodName: "RemoveResourceAttribute",
+			Handler:    _Msg_RemoveResourceAttribute_Handler,
+		},
+		{
+			MethodName: "RegisterResourceSchema",
+			Handler:    _Msg_RegisterResourceSchema_Handler,
+		},
+		{
+			MethodName: "CreateNamespace",
+			Handler:    _Msg_CreateNamespace_Handler,
+		},
+		{
+			MethodName: "UpdateNamespace",
+			Handler:    _Msg_UpdateNamespace_Handler,
+		},
+		{
+			MethodName: "ReplicateResource",
+			Handler:    _Msg_ReplicateResource_Handler,
+		},
+		{
+			MethodName: "ApproveResourceSchema",
+			Handler:    _Msg_ApproveResourceSchema_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "crude/crude/tx.proto",
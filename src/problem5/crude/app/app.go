@@ -268,6 +268,11 @@ func New(
 		return nil, err
 	}
 
+	// the crude module proves resources against the committed multistore
+	if querier, ok := app.CommitMultiStore().(storetypes.Queryable); ok {
+		app.CrudeKeeper.SetStoreQuerier(querier)
+	}
+
 	/****  Module Options ****/
 
 	app.ModuleManager.RegisterInvariants(app.CrisisKeeper)
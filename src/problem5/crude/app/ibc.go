@@ -1,6 +1,9 @@
 package app
 
 import (
+	crudemodule "crude/x/crude/module"
+	crudemoduletypes "crude/x/crude/types"
+
 	"cosmossdk.io/core/appmodule"
 	storetypes "cosmossdk.io/store/types"
 	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
@@ -142,8 +145,12 @@ func (app *App) registerIBCModules(appOpts servertypes.AppOptions) error {
 	)
 	app.GovKeeper.SetLegacyRouter(govRouter)
 
-	// Create IBC modules with ibcfee middleware
-	transferIBCModule := ibcfee.NewIBCMiddleware(ibctransfer.NewIBCModule(app.TransferKeeper), app.IBCFeeKeeper)
+	// Create IBC modules with ibcfee middleware, the transfer stack running the
+	// resource operations of the crude transfer memos
+	transferIBCModule := ibcfee.NewIBCMiddleware(
+		crudemodule.NewIBCMiddleware(ibctransfer.NewIBCModule(app.TransferKeeper), app.CrudeKeeper),
+		app.IBCFeeKeeper,
+	)
 
 	// integration point for custom authentication modules
 	var noAuthzModule porttypes.IBCModule
@@ -160,6 +167,8 @@ func (app *App) registerIBCModules(appOpts servertypes.AppOptions) error {
 		AddRoute(icacontrollertypes.SubModuleName, icaControllerIBCModule).
 		AddRoute(icahosttypes.SubModuleName, icaHostIBCModule)
 
+	crudeIBCModule := ibcfee.NewIBCMiddleware(crudemodule.NewIBCModule(app.CrudeKeeper), app.IBCFeeKeeper)
+	ibcRouter.AddRoute(crudemoduletypes.ModuleName, crudeIBCModule)
 	// this line is used by starport scaffolding # ibc/app/module
 
 	app.IBCKeeper.SetRouter(ibcRouter)
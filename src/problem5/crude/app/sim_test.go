@@ -33,6 +33,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"crude/app"
+	crudekeeper "crude/x/crude/keeper"
 )
 
 const (
@@ -59,6 +60,16 @@ func interBlockCacheOpt() func(*baseapp.BaseApp) {
 	return baseapp.SetInterBlockCache(store.NewCommitKVStoreCacheManager())
 }
 
+// requireCrudeInvariants asserts the crude invariants against the latest
+// committed state, regardless of the configured invariant check period.
+func requireCrudeInvariants(tb testing.TB, bApp *app.App) {
+	tb.Helper()
+
+	ctx := bApp.NewContextLegacy(true, cmtproto.Header{Height: bApp.LastBlockHeight()})
+	msg, broken := crudekeeper.AllInvariants(bApp.CrudeKeeper)(ctx)
+	require.False(tb, broken, msg)
+}
+
 // BenchmarkSimulation run the chain simulation
 // Running using starport command:
 // `ignite chain simulate -v --numBlocks 200 --blockSize 50`
@@ -109,6 +120,7 @@ func BenchmarkSimulation(b *testing.B) {
 	err = simtestutil.CheckExportSimulation(bApp, config, simParams)
 	require.NoError(b, err)
 	require.NoError(b, simErr)
+	requireCrudeInvariants(b, bApp)
 
 	if config.Commit {
 		simtestutil.PrintStats(db)
@@ -155,6 +167,7 @@ func TestAppImportExport(t *testing.T) {
 	err = simtestutil.CheckExportSimulation(bApp, config, simParams)
 	require.NoError(t, err)
 	require.NoError(t, simErr)
+	requireCrudeInvariants(t, bApp)
 
 	if config.Commit {
 		simtestutil.PrintStats(db)
@@ -195,6 +208,8 @@ func TestAppImportExport(t *testing.T) {
 		}
 	}
 	require.NoError(t, err)
+	msg, broken := crudekeeper.AllInvariants(newApp.CrudeKeeper)(ctxB)
+	require.False(t, broken, msg)
 	err = newApp.StoreConsensusParams(ctxB, exported.ConsensusParams)
 	require.NoError(t, err)
 	fmt.Printf("comparing stores...\n")
@@ -278,6 +293,7 @@ func TestAppSimulationAfterImport(t *testing.T) {
 	err = simtestutil.CheckExportSimulation(bApp, config, simParams)
 	require.NoError(t, err)
 	require.NoError(t, simErr)
+	requireCrudeInvariants(t, bApp)
 
 	if config.Commit {
 		simtestutil.PrintStats(db)
@@ -325,6 +341,7 @@ func TestAppSimulationAfterImport(t *testing.T) {
 		bApp.AppCodec(),
 	)
 	require.NoError(t, err)
+	requireCrudeInvariants(t, newApp)
 }
 
 func TestAppStateDeterminism(t *testing.T) {
@@ -411,6 +428,7 @@ func TestAppStateDeterminism(t *testing.T) {
 				bApp.AppCodec(),
 			)
 			require.NoError(t, err)
+			requireCrudeInvariants(t, bApp)
 
 			if config.Commit {
 				simtestutil.PrintStats(db)
@@ -24,6 +24,7 @@ import (
 	"github.com/spf13/viper"
 
 	"crude/app"
+	crudecli "crude/x/crude/client/cli"
 )
 
 func initRootCmd(
@@ -49,6 +50,7 @@ func initRootCmd(
 		queryCommand(),
 		txCommand(),
 		keys.Commands(),
+		crudecli.GetOfflineCmd(),
 	)
 }
 
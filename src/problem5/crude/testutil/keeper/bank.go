@@ -0,0 +1,61 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"crude/x/crude/types"
+)
+
+var _ types.BankKeeper = (*BankKeeper)(nil)
+
+// BankKeeper is an in-memory bank keeper, only meant to be used in tests
+type BankKeeper struct {
+	balances map[string]sdk.Coins
+}
+
+// NewBankKeeper returns an empty in-memory bank keeper
+func NewBankKeeper() *BankKeeper {
+	return &BankKeeper{balances: make(map[string]sdk.Coins)}
+}
+
+// Fund mints coins to an account
+func (b *BankKeeper) Fund(addr sdk.AccAddress, amt sdk.Coins) {
+	b.balances[addr.String()] = b.balances[addr.String()].Add(amt...)
+}
+
+// ModuleBalance returns the balance of a module account
+func (b *BankKeeper) ModuleBalance(module string) sdk.Coins {
+	return b.balances[authtypes.NewModuleAddress(module).String()]
+}
+
+func (b *BankKeeper) SpendableCoins(_ context.Context, addr sdk.AccAddress) sdk.Coins {
+	return b.balances[addr.String()]
+}
+
+func (b *BankKeeper) GetAllBalances(_ context.Context, addr sdk.AccAddress) sdk.Coins {
+	return b.balances[addr.String()]
+}
+
+func (b *BankKeeper) SendCoinsFromAccountToModule(_ context.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error {
+	return b.send(senderAddr, authtypes.NewModuleAddress(recipientModule), amt)
+}
+
+func (b *BankKeeper) SendCoinsFromModuleToAccount(_ context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error {
+	return b.send(authtypes.NewModuleAddress(senderModule), recipientAddr, amt)
+}
+
+func (b *BankKeeper) send(from, to sdk.AccAddress, amt sdk.Coins) error {
+	balance, hasNeg := b.balances[from.String()].SafeSub(amt...)
+	if hasNeg {
+		return errorsmod.Wrapf(sdkerrors.ErrInsufficientFunds, "%s is smaller than %s", b.balances[from.String()], amt)
+	}
+	b.balances[from.String()] = balance
+	b.balances[to.String()] = b.balances[to.String()].Add(amt...)
+
+	return nil
+}
@@ -22,6 +22,11 @@ import (
 )
 
 func CrudeKeeper(t testing.TB) (keeper.Keeper, sdk.Context) {
+	return CrudeKeeperWithBank(t, NewBankKeeper())
+}
+
+// CrudeKeeperWithBank builds a crude keeper on top of the given bank keeper
+func CrudeKeeperWithBank(t testing.TB, bankKeeper types.BankKeeper) (keeper.Keeper, sdk.Context) {
 	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
 
 	db := dbm.NewMemDB()
@@ -38,6 +43,9 @@ func CrudeKeeper(t testing.TB) (keeper.Keeper, sdk.Context) {
 		runtime.NewKVStoreService(storeKey),
 		log.NewNopLogger(),
 		authority.String(),
+		bankKeeper,
+		nil,
+		nil,
 	)
 
 	ctx := sdk.NewContext(stateStore, cmtproto.Header{}, false, log.NewNopLogger())
@@ -47,5 +55,10 @@ func CrudeKeeper(t testing.TB) (keeper.Keeper, sdk.Context) {
 		panic(err)
 	}
 
+	// Initialize the resource aggregates, as genesis does
+	if err := k.RebuildResourceStats(ctx); err != nil {
+		panic(err)
+	}
+
 	return k, ctx
 }
@@ -0,0 +1,30 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/spf13/cobra"
+
+	"crude/x/crude/types"
+)
+
+// GetTxCmd returns the transaction commands autocli cannot generate for this
+// module. Autocli adds the remaining ones on top of it.
+func GetTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      fmt.Sprintf("Transactions commands for the %s module", types.ModuleName),
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		CmdCreateResource(),
+		CmdUpdateResource(),
+		CmdBatchResourceOps(),
+	)
+
+	return cmd
+}
@@ -0,0 +1,255 @@
+package cli
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/spf13/cobra"
+
+	"crude/x/crude/types"
+)
+
+const (
+	FlagFormat = "format"
+
+	FormatJSON = "json"
+	FormatCSV  = "csv"
+
+	// TagSeparator separates the tags of a CSV operation
+	TagSeparator = ";"
+)
+
+func CmdBatchResourceOps() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "batch-resource-ops [file]",
+		Short: "Create, update and delete resources atomically from a JSON or CSV file",
+		Long: strings.TrimSpace(`Create, update and delete resources atomically from a JSON or CSV file.
+
+A JSON file holds an array of operations:
+
+[
+  {"create": {"name": "a", "payload": {"uint64Value": "1"}, "expiryHeight": "100", "tags": ["env:prod"]}},
+  {"update": {"id": "4", "name": "b", "payload": {"decValue": "2.5"}, "expectedVersion": "3"}},
+  {"delete": {"id": "5"}}
+]
+
+A CSV file starts with a header naming its columns among op, id, name, value,
+value_type, expected_version, expiry_height, expiry_time (RFC 3339), tags
+(separated by semicolons), schema_id, schema_version and namespace. The op column is
+required and holds create, update or delete. The value is parsed according to
+value_type, uint64 by default:
+
+op,id,name,value,value_type,tags
+create,,a,1,,env:prod;team:payments
+update,4,b,2.5,dec,
+delete,5,,,,
+
+The format is taken from the file extension unless --format is set.`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			format, err := cmd.Flags().GetString(FlagFormat)
+			if err != nil {
+				return err
+			}
+			if format == "" {
+				format = strings.TrimPrefix(strings.ToLower(filepath.Ext(args[0])), ".")
+			}
+
+			file, err := os.Open(args[0])
+			if err != nil {
+				return err
+			}
+			defer file.Close()
+
+			ops, err := ParseResourceOps(clientCtx.Codec, file, format)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgBatchResourceOps(clientCtx.GetFromAddress().String(), ops)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagFormat, "", "Format of the operations file, json or csv (default: the file extension)")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// ParseResourceOps reads the operations of a batch in the given format
+func ParseResourceOps(cdc codec.JSONCodec, r io.Reader, format string) ([]types.ResourceOp, error) {
+	switch format {
+	case FormatJSON:
+		return parseResourceOpsJSON(cdc, r)
+	case FormatCSV:
+		return parseResourceOpsCSV(r)
+	default:
+		return nil, fmt.Errorf("unsupported operations format %q, expected %s or %s", format, FormatJSON, FormatCSV)
+	}
+}
+
+func parseResourceOpsJSON(cdc codec.JSONCodec, r io.Reader) ([]types.ResourceOp, error) {
+	var raws []json.RawMessage
+	if err := json.NewDecoder(r).Decode(&raws); err != nil {
+		return nil, fmt.Errorf("operations must be a JSON array: %w", err)
+	}
+
+	ops := make([]types.ResourceOp, len(raws))
+	for i, raw := range raws {
+		if err := cdc.UnmarshalJSON(raw, &ops[i]); err != nil {
+			return nil, fmt.Errorf("operation %d: %w", i, err)
+		}
+	}
+
+	return ops, nil
+}
+
+func parseResourceOpsCSV(r io.Reader) ([]types.ResourceOp, error) {
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("cannot read CSV header: %w", err)
+	}
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		name = strings.ToLower(strings.TrimSpace(name))
+		switch name {
+		case "op", "id", "name", "value", "value_type", "expected_version", "expiry_height", "expiry_time", "tags", "schema_id", "schema_version", "namespace":
+		default:
+			return nil, fmt.Errorf("unknown CSV column %q", name)
+		}
+		columns[name] = i
+	}
+	if _, ok := columns["op"]; !ok {
+		return nil, errors.New("missing CSV column \"op\"")
+	}
+
+	var ops []types.ResourceOp
+	for line := 2; ; line++ {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		op, err := parseResourceOpRecord(columns, record)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+		ops = append(ops, op)
+	}
+
+	return ops, nil
+}
+
+func parseResourceOpRecord(columns map[string]int, record []string) (op types.ResourceOp, err error) {
+	field := func(name string) string {
+		if i, ok := columns[name]; ok {
+			return strings.TrimSpace(record[i])
+		}
+		return ""
+	}
+	uintField := func(name string) uint64 {
+		if err != nil || field(name) == "" {
+			return 0
+		}
+		var v uint64
+		if v, err = strconv.ParseUint(field(name), 10, 64); err != nil {
+			err = fmt.Errorf("invalid %s: %w", name, err)
+		}
+		return v
+	}
+	payloadField := func() *types.ResourcePayload {
+		if err != nil || (field("value") == "" && field("value_type") == "") {
+			return nil
+		}
+		valueType := field("value_type")
+		if valueType == "" {
+			valueType = types.ValueTypeUint64
+		}
+		var payload *types.ResourcePayload
+		if payload, err = types.ParseResourcePayload(valueType, field("value")); err != nil {
+			err = fmt.Errorf("invalid value: %w", err)
+		}
+		return payload
+	}
+	tagsField := func() []string {
+		var tags []string
+		for _, tag := range strings.Split(field("tags"), TagSeparator) {
+			if tag = strings.TrimSpace(tag); tag != "" {
+				tags = append(tags, tag)
+			}
+		}
+		return tags
+	}
+
+	schemaField := func() *types.ResourceSchemaRef {
+		if field("schema_id") == "" {
+			return nil
+		}
+		return &types.ResourceSchemaRef{Id: field("schema_id"), Version: uintField("schema_version")}
+	}
+
+	kind := strings.ToLower(field("op"))
+	if (kind == "update" || kind == "delete") && field("id") == "" {
+		return op, fmt.Errorf("missing id for %s", kind)
+	}
+
+	switch kind {
+	case "create":
+		create := &types.CreateResourceOp{Name: field("name"), Payload: payloadField(), Tags: tagsField(), Schema: schemaField(), Namespace: field("namespace")}
+		if s := field("expiry_height"); s != "" && err == nil {
+			if create.ExpiryHeight, err = strconv.ParseInt(s, 10, 64); err != nil {
+				err = fmt.Errorf("invalid expiry_height: %w", err)
+			}
+		}
+		if s := field("expiry_time"); s != "" && err == nil {
+			var expiry time.Time
+			if expiry, err = time.Parse(time.RFC3339, s); err != nil {
+				err = fmt.Errorf("invalid expiry_time: %w", err)
+			}
+			create.ExpiryTime = &expiry
+		}
+		op.Op = &types.ResourceOp_Create{Create: create}
+	case "update":
+		op.Op = &types.ResourceOp_Update{Update: &types.UpdateResourceOp{
+			Id:              uintField("id"),
+			Name:            field("name"),
+			Payload:         payloadField(),
+			ExpectedVersion: uintField("expected_version"),
+			Tags:            tagsField(),
+			Schema:          schemaField(),
+		}}
+	case "delete":
+		op.Op = &types.ResourceOp_Delete{Delete: &types.DeleteResourceOp{Id: uintField("id"), ExpectedVersion: uintField("expected_version")}}
+	default:
+		return op, fmt.Errorf("unknown operation %q, expected create, update or delete", kind)
+	}
+
+	return op, err
+}
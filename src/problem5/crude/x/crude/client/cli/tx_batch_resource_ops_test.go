@@ -0,0 +1,104 @@
+package cli_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/stretchr/testify/require"
+
+	"crude/x/crude/client/cli"
+	"crude/x/crude/types"
+)
+
+func TestParseResourceOps(t *testing.T) {
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	expiry := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+	expected := []types.ResourceOp{
+		{Op: &types.ResourceOp_Create{Create: &types.CreateResourceOp{Name: "a", Payload: types.NewUint64Payload(1), ExpiryHeight: 100, Tags: []string{"env:prod", "team:payments"}}}},
+		{Op: &types.ResourceOp_Create{Create: &types.CreateResourceOp{Name: "b", ExpiryTime: &expiry, Schema: &types.ResourceSchemaRef{Id: "product"}, Namespace: "acme"}}},
+		{Op: &types.ResourceOp_Update{Update: &types.UpdateResourceOp{Id: 4, Name: "c", Payload: types.NewDecPayload(math.LegacyNewDecWithPrec(25, 1)), ExpectedVersion: 3, Schema: &types.ResourceSchemaRef{Id: "product", Version: 2}}}},
+		{Op: &types.ResourceOp_Delete{Delete: &types.DeleteResourceOp{Id: 5}}},
+	}
+
+	for _, tc := range []struct {
+		desc   string
+		format string
+		input  string
+		err    string
+	}{
+		{
+			desc:   "JSON",
+			format: cli.FormatJSON,
+			input: `[
+				{"create": {"name": "a", "payload": {"uint64Value": "1"}, "expiryHeight": "100", "tags": ["env:prod", "team:payments"]}},
+				{"create": {"name": "b", "expiryTime": "2030-01-01T00:00:00Z", "schema": {"id": "product"}, "namespace": "acme"}},
+				{"update": {"id": 4, "name": "c", "payload": {"decValue": "2.5"}, "expectedVersion": "3", "schema": {"id": "product", "version": "2"}}},
+				{"delete": {"id": "5"}}
+			]`,
+		},
+		{
+			desc:   "CSV",
+			format: cli.FormatCSV,
+			input: "op,id,name,value,value_type,expiry_height,expiry_time,expected_version,tags,schema_id,schema_version,namespace\n" +
+				"create,,a,1,,100,,,env:prod; team:payments,,,\n" +
+				"create,,b,,,,2030-01-01T00:00:00Z,,,product,,acme\n" +
+				"update,4,c,2.5,dec,,,3,,product,2,\n" +
+				"delete,5,,,,,,,,,,\n",
+		},
+		{
+			desc:   "JSONNotAnArray",
+			format: cli.FormatJSON,
+			input:  `{"create": {"name": "a"}}`,
+			err:    "JSON array",
+		},
+		{
+			desc:   "CSVUnknownColumn",
+			format: cli.FormatCSV,
+			input:  "op,colour\ncreate,red\n",
+			err:    `unknown CSV column "colour"`,
+		},
+		{
+			desc:   "CSVUnknownOperation",
+			format: cli.FormatCSV,
+			input:  "op,id\ncreate,\nmove,1\n",
+			err:    `line 3: unknown operation "move"`,
+		},
+		{
+			desc:   "CSVMissingID",
+			format: cli.FormatCSV,
+			input:  "op,id\ndelete,\n",
+			err:    "missing id for delete",
+		},
+		{
+			desc:   "CSVInvalidValue",
+			format: cli.FormatCSV,
+			input:  "op,name,value\ncreate,a,-1\n",
+			err:    "invalid value",
+		},
+		{
+			desc:   "CSVUnknownValueType",
+			format: cli.FormatCSV,
+			input:  "op,name,value,value_type\ncreate,a,1,float\n",
+			err:    `unknown value type "float"`,
+		},
+		{
+			desc:   "UnknownFormat",
+			format: "yaml",
+			err:    `unsupported operations format "yaml"`,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			ops, err := cli.ParseResourceOps(cdc, strings.NewReader(tc.input), tc.format)
+			if tc.err != "" {
+				require.ErrorContains(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, expected, ops)
+		})
+	}
+}
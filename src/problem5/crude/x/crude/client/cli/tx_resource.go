@@ -0,0 +1,210 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"crude/x/crude/types"
+)
+
+const (
+	FlagValueType       = "value-type"
+	FlagTags            = "tags"
+	FlagMinValue        = "min-value"
+	FlagMaxValue        = "max-value"
+	FlagExpiryHeight    = "expiry-height"
+	FlagExpiryTime      = "expiry-time"
+	FlagExpectedVersion = "expected-version"
+	FlagSchemaID        = "schema-id"
+	FlagSchemaVersion   = "schema-version"
+	FlagNamespace       = "namespace"
+)
+
+var valueTypeUsage = fmt.Sprintf(
+	"Type of the value, one of %s. Bytes are base64 encoded and coins are written like 10stake",
+	strings.Join(types.ValueTypes, ", "),
+)
+
+func CmdCreateResource() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-resource [name] [value]",
+		Short: "Create resource",
+		Example: strings.TrimSpace(`
+cruded tx crude create-resource counter 42
+cruded tx crude create-resource price 1.25 --value-type dec --tags env:prod
+cruded tx crude create-resource config '{"retries":3}' --value-type json
+cruded tx crude create-resource '{"sku":"a-1"}' 1 --schema-id product
+cruded tx crude create-resource counter 0 --namespace acme`),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			payload, err := payloadFromFlags(cmd.Flags(), args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCreateResource(clientCtx.GetFromAddress().String(), args[0], payload)
+			if msg.Tags, err = cmd.Flags().GetStringSlice(FlagTags); err != nil {
+				return err
+			}
+			if msg.ValueBounds, err = valueBoundsFromFlags(cmd.Flags()); err != nil {
+				return err
+			}
+			if msg.Schema, err = schemaRefFromFlags(cmd.Flags()); err != nil {
+				return err
+			}
+			if msg.Namespace, err = cmd.Flags().GetString(FlagNamespace); err != nil {
+				return err
+			}
+			if msg.ExpiryHeight, err = cmd.Flags().GetInt64(FlagExpiryHeight); err != nil {
+				return err
+			}
+			if s, _ := cmd.Flags().GetString(FlagExpiryTime); s != "" {
+				expiry, err := time.Parse(time.RFC3339, s)
+				if err != nil {
+					return fmt.Errorf("invalid %s: %w", FlagExpiryTime, err)
+				}
+				msg.ExpiryTime = &expiry
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagValueType, types.ValueTypeUint64, valueTypeUsage)
+	cmd.Flags().StringSlice(FlagTags, nil, "Comma separated tags of the resource")
+	cmd.Flags().String(FlagMinValue, "", "Lower bound of the uint64 value")
+	cmd.Flags().String(FlagMaxValue, "", "Upper bound of the uint64 value")
+	cmd.Flags().String(FlagSchemaID, "", "Id of the schema the name of the resource must conform to")
+	cmd.Flags().Uint64(FlagSchemaVersion, 0, "Version of the schema, the latest one if unset")
+	cmd.Flags().String(FlagNamespace, "", "Namespace the resource is created in")
+	cmd.Flags().Int64(FlagExpiryHeight, 0, "Block height from which the resource is pruned")
+	cmd.Flags().String(FlagExpiryTime, "", "Block time (RFC 3339) from which the resource is pruned")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdUpdateResource() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-resource [id] [name] [value]",
+		Short: "Update resource",
+		Long: strings.TrimSpace(`Update resource. The name, the value and the tags of the resource are all
+replaced, the value bounds only if --min-value or --max-value is set and the
+schema only if --schema-id is set.`),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid id: %w", err)
+			}
+			payload, err := payloadFromFlags(cmd.Flags(), args[2])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgUpdateResource(clientCtx.GetFromAddress().String(), id, args[1], payload)
+			if msg.Tags, err = cmd.Flags().GetStringSlice(FlagTags); err != nil {
+				return err
+			}
+			if msg.ValueBounds, err = valueBoundsFromFlags(cmd.Flags()); err != nil {
+				return err
+			}
+			if msg.Schema, err = schemaRefFromFlags(cmd.Flags()); err != nil {
+				return err
+			}
+			if msg.ExpectedVersion, err = cmd.Flags().GetUint64(FlagExpectedVersion); err != nil {
+				return err
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagValueType, types.ValueTypeUint64, valueTypeUsage)
+	cmd.Flags().StringSlice(FlagTags, nil, "Comma separated tags replacing those of the resource")
+	cmd.Flags().String(FlagMinValue, "", "Lower bound of the uint64 value")
+	cmd.Flags().String(FlagMaxValue, "", "Upper bound of the uint64 value")
+	cmd.Flags().String(FlagSchemaID, "", "Id of the schema replacing the one of the resource")
+	cmd.Flags().Uint64(FlagSchemaVersion, 0, "Version of the schema, the latest one if unset")
+	cmd.Flags().Uint64(FlagExpectedVersion, 0, "Version the resource must be at for the update to apply")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// payloadFromFlags parses a value argument according to the --value-type flag
+func payloadFromFlags(fs *pflag.FlagSet, value string) (*types.ResourcePayload, error) {
+	valueType, err := fs.GetString(FlagValueType)
+	if err != nil {
+		return nil, err
+	}
+
+	return types.ParseResourcePayload(valueType, value)
+}
+
+// valueBoundsFromFlags returns the bounds set through --min-value and
+// --max-value, nil if neither is set. A missing bound is left open.
+func valueBoundsFromFlags(fs *pflag.FlagSet) (*types.ResourceValueBounds, error) {
+	minValue, _ := fs.GetString(FlagMinValue)
+	maxValue, _ := fs.GetString(FlagMaxValue)
+	if minValue == "" && maxValue == "" {
+		return nil, nil
+	}
+
+	bounds := &types.ResourceValueBounds{Max: ^uint64(0)}
+	var err error
+	if minValue != "" {
+		if bounds.Min, err = strconv.ParseUint(minValue, 10, 64); err != nil {
+			return nil, fmt.Errorf("invalid %s: %w", FlagMinValue, err)
+		}
+	}
+	if maxValue != "" {
+		if bounds.Max, err = strconv.ParseUint(maxValue, 10, 64); err != nil {
+			return nil, fmt.Errorf("invalid %s: %w", FlagMaxValue, err)
+		}
+	}
+
+	return bounds, nil
+}
+
+// schemaRefFromFlags returns the schema set through --schema-id and
+// --schema-version, nil if no schema id is set
+func schemaRefFromFlags(fs *pflag.FlagSet) (*types.ResourceSchemaRef, error) {
+	id, _ := fs.GetString(FlagSchemaID)
+	version, err := fs.GetUint64(FlagSchemaVersion)
+	if err != nil {
+		return nil, err
+	}
+	if id == "" {
+		if version != 0 {
+			return nil, fmt.Errorf("--%s requires --%s", FlagSchemaVersion, FlagSchemaID)
+		}
+		return nil, nil
+	}
+
+	return &types.ResourceSchemaRef{Id: id, Version: version}, nil
+}
@@ -0,0 +1,97 @@
+package cli
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	cmtcrypto "github.com/cometbft/cometbft/proto/tendermint/crypto"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/spf13/cobra"
+
+	"crude/x/crude/types"
+	"crude/x/crude/verifier"
+)
+
+// GetOfflineCmd returns the commands of the module that run without a node
+func GetOfflineCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      fmt.Sprintf("Offline commands for the %s module", types.ModuleName),
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		CmdVerifyResourceProof(),
+	)
+
+	return cmd
+}
+
+func CmdVerifyResourceProof() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "verify-resource-proof [id] [app-hash] [proof-file]",
+		Short: "Verify the proof of a resource, or of its absence, against an app hash",
+		Long: strings.TrimSpace(`Verify the proof of a resource, or of its absence, against an app hash.
+
+The proof file holds the JSON output of show-resource-with-proof, or - to read
+it from the standard input:
+
+cruded query crude show-resource-with-proof 4 --output json > proof.json
+
+A proof taken at height h verifies against the hex encoded app hash of the block
+header at height h + 1, which should come from a trusted source such as a light
+client rather than from the node that served the proof. The command prints the
+proven resource and fails if the proof does not hold.`),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid id: %w", err)
+			}
+			appHash, err := hex.DecodeString(args[1])
+			if err != nil {
+				return fmt.Errorf("invalid app hash: %w", err)
+			}
+
+			var bz []byte
+			if args[2] == "-" {
+				bz, err = io.ReadAll(cmd.InOrStdin())
+			} else {
+				bz, err = os.ReadFile(args[2])
+			}
+			if err != nil {
+				return err
+			}
+			// Only the proven value and the proof are read, the resource of
+			// the response is not trusted
+			var proof struct {
+				Value []byte              `json:"value"`
+				Proof *cmtcrypto.ProofOps `json:"proof"`
+			}
+			if err := json.Unmarshal(bz, &proof); err != nil {
+				return fmt.Errorf("invalid proof file: %w", err)
+			}
+
+			resource, err := verifier.Verify(clientCtx.Codec, appHash, id, &types.QueryResourceWithProofResponse{Value: proof.Value, Proof: proof.Proof})
+			if err != nil {
+				return err
+			}
+			if resource == nil {
+				return clientCtx.PrintString(fmt.Sprintf("resource %d does not exist\n", id))
+			}
+
+			return clientCtx.PrintProto(resource)
+		},
+	}
+
+	return cmd
+}
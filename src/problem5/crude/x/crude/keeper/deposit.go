@@ -0,0 +1,66 @@
+package keeper
+
+import (
+	"context"
+
+	"crude/x/crude/types"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// SettleResourceDeposit brings the deposit of a resource in line with its
+// current size. Any increase is escrowed from the payer, any decrease is
+// refunded to the owner. The resource is updated but not stored.
+func (k Keeper) SettleResourceDeposit(ctx context.Context, resource *types.Resource, payer string) error {
+	required := k.GetParams(ctx).DepositFor(*resource)
+
+	var charge, refund sdk.Coins
+	for _, coin := range required {
+		if held := resource.Deposit.AmountOf(coin.Denom); coin.Amount.GT(held) {
+			charge = charge.Add(sdk.NewCoin(coin.Denom, coin.Amount.Sub(held)))
+		}
+	}
+	for _, coin := range resource.Deposit {
+		if owed := required.AmountOf(coin.Denom); coin.Amount.GT(owed) {
+			refund = refund.Add(sdk.NewCoin(coin.Denom, coin.Amount.Sub(owed)))
+		}
+	}
+
+	if !charge.IsZero() {
+		payerAddr, err := sdk.AccAddressFromBech32(payer)
+		if err != nil {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid payer address (%s)", err)
+		}
+		if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, payerAddr, types.ModuleName, charge); err != nil {
+			return err
+		}
+	}
+	if !refund.IsZero() {
+		if err := k.refund(ctx, resource.Owner, refund); err != nil {
+			return err
+		}
+	}
+
+	resource.Deposit = required
+	return nil
+}
+
+// RefundResourceDeposit returns the whole deposit of a resource to its owner
+func (k Keeper) RefundResourceDeposit(ctx context.Context, resource types.Resource) error {
+	if resource.Deposit.IsZero() {
+		return nil
+	}
+
+	return k.refund(ctx, resource.Owner, resource.Deposit)
+}
+
+func (k Keeper) refund(ctx context.Context, owner string, amount sdk.Coins) error {
+	ownerAddr, err := sdk.AccAddressFromBech32(owner)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid owner address (%s)", err)
+	}
+
+	return k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, ownerAddr, amount)
+}
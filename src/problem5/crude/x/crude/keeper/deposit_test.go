@@ -0,0 +1,113 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	keepertest "crude/testutil/keeper"
+	"crude/testutil/sample"
+	"crude/x/crude/keeper"
+	"crude/x/crude/types"
+)
+
+func TestResourceDeposit(t *testing.T) {
+	owner, editor := sample.AccAddress(), sample.AccAddress()
+	funds := sdk.NewCoins(sdk.NewInt64Coin("stake", 10_000))
+	params := types.NewParams(0, "", 0, nil, sdk.NewCoins(sdk.NewInt64Coin("stake", 2)), 0, 0, 0, 0, 0, false, false)
+
+	setup := func(t *testing.T) (*keepertest.BankKeeper, keeper.Keeper, types.MsgServer, sdk.Context) {
+		bank := keepertest.NewBankKeeper()
+		bank.Fund(sdk.MustAccAddressFromBech32(owner), funds)
+		bank.Fund(sdk.MustAccAddressFromBech32(editor), funds)
+		k, ctx := keepertest.CrudeKeeperWithBank(t, bank)
+		require.NoError(t, k.SetParams(ctx, params))
+		return bank, k, keeper.NewMsgServerImpl(k), ctx
+	}
+	escrow := func(bank *keepertest.BankKeeper) sdk.Coins {
+		return bank.ModuleBalance(types.ModuleName)
+	}
+	balance := func(bank *keepertest.BankKeeper, ctx sdk.Context, addr string) sdk.Coins {
+		return bank.SpendableCoins(ctx, sdk.MustAccAddressFromBech32(addr))
+	}
+
+	t.Run("Create", func(t *testing.T) {
+		bank, k, srv, ctx := setup(t)
+		_, err := srv.CreateResource(ctx, &types.MsgCreateResource{Creator: owner, Name: "name", Payload: types.NewUint64Payload(1)})
+		require.NoError(t, err)
+
+		resource, found := k.GetResource(ctx, 0)
+		require.True(t, found)
+		require.False(t, resource.Deposit.IsZero())
+		require.Equal(t, params.DepositFor(resource), resource.Deposit)
+		require.Equal(t, resource.Deposit, escrow(bank))
+		require.Equal(t, funds.Sub(resource.Deposit...), balance(bank, ctx, owner))
+	})
+	t.Run("UpdateGrowChargesSigner", func(t *testing.T) {
+		bank, k, srv, ctx := setup(t)
+		_, err := srv.CreateResource(ctx, &types.MsgCreateResource{Creator: owner, Name: "a"})
+		require.NoError(t, err)
+		_, err = srv.GrantResourceRole(ctx, &types.MsgGrantResourceRole{Creator: owner, Address: editor, Role: types.ResourceRole_RESOURCE_ROLE_EDITOR})
+		require.NoError(t, err)
+		before, _ := k.GetResource(ctx, 0)
+
+		_, err = srv.UpdateResource(ctx, &types.MsgUpdateResource{Creator: editor, Name: "a much longer name"})
+		require.NoError(t, err)
+
+		after, _ := k.GetResource(ctx, 0)
+		require.True(t, after.Deposit.IsAllGT(before.Deposit))
+		require.Equal(t, after.Deposit, escrow(bank))
+		require.Equal(t, funds.Sub(after.Deposit.Sub(before.Deposit...)...), balance(bank, ctx, editor))
+	})
+	t.Run("UpdateShrinkRefundsOwner", func(t *testing.T) {
+		bank, k, srv, ctx := setup(t)
+		_, err := srv.CreateResource(ctx, &types.MsgCreateResource{Creator: owner, Name: "a much longer name"})
+		require.NoError(t, err)
+		_, err = srv.UpdateResource(ctx, &types.MsgUpdateResource{Creator: owner, Name: "a"})
+		require.NoError(t, err)
+
+		after, _ := k.GetResource(ctx, 0)
+		require.Equal(t, after.Deposit, escrow(bank))
+		require.Equal(t, funds.Sub(after.Deposit...), balance(bank, ctx, owner))
+	})
+	t.Run("DeleteRefundsOwner", func(t *testing.T) {
+		bank, _, srv, ctx := setup(t)
+		_, err := srv.CreateResource(ctx, &types.MsgCreateResource{Creator: owner, Name: "name"})
+		require.NoError(t, err)
+		_, err = srv.DeleteResource(ctx, &types.MsgDeleteResource{Creator: owner})
+		require.NoError(t, err)
+
+		require.True(t, escrow(bank).IsZero())
+		require.Equal(t, funds, balance(bank, ctx, owner))
+	})
+	t.Run("InsufficientFunds", func(t *testing.T) {
+		_, _, srv, ctx := setup(t)
+		_, err := srv.CreateResource(ctx, &types.MsgCreateResource{Creator: sample.AccAddress(), Name: "name"})
+		require.ErrorIs(t, err, sdkerrors.ErrInsufficientFunds)
+	})
+}
+
+func TestDepositEscrowInvariant(t *testing.T) {
+	owner := sample.AccAddress()
+	bank := keepertest.NewBankKeeper()
+	bank.Fund(sdk.MustAccAddressFromBech32(owner), sdk.NewCoins(sdk.NewInt64Coin("stake", 10_000)))
+	k, ctx := keepertest.CrudeKeeperWithBank(t, bank)
+	require.NoError(t, k.SetParams(ctx, types.NewParams(0, "", 0, nil, sdk.NewCoins(sdk.NewInt64Coin("stake", 1)), 0, 0, 0, 0, 0, false, false)))
+	srv := keeper.NewMsgServerImpl(k)
+
+	for i := 0; i < 3; i++ {
+		_, err := srv.CreateResource(ctx, &types.MsgCreateResource{Creator: owner, Name: "name"})
+		require.NoError(t, err)
+	}
+	_, broken := keeper.DepositEscrowInvariant(k)(ctx)
+	require.False(t, broken)
+
+	// Recording a deposit that was never escrowed breaks the invariant
+	resource, _ := k.GetResource(ctx, 0)
+	resource.Deposit = resource.Deposit.Add(sdk.NewInt64Coin("stake", 1))
+	require.NoError(t, k.SetResource(ctx, resource))
+	_, broken = keeper.DepositEscrowInvariant(k)(ctx)
+	require.True(t, broken)
+}
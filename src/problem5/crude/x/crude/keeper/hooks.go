@@ -0,0 +1,78 @@
+package keeper
+
+import (
+	"context"
+
+	"crude/x/crude/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SetHooks sets the resource hooks. It must be called before the keeper is
+// handed to the module, copies made earlier do not see the hooks.
+func (k *Keeper) SetHooks(rh types.ResourceHooks) *Keeper {
+	if k.hooks != nil {
+		panic("cannot set resource hooks twice")
+	}
+
+	k.hooks = rh
+
+	return k
+}
+
+// Hooks gets the hooks for the crude keeper. The replication of resources to
+// IBC channels runs after the hooks that were provided, if any.
+func (k Keeper) Hooks() types.ResourceHooks {
+	if k.hooks == nil {
+		return types.MultiResourceHooks{replicationHooks{k}}
+	}
+
+	return types.MultiResourceHooks{k.hooks, replicationHooks{k}}
+}
+
+// replicationHooks forward the changes of the resources subscribed to IBC
+// channels. The deletion is forwarded ahead of the removal, which drops the
+// subscriptions.
+type replicationHooks struct {
+	k Keeper
+}
+
+var _ types.ResourceHooks = replicationHooks{}
+
+func (replicationHooks) BeforeResourceCreated(context.Context, types.Resource) error { return nil }
+
+func (replicationHooks) AfterResourceCreated(context.Context, types.Resource) error { return nil }
+
+func (replicationHooks) BeforeResourceUpdated(context.Context, types.Resource, types.Resource) error {
+	return nil
+}
+
+func (h replicationHooks) AfterResourceUpdated(ctx context.Context, _, updated types.Resource) error {
+	return h.k.forwardResourcePacket(ctx, updated.Id, types.CrudePacketData{
+		Packet: &types.CrudePacketData_ResourceUpdatePacket{
+			ResourceUpdatePacket: &types.ResourceUpdatePacketData{Resource: updated},
+		},
+	})
+}
+
+func (h replicationHooks) BeforeResourceDeleted(ctx context.Context, resource types.Resource) error {
+	return h.k.forwardResourcePacket(ctx, resource.Id, types.CrudePacketData{
+		Packet: &types.CrudePacketData_ResourceDeletePacket{
+			ResourceDeletePacket: &types.ResourceDeletePacketData{ResourceId: resource.Id},
+		},
+	})
+}
+
+func (replicationHooks) AfterResourceDeleted(context.Context, types.Resource) error { return nil }
+
+// runPruneHook runs a delete hook on behalf of EndBlock pruning. Expiry
+// cannot be vetoed, a failing hook is logged and its writes discarded.
+func (k Keeper) runPruneHook(ctx context.Context, resource types.Resource, hook func(context.Context, types.Resource) error) {
+	cacheCtx, write := sdk.UnwrapSDKContext(ctx).CacheContext()
+	if err := hook(cacheCtx, resource); err != nil {
+		k.Logger().Error("resource hook failed while pruning", "id", resource.Id, "err", err)
+		return
+	}
+
+	write()
+}
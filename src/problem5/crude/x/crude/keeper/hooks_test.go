@@ -0,0 +1,132 @@
+package keeper_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "crude/testutil/keeper"
+	"crude/x/crude/keeper"
+	"crude/x/crude/types"
+)
+
+var errVeto = errors.New("vetoed")
+
+// mockHooks records the lifecycle calls it receives and vetoes the ones listed
+type mockHooks struct {
+	calls []string
+	veto  map[string]bool
+}
+
+func (h *mockHooks) record(call string) error {
+	h.calls = append(h.calls, call)
+	if h.veto[call] {
+		return errVeto
+	}
+	return nil
+}
+
+func (h *mockHooks) BeforeResourceCreated(context.Context, types.Resource) error {
+	return h.record("BeforeResourceCreated")
+}
+
+func (h *mockHooks) AfterResourceCreated(context.Context, types.Resource) error {
+	return h.record("AfterResourceCreated")
+}
+
+func (h *mockHooks) BeforeResourceUpdated(context.Context, types.Resource, types.Resource) error {
+	return h.record("BeforeResourceUpdated")
+}
+
+func (h *mockHooks) AfterResourceUpdated(context.Context, types.Resource, types.Resource) error {
+	return h.record("AfterResourceUpdated")
+}
+
+func (h *mockHooks) BeforeResourceDeleted(context.Context, types.Resource) error {
+	return h.record("BeforeResourceDeleted")
+}
+
+func (h *mockHooks) AfterResourceDeleted(context.Context, types.Resource) error {
+	return h.record("AfterResourceDeleted")
+}
+
+func setupHooks(t *testing.T, veto ...string) (*mockHooks, keeper.Keeper, types.MsgServer, sdk.Context) {
+	hooks := &mockHooks{veto: make(map[string]bool)}
+	for _, call := range veto {
+		hooks.veto[call] = true
+	}
+
+	k, ctx := keepertest.CrudeKeeper(t)
+	k.SetHooks(hooks)
+	return hooks, k, keeper.NewMsgServerImpl(k), ctx
+}
+
+func TestResourceHooks(t *testing.T) {
+	creator := "A"
+	hooks, _, srv, ctx := setupHooks(t)
+
+	_, err := srv.CreateResource(ctx, &types.MsgCreateResource{Creator: creator})
+	require.NoError(t, err)
+	_, err = srv.UpdateResource(ctx, &types.MsgUpdateResource{Creator: creator, Name: "name"})
+	require.NoError(t, err)
+	_, err = srv.RevertResource(ctx, &types.MsgRevertResource{Creator: creator})
+	require.NoError(t, err)
+	_, err = srv.DeleteResource(ctx, &types.MsgDeleteResource{Creator: creator})
+	require.NoError(t, err)
+
+	require.Equal(t, []string{
+		"BeforeResourceCreated", "AfterResourceCreated",
+		"BeforeResourceUpdated", "AfterResourceUpdated",
+		"BeforeResourceUpdated", "AfterResourceUpdated",
+		"BeforeResourceDeleted", "AfterResourceDeleted",
+	}, hooks.calls)
+}
+
+func TestResourceHooksVeto(t *testing.T) {
+	creator := "A"
+
+	t.Run("Create", func(t *testing.T) {
+		_, k, srv, ctx := setupHooks(t, "BeforeResourceCreated")
+		_, err := srv.CreateResource(ctx, &types.MsgCreateResource{Creator: creator})
+		require.ErrorIs(t, err, errVeto)
+		require.Empty(t, k.GetAllResource(ctx))
+	})
+	t.Run("Update", func(t *testing.T) {
+		_, k, srv, ctx := setupHooks(t, "BeforeResourceUpdated")
+		_, err := srv.CreateResource(ctx, &types.MsgCreateResource{Creator: creator, Name: "old"})
+		require.NoError(t, err)
+		_, err = srv.UpdateResource(ctx, &types.MsgUpdateResource{Creator: creator, Name: "new"})
+		require.ErrorIs(t, err, errVeto)
+
+		resource, _ := k.GetResource(ctx, 0)
+		require.Equal(t, "old", resource.Name)
+	})
+	t.Run("Delete", func(t *testing.T) {
+		_, k, srv, ctx := setupHooks(t, "BeforeResourceDeleted")
+		_, err := srv.CreateResource(ctx, &types.MsgCreateResource{Creator: creator})
+		require.NoError(t, err)
+		_, err = srv.DeleteResource(ctx, &types.MsgDeleteResource{Creator: creator})
+		require.ErrorIs(t, err, errVeto)
+
+		_, found := k.GetResource(ctx, 0)
+		require.True(t, found)
+	})
+	t.Run("PruneCannotBeVetoed", func(t *testing.T) {
+		hooks, k, _, ctx := setupHooks(t, "BeforeResourceDeleted")
+		_, err := k.AppendResource(ctx, types.Resource{Owner: creator, ExpiryHeight: 1})
+		require.NoError(t, err)
+
+		require.NoError(t, k.PruneExpiredResources(ctx.WithBlockHeight(1)))
+		require.Empty(t, k.GetAllResource(ctx))
+		require.Equal(t, []string{"BeforeResourceDeleted", "AfterResourceDeleted"}, hooks.calls)
+	})
+}
+
+func TestSetHooksTwice(t *testing.T) {
+	k, _ := keepertest.CrudeKeeper(t)
+	k.SetHooks(&mockHooks{})
+	require.Panics(t, func() { k.SetHooks(&mockHooks{}) })
+}
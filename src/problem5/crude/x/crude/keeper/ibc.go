@@ -0,0 +1,102 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"crude/x/crude/types"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	capabilitytypes "github.com/cosmos/ibc-go/modules/capability/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+	"github.com/cosmos/ibc-go/v8/modules/core/exported"
+)
+
+// ShouldBound checks if the IBC app module can be bound to the desired port
+func (k Keeper) ShouldBound(ctx sdk.Context, portID string) bool {
+	scopedKeeper := k.ScopedKeeper()
+	if scopedKeeper == nil {
+		return false
+	}
+	_, ok := scopedKeeper.GetCapability(ctx, host.PortPath(portID))
+	return !ok
+}
+
+// BindPort defines a wrapper function for the port Keeper's function in
+// order to expose it to module's InitGenesis function
+func (k Keeper) BindPort(ctx sdk.Context, portID string) error {
+	portCap := k.ibcKeeperFn().PortKeeper.BindPort(ctx, portID)
+	return k.ClaimCapability(ctx, portCap, host.PortPath(portID))
+}
+
+// GetPort returns the portID for the IBC app module. Used in ExportGenesis
+func (k Keeper) GetPort(ctx context.Context) string {
+	port, err := k.port.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		return ""
+	}
+	if err != nil {
+		panic(err)
+	}
+
+	return port
+}
+
+// SetPort sets the portID for the IBC app module. Used in InitGenesis
+func (k Keeper) SetPort(ctx context.Context, portID string) error {
+	return k.port.Set(ctx, portID)
+}
+
+// AuthenticateCapability wraps the scopedKeeper's AuthenticateCapability function
+func (k Keeper) AuthenticateCapability(ctx sdk.Context, cap *capabilitytypes.Capability, name string) bool {
+	return k.ScopedKeeper().AuthenticateCapability(ctx, cap, name)
+}
+
+// ClaimCapability allows the IBC app module to claim a capability that core IBC
+// passes to it
+func (k Keeper) ClaimCapability(ctx sdk.Context, cap *capabilitytypes.Capability, name string) error {
+	return k.ScopedKeeper().ClaimCapability(ctx, cap, name)
+}
+
+// ScopedKeeper returns the capability keeper scoped to the module, nil if the
+// keeper was built without IBC
+func (k Keeper) ScopedKeeper() exported.ScopedKeeper {
+	if k.capabilityScopedFn == nil {
+		return nil
+	}
+	return k.capabilityScopedFn(types.ModuleName)
+}
+
+// isChannelOpen reports whether a channel of the module port is open
+func (k Keeper) isChannelOpen(ctx sdk.Context, channelID string) bool {
+	if k.ibcKeeperFn == nil {
+		return false
+	}
+	channel, found := k.ibcKeeperFn().ChannelKeeper.GetChannel(ctx, k.GetPort(ctx), channelID)
+	return found && channel.State == channeltypes.OPEN
+}
+
+// transmitPacket sends a packet on a channel of the module port, timing out
+// at the given block time in nanoseconds
+func (k Keeper) transmitPacket(ctx sdk.Context, channelID string, data types.CrudePacketData, timeoutTimestamp uint64) (uint64, error) {
+	if k.ibcKeeperFn == nil {
+		return 0, errorsmod.Wrap(channeltypes.ErrChannelNotFound, "IBC is not enabled")
+	}
+	sourcePort := k.GetPort(ctx)
+	channelCap, ok := k.ScopedKeeper().GetCapability(ctx, host.ChannelCapabilityPath(sourcePort, channelID))
+	if !ok {
+		return 0, errorsmod.Wrap(channeltypes.ErrChannelCapabilityNotFound, "module does not own channel capability")
+	}
+
+	packetBytes, err := data.GetBytes()
+	if err != nil {
+		return 0, errorsmod.Wrapf(sdkerrors.ErrJSONMarshal, "cannot marshal the packet: %s", err)
+	}
+
+	return k.ibcKeeperFn().ChannelKeeper.SendPacket(ctx, channelCap, sourcePort, channelID, clienttypes.ZeroHeight(), timeoutTimestamp, packetBytes)
+}
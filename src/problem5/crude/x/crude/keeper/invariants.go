@@ -0,0 +1,396 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"crude/x/crude/types"
+
+	"cosmossdk.io/collections"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// RegisterInvariants registers all crude invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "resource-count", ResourceCountInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "stored-values", StoredValuesInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "resource-indexes", ResourceIndexesInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "deposit-escrow", DepositEscrowInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "resource-stats", ResourceStatsInvariant(k))
+}
+
+// AllInvariants runs all invariants of the crude module
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		for _, invariant := range []sdk.Invariant{
+			ResourceCountInvariant(k),
+			StoredValuesInvariant(k),
+			ResourceIndexesInvariant(k),
+			DepositEscrowInvariant(k),
+			ResourceStatsInvariant(k),
+		} {
+			res, stop := invariant(ctx)
+			if stop {
+				return res, stop
+			}
+		}
+
+		return "", false
+	}
+}
+
+// ResourceCountInvariant checks that every stored resource id is below the
+// resource count, so that no future append can overwrite it
+func ResourceCountInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg   string
+			count = k.GetResourceCount(ctx)
+		)
+
+		iterator, err := k.resources.Iterate(ctx, nil)
+		if err != nil {
+			panic(err)
+		}
+		defer iterator.Close()
+
+		ids, err := iterator.Keys()
+		if err != nil {
+			msg += fmt.Sprintf("\tresource keys do not decode: %v\n", err)
+		}
+		for _, id := range ids {
+			if id >= count {
+				msg += fmt.Sprintf("\tresource %d is not below the resource count %d\n", id, count)
+			}
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "resource-count", msg), msg != ""
+	}
+}
+
+// StoredValuesInvariant checks that every value held by the module decodes
+func StoredValuesInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var msg string
+
+		if _, err := k.params.Get(ctx); err != nil && !errors.Is(err, collections.ErrNotFound) {
+			msg += fmt.Sprintf("\tparams do not decode: %v\n", err)
+		}
+		if _, err := k.resourceTotals.Get(ctx); err != nil && !errors.Is(err, collections.ErrNotFound) {
+			msg += fmt.Sprintf("\tresource totals do not decode: %v\n", err)
+		}
+		msg += undecodableValues(ctx, "resource", k.resources)
+		msg += undecodableValues(ctx, "resource revision", k.resourceRevisions)
+		msg += undecodableValues(ctx, "resource transfer offer", k.transferOffers)
+		msg += undecodableValues(ctx, "resource role grant", k.resourceRoles)
+		msg += undecodableValues(ctx, "resource schema", k.resourceSchemas)
+		msg += undecodableValues(ctx, "namespace", k.namespaces)
+		msg += undecodableValues(ctx, "creator resource count", k.creatorCounts)
+		msg += undecodableValues(ctx, "resource value count", k.valueCounts)
+		msg += undecodableValues(ctx, "namespace resource count", k.namespaceCounts)
+		msg += undecodableValues(ctx, "resource replication", k.replications)
+		msg += undecodableValues(ctx, "resource replica", k.replicas)
+
+		return sdk.FormatInvariant(types.ModuleName, "stored-values", msg), msg != ""
+	}
+}
+
+// ResourceIndexesInvariant checks that the secondary indexes and the records
+// keyed by resource id agree with the primary resource store, both ways
+func ResourceIndexesInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var msg string
+
+		resources := make(map[uint64]types.Resource)
+		err := k.resources.Walk(ctx, nil, func(id uint64, resource types.Resource) (bool, error) {
+			resources[id] = resource
+			if resource.Id != id {
+				msg += fmt.Sprintf("\tresource stored under %d has id %d\n", id, resource.Id)
+			}
+			msg += missingIndexEntry(ctx, "creator", k.resourcesByCreator, collections.Join(resource.Creator, id))
+			msg += missingIndexEntry(ctx, "owner", k.resourcesByOwner, collections.Join(resource.Owner, id))
+			msg += missingIndexEntry(ctx, "name", k.resourcesByName, collections.Join(resource.Name, id))
+			if resource.ExpiryHeight > 0 {
+				msg += missingIndexEntry(ctx, "expiry height", k.expiryHeightQueue, collections.Join(resource.ExpiryHeight, id))
+			}
+			if resource.ExpiryTime != nil {
+				msg += missingIndexEntry(ctx, "expiry time", k.expiryTimeQueue, collections.Join(*resource.ExpiryTime, id))
+			}
+			for _, attribute := range resource.Attributes {
+				msg += missingIndexEntry(ctx, "attribute", k.resourcesByAttribute, collections.Join3(attribute.Key, attribute.Value, id))
+			}
+			for _, tag := range resource.Tags {
+				msg += missingIndexEntry(ctx, "tag", k.resourcesByTag, collections.Join(tag, id))
+			}
+			if resource.Namespace != "" {
+				msg += missingIndexEntry(ctx, "namespace", k.resourcesByNamespace, collections.Join(resource.Namespace, id))
+				if has, err := k.namespaces.Has(ctx, resource.Namespace); err == nil && !has {
+					msg += fmt.Sprintf("\tresource %d in unknown namespace %s\n", id, resource.Namespace)
+				}
+			}
+			return false, nil
+		})
+		if err != nil {
+			// undecodable values are reported by the stored-values invariant
+			return sdk.FormatInvariant(types.ModuleName, "resource-indexes", fmt.Sprintf("\tcannot walk resources: %v\n", err)), true
+		}
+
+		msg += staleIndexEntries(ctx, "creator", k.resourcesByCreator, func(key collections.Pair[string, uint64]) bool {
+			resource, found := resources[key.K2()]
+			return found && resource.Creator == key.K1()
+		})
+		msg += staleIndexEntries(ctx, "owner", k.resourcesByOwner, func(key collections.Pair[string, uint64]) bool {
+			resource, found := resources[key.K2()]
+			return found && resource.Owner == key.K1()
+		})
+		msg += staleIndexEntries(ctx, "expiry height", k.expiryHeightQueue, func(key collections.Pair[int64, uint64]) bool {
+			resource, found := resources[key.K2()]
+			return found && resource.ExpiryHeight == key.K1()
+		})
+		msg += staleIndexEntries(ctx, "expiry time", k.expiryTimeQueue, func(key collections.Pair[time.Time, uint64]) bool {
+			resource, found := resources[key.K2()]
+			return found && resource.ExpiryTime != nil && resource.ExpiryTime.Equal(key.K1())
+		})
+		msg += staleIndexEntries(ctx, "attribute", k.resourcesByAttribute, func(key collections.Triple[string, string, uint64]) bool {
+			resource, found := resources[key.K3()]
+			if !found {
+				return false
+			}
+			value, found := resource.GetAttribute(key.K1())
+			return found && value == key.K2()
+		})
+		msg += staleIndexEntries(ctx, "tag", k.resourcesByTag, func(key collections.Pair[string, uint64]) bool {
+			resource, found := resources[key.K2()]
+			return found && resource.HasTag(key.K1())
+		})
+		msg += staleIndexEntries(ctx, "name", k.resourcesByName, func(key collections.Pair[string, uint64]) bool {
+			resource, found := resources[key.K2()]
+			return found && resource.Name == key.K1()
+		})
+		msg += staleIndexEntries(ctx, "namespace", k.resourcesByNamespace, func(key collections.Pair[string, uint64]) bool {
+			resource, found := resources[key.K2()]
+			return found && resource.Namespace == key.K1()
+		})
+		msg += staleIndexEntries(ctx, "grantee", k.resourcesByGrantee, func(key collections.Pair[string, uint64]) bool {
+			has, err := k.resourceRoles.Has(ctx, collections.Join(key.K2(), key.K1()))
+			return err == nil && has
+		})
+
+		err = k.resourceRoles.Walk(ctx, nil, func(key collections.Pair[uint64, string], _ types.ResourceRoleGrant) (bool, error) {
+			if _, found := resources[key.K1()]; !found {
+				msg += fmt.Sprintf("\trole grant to %s on unknown resource %d\n", key.K2(), key.K1())
+			}
+			msg += missingIndexEntry(ctx, "grantee", k.resourcesByGrantee, collections.Join(key.K2(), key.K1()))
+			return false, nil
+		})
+		if err != nil {
+			msg += fmt.Sprintf("\tcannot walk role grants: %v\n", err)
+		}
+
+		err = k.transferOffers.Walk(ctx, nil, func(id uint64, _ types.ResourceTransferOffer) (bool, error) {
+			if _, found := resources[id]; !found {
+				msg += fmt.Sprintf("\ttransfer offer for unknown resource %d\n", id)
+			}
+			return false, nil
+		})
+		if err != nil {
+			msg += fmt.Sprintf("\tcannot walk transfer offers: %v\n", err)
+		}
+
+		err = k.replications.Walk(ctx, nil, func(key collections.Pair[uint64, string], _ types.ResourceReplication) (bool, error) {
+			if _, found := resources[key.K1()]; !found {
+				msg += fmt.Sprintf("\treplication of unknown resource %d to %s\n", key.K1(), key.K2())
+			}
+			msg += missingIndexEntry(ctx, "replication channel", k.channelReplications, collections.Join(key.K2(), key.K1()))
+			return false, nil
+		})
+		if err != nil {
+			msg += fmt.Sprintf("\tcannot walk replications: %v\n", err)
+		}
+		msg += staleIndexEntries(ctx, "replication channel", k.channelReplications, func(key collections.Pair[string, uint64]) bool {
+			has, err := k.replications.Has(ctx, collections.Join(key.K2(), key.K1()))
+			return err == nil && has
+		})
+
+		err = k.replicas.Walk(ctx, nil, func(key collections.Pair[string, uint64], replica types.ResourceReplica) (bool, error) {
+			resource, found := resources[replica.ResourceId]
+			if !found {
+				msg += fmt.Sprintf("\treplica of resource %d of %s is unknown resource %d\n", key.K2(), key.K1(), replica.ResourceId)
+			} else if resource.Owner != replicaOwner() {
+				msg += fmt.Sprintf("\treplica %d is owned by %s rather than the module\n", replica.ResourceId, resource.Owner)
+			}
+			return false, nil
+		})
+		if err != nil {
+			msg += fmt.Sprintf("\tcannot walk replicas: %v\n", err)
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "resource-indexes", msg), msg != ""
+	}
+}
+
+// DepositEscrowInvariant checks that the module account holds exactly the sum
+// of the deposits recorded on resources
+func DepositEscrowInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var expected sdk.Coins
+		for _, resource := range k.GetAllResource(ctx) {
+			expected = expected.Add(resource.Deposit...)
+		}
+
+		escrow := k.bankKeeper.GetAllBalances(ctx, authtypes.NewModuleAddress(types.ModuleName))
+		broken := !escrow.Equal(expected)
+
+		return sdk.FormatInvariant(
+			types.ModuleName, "deposit-escrow",
+			fmt.Sprintf("\tescrow balance: %s\n\tsum of resource deposits: %s\n", escrow, expected),
+		), broken
+	}
+}
+
+// ResourceStatsInvariant checks that the aggregates maintained as resources
+// are written and removed match the stored resources
+func ResourceStatsInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var msg string
+
+		expected := types.ResourceTotals{ValueSum: sdkmath.ZeroInt()}
+		creatorCounts := make(map[string]uint64)
+		valueCounts := make(map[uint64]uint64)
+		namespaceCounts := make(map[string]uint64)
+		err := k.resources.Walk(ctx, nil, func(_ uint64, resource types.Resource) (bool, error) {
+			expected.LiveCount++
+			creatorCounts[resource.Creator]++
+			if resource.Namespace != "" {
+				namespaceCounts[resource.Namespace]++
+			}
+			if value, ok := resource.Payload.Uint64(); ok {
+				expected.ValueCount++
+				expected.ValueSum = expected.ValueSum.Add(sdkmath.NewIntFromUint64(value))
+				valueCounts[value]++
+			}
+			return false, nil
+		})
+		if err != nil {
+			return sdk.FormatInvariant(types.ModuleName, "resource-stats", fmt.Sprintf("\tcannot walk resources: %v\n", err)), true
+		}
+		expected.CreatorCount = uint64(len(creatorCounts))
+
+		totals := k.GetResourceTotals(ctx)
+		if totals.LiveCount != expected.LiveCount || totals.CreatorCount != expected.CreatorCount ||
+			totals.ValueCount != expected.ValueCount || !totals.ValueSum.Equal(expected.ValueSum) {
+			msg += fmt.Sprintf("\tresource totals %v, expected %v\n", totals, expected)
+		}
+
+		err = k.creatorCounts.Walk(ctx, nil, func(creator string, count uint64) (bool, error) {
+			if count != creatorCounts[creator] {
+				msg += fmt.Sprintf("\t%s counted with %d resources, expected %d\n", creator, count, creatorCounts[creator])
+			}
+			delete(creatorCounts, creator)
+			return false, nil
+		})
+		if err != nil {
+			msg += fmt.Sprintf("\tcannot walk creator resource counts: %v\n", err)
+		}
+		for creator, count := range creatorCounts {
+			msg += fmt.Sprintf("\t%s not counted, expected %d resources\n", creator, count)
+		}
+
+		err = k.creatorRanks.Walk(ctx, nil, func(key collections.Pair[uint64, string]) (bool, error) {
+			if count := k.GetCreatorResourceCount(ctx, key.K2()); count != rankCount(key.K1()) {
+				msg += fmt.Sprintf("\t%s ranked with %d resources, counted with %d\n", key.K2(), rankCount(key.K1()), count)
+			}
+			return false, nil
+		})
+		if err != nil {
+			msg += fmt.Sprintf("\tcannot walk creator ranks: %v\n", err)
+		}
+		err = k.creatorCounts.Walk(ctx, nil, func(creator string, count uint64) (bool, error) {
+			msg += missingIndexEntry(ctx, "creator rank", k.creatorRanks, collections.Join(rankKey(count), creator))
+			return false, nil
+		})
+		if err != nil {
+			msg += fmt.Sprintf("\tcannot walk creator resource counts: %v\n", err)
+		}
+
+		err = k.valueCounts.Walk(ctx, nil, func(value, count uint64) (bool, error) {
+			if count != valueCounts[value] {
+				msg += fmt.Sprintf("\tvalue %d counted %d times, expected %d\n", value, count, valueCounts[value])
+			}
+			delete(valueCounts, value)
+			return false, nil
+		})
+		if err != nil {
+			msg += fmt.Sprintf("\tcannot walk resource value counts: %v\n", err)
+		}
+		for value, count := range valueCounts {
+			msg += fmt.Sprintf("\tvalue %d not counted, expected %d times\n", value, count)
+		}
+
+		err = k.namespaceCounts.Walk(ctx, nil, func(namespace string, count uint64) (bool, error) {
+			if count != namespaceCounts[namespace] {
+				msg += fmt.Sprintf("\tnamespace %s counted with %d resources, expected %d\n", namespace, count, namespaceCounts[namespace])
+			}
+			delete(namespaceCounts, namespace)
+			return false, nil
+		})
+		if err != nil {
+			msg += fmt.Sprintf("\tcannot walk namespace resource counts: %v\n", err)
+		}
+		for namespace, count := range namespaceCounts {
+			msg += fmt.Sprintf("\tnamespace %s not counted, expected %d resources\n", namespace, count)
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "resource-stats", msg), msg != ""
+	}
+}
+
+// undecodableValues reports the entries of a map whose value fails to decode
+func undecodableValues[K, V any](ctx context.Context, name string, m collections.Map[K, V]) (msg string) {
+	iterator, err := m.Iterate(ctx, nil)
+	if err != nil {
+		return fmt.Sprintf("\tcannot iterate %s store: %v\n", name, err)
+	}
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		if _, err := iterator.Value(); err != nil {
+			key, _ := iterator.Key()
+			msg += fmt.Sprintf("\t%s %v does not decode: %v\n", name, key, err)
+		}
+	}
+
+	return msg
+}
+
+// missingIndexEntry reports an index entry expected for a primary record
+func missingIndexEntry[K any](ctx context.Context, name string, index collections.KeySet[K], key K) string {
+	has, err := index.Has(ctx, key)
+	if err != nil {
+		return fmt.Sprintf("\tcannot read %s index: %v\n", name, err)
+	}
+	if !has {
+		return fmt.Sprintf("\tmissing %s index entry %v\n", name, key)
+	}
+
+	return ""
+}
+
+// staleIndexEntries reports the index entries that no primary record backs
+func staleIndexEntries[K any](ctx context.Context, name string, index collections.KeySet[K], backed func(K) bool) (msg string) {
+	err := index.Walk(ctx, nil, func(key K) (bool, error) {
+		if !backed(key) {
+			msg += fmt.Sprintf("\tstale %s index entry %v\n", name, key)
+		}
+		return false, nil
+	})
+	if err != nil {
+		msg += fmt.Sprintf("\tcannot walk %s index: %v\n", name, err)
+	}
+
+	return msg
+}
@@ -0,0 +1,229 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "crude/testutil/keeper"
+	"crude/x/crude/keeper"
+	"crude/x/crude/types"
+)
+
+// rawStoreKeeper builds a keeper whose underlying store the test can corrupt
+func rawStoreKeeper(t *testing.T) (keeper.Keeper, sdk.Context, storetypes.KVStore) {
+	t.Helper()
+
+	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContext(storeKey, storetypes.NewTransientStoreKey("transient_test"))
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	k := keeper.NewKeeper(cdc, runtime.NewKVStoreService(storeKey), log.NewNopLogger(), authtypes.NewModuleAddress(govtypes.ModuleName).String(), nil, nil, nil)
+	require.NoError(t, k.SetParams(ctx, types.DefaultParams()))
+	require.NoError(t, k.RebuildResourceStats(ctx))
+
+	return k, ctx, ctx.KVStore(storeKey)
+}
+
+func TestAllInvariants(t *testing.T) {
+	k, ctx := keepertest.CrudeKeeper(t)
+	srv := keeper.NewMsgServerImpl(k)
+
+	for i := 0; i < 3; i++ {
+		_, err := srv.CreateResource(ctx, &types.MsgCreateResource{Creator: "A", Name: "name", ExpiryHeight: 100})
+		require.NoError(t, err)
+	}
+	_, err := srv.GrantResourceRole(ctx, &types.MsgGrantResourceRole{Creator: "A", Id: 0, Address: "B", Role: types.ResourceRole_RESOURCE_ROLE_EDITOR})
+	require.NoError(t, err)
+	_, err = srv.OfferResourceTransfer(ctx, &types.MsgOfferResourceTransfer{Creator: "A", Id: 1, Recipient: "B"})
+	require.NoError(t, err)
+	_, err = srv.DeleteResource(ctx, &types.MsgDeleteResource{Creator: "A", Id: 2})
+	require.NoError(t, err)
+
+	msg, broken := keeper.AllInvariants(k)(ctx)
+	require.False(t, broken, msg)
+}
+
+func TestResourceCountInvariant(t *testing.T) {
+	k, ctx := keepertest.CrudeKeeper(t)
+	createNResource(k, ctx, 3)
+
+	_, broken := keeper.ResourceCountInvariant(k)(ctx)
+	require.False(t, broken)
+
+	// Winding the count back lets the next append overwrite resource 2
+	require.NoError(t, k.SetResourceCount(ctx, 2))
+	msg, broken := keeper.ResourceCountInvariant(k)(ctx)
+	require.True(t, broken)
+	require.Contains(t, msg, "resource 2 is not below the resource count 2")
+}
+
+func TestStoredValuesInvariant(t *testing.T) {
+	k, ctx, store := rawStoreKeeper(t)
+	createNResource(k, ctx, 2)
+
+	_, broken := keeper.StoredValuesInvariant(k)(ctx)
+	require.False(t, broken)
+
+	key, err := collections.EncodeKeyWithPrefix(types.ResourceKey, collections.Uint64Key, 1)
+	require.NoError(t, err)
+	store.Set(key, []byte{0xff, 0xff})
+	msg, broken := keeper.StoredValuesInvariant(k)(ctx)
+	require.True(t, broken)
+	require.Contains(t, msg, "resource 1 does not decode")
+}
+
+func TestResourceStatsInvariant(t *testing.T) {
+	k, ctx, store := rawStoreKeeper(t)
+	require.NoError(t, k.SetResource(ctx, types.Resource{Id: 0, Owner: "A", Creator: "A", Payload: types.NewUint64Payload(5)}))
+	require.NoError(t, k.SetResource(ctx, types.Resource{Id: 1, Owner: "B", Creator: "B"}))
+
+	_, broken := keeper.ResourceStatsInvariant(k)(ctx)
+	require.False(t, broken)
+
+	// A resource written behind the keeper's back is missing from the aggregates
+	resource := types.Resource{Id: 2, Owner: "C", Creator: "C", Payload: types.NewUint64Payload(7)}
+	key, err := collections.EncodeKeyWithPrefix(types.ResourceKey, collections.Uint64Key, 2)
+	require.NoError(t, err)
+	store.Set(key, codec.NewProtoCodec(codectypes.NewInterfaceRegistry()).MustMarshal(&resource))
+	msg, broken := keeper.ResourceStatsInvariant(k)(ctx)
+	require.True(t, broken)
+	require.Contains(t, msg, "C not counted, expected 1 resources")
+	require.Contains(t, msg, "value 7 not counted, expected 1 times")
+
+	require.NoError(t, k.RebuildResourceStats(ctx))
+	msg, broken = keeper.ResourceStatsInvariant(k)(ctx)
+	require.False(t, broken, msg)
+}
+
+func TestResourceIndexesInvariant(t *testing.T) {
+	expiry := time.Unix(1_000, 0).UTC()
+
+	for _, tc := range []struct {
+		desc    string
+		corrupt func(t *testing.T, k keeper.Keeper, ctx sdk.Context, store storetypes.KVStore)
+		msg     string
+	}{
+		{
+			desc:    "Valid",
+			corrupt: func(*testing.T, keeper.Keeper, sdk.Context, storetypes.KVStore) {},
+		},
+		{
+			desc: "MissingCreatorEntry",
+			corrupt: func(t *testing.T, _ keeper.Keeper, _ sdk.Context, store storetypes.KVStore) {
+				key, err := collections.EncodeKeyWithPrefix(types.ResourceCreatorKey, collections.PairKeyCodec(collections.StringKey, collections.Uint64Key), collections.Join("A", uint64(0)))
+				require.NoError(t, err)
+				store.Delete(key)
+			},
+			msg: "missing creator index entry",
+		},
+		{
+			desc: "StaleOwnerEntry",
+			corrupt: func(t *testing.T, _ keeper.Keeper, _ sdk.Context, store storetypes.KVStore) {
+				key, err := collections.EncodeKeyWithPrefix(types.ResourceOwnerKey, collections.PairKeyCodec(collections.StringKey, collections.Uint64Key), collections.Join("B", uint64(0)))
+				require.NoError(t, err)
+				store.Set(key, []byte{})
+			},
+			msg: "stale owner index entry",
+		},
+		{
+			desc: "StaleExpiryTimeEntry",
+			corrupt: func(t *testing.T, _ keeper.Keeper, _ sdk.Context, store storetypes.KVStore) {
+				key, err := collections.EncodeKeyWithPrefix(types.ResourceExpiryTimeKey, collections.PairKeyCodec(sdk.TimeKey, collections.Uint64Key), collections.Join(expiry.Add(time.Second), uint64(0)))
+				require.NoError(t, err)
+				store.Set(key, []byte{})
+			},
+			msg: "stale expiry time index entry",
+		},
+		{
+			desc: "StaleAttributeEntry",
+			corrupt: func(t *testing.T, _ keeper.Keeper, _ sdk.Context, store storetypes.KVStore) {
+				key, err := collections.EncodeKeyWithPrefix(types.ResourceAttributeKey, collections.TripleKeyCodec(collections.StringKey, collections.StringKey, collections.Uint64Key), collections.Join3("colour", "blue", uint64(0)))
+				require.NoError(t, err)
+				store.Set(key, []byte{})
+			},
+			msg: "stale attribute index entry",
+		},
+		{
+			desc: "MissingTagEntry",
+			corrupt: func(t *testing.T, _ keeper.Keeper, _ sdk.Context, store storetypes.KVStore) {
+				key, err := collections.EncodeKeyWithPrefix(types.ResourceTagKey, collections.PairKeyCodec(collections.StringKey, collections.Uint64Key), collections.Join("env:prod", uint64(0)))
+				require.NoError(t, err)
+				store.Delete(key)
+			},
+			msg: "missing tag index entry",
+		},
+		{
+			desc: "StaleNameEntry",
+			corrupt: func(t *testing.T, _ keeper.Keeper, _ sdk.Context, store storetypes.KVStore) {
+				key, err := collections.EncodeKeyWithPrefix(types.ResourceNameKey, collections.PairKeyCodec(collections.StringKey, collections.Uint64Key), collections.Join("b", uint64(0)))
+				require.NoError(t, err)
+				store.Set(key, []byte{})
+			},
+			msg: "stale name index entry",
+		},
+		{
+			desc: "MissingNamespaceEntry",
+			corrupt: func(t *testing.T, _ keeper.Keeper, _ sdk.Context, store storetypes.KVStore) {
+				key, err := collections.EncodeKeyWithPrefix(types.ResourceNamespaceKey, collections.PairKeyCodec(collections.StringKey, collections.Uint64Key), collections.Join("acme", uint64(0)))
+				require.NoError(t, err)
+				store.Delete(key)
+			},
+			msg: "missing namespace index entry",
+		},
+		{
+			desc: "UnknownNamespace",
+			corrupt: func(t *testing.T, _ keeper.Keeper, _ sdk.Context, store storetypes.KVStore) {
+				key, err := collections.EncodeKeyWithPrefix(types.NamespaceKey, collections.StringKey, "acme")
+				require.NoError(t, err)
+				store.Delete(key)
+			},
+			msg: "resource 0 in unknown namespace acme",
+		},
+		{
+			desc: "GrantOnUnknownResource",
+			corrupt: func(t *testing.T, k keeper.Keeper, ctx sdk.Context, _ storetypes.KVStore) {
+				require.NoError(t, k.SetResourceRoleGrant(ctx, types.ResourceRoleGrant{ResourceId: 9, Address: "B", Role: types.ResourceRole_RESOURCE_ROLE_EDITOR}))
+			},
+			msg: "role grant to B on unknown resource 9",
+		},
+		{
+			desc: "MismatchedId",
+			corrupt: func(t *testing.T, k keeper.Keeper, ctx sdk.Context, store storetypes.KVStore) {
+				resource, found := k.GetResource(ctx, 0)
+				require.True(t, found)
+				resource.Id = 1
+				key, err := collections.EncodeKeyWithPrefix(types.ResourceKey, collections.Uint64Key, 0)
+				require.NoError(t, err)
+				store.Set(key, codec.NewProtoCodec(codectypes.NewInterfaceRegistry()).MustMarshal(&resource))
+			},
+			msg: "resource stored under 0 has id 1",
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			k, ctx, store := rawStoreKeeper(t)
+			require.NoError(t, k.SetNamespace(ctx, types.Namespace{Name: "acme", Admin: "A"}))
+			require.NoError(t, k.SetResource(ctx, types.Resource{Id: 0, Name: "a", Owner: "A", Creator: "A", ExpiryTime: &expiry, Attributes: []types.ResourceAttribute{{Key: "colour", Value: "red"}}, Tags: []string{"env:prod"}, Namespace: "acme"}))
+			require.NoError(t, k.SetResourceCount(ctx, 1))
+
+			tc.corrupt(t, k, ctx, store)
+			msg, broken := keeper.ResourceIndexesInvariant(k)(ctx)
+			if tc.msg == "" {
+				require.False(t, broken, msg)
+			} else {
+				require.True(t, broken)
+				require.Contains(t, msg, tc.msg)
+			}
+		})
+	}
+}
@@ -2,11 +2,15 @@ package keeper
 
 import (
 	"fmt"
+	"time"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/core/store"
 	"cosmossdk.io/log"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	capabilitykeeper "github.com/cosmos/ibc-go/modules/capability/keeper"
+	ibckeeper "github.com/cosmos/ibc-go/v8/modules/core/keeper"
 
 	"crude/x/crude/types"
 )
@@ -20,6 +24,45 @@ type (
 		// the address capable of executing a MsgUpdateParams message. Typically, this
 		// should be the x/gov module account.
 		authority string
+
+		bankKeeper types.BankKeeper
+		hooks      types.ResourceHooks
+
+		// the IBC keepers are built after the module, hence the getters
+		ibcKeeperFn        func() *ibckeeper.Keeper
+		capabilityScopedFn func(string) capabilitykeeper.ScopedKeeper
+
+		// storeQuerier is shared by the copies of the keeper, so that it can be
+		// set once the app has built its multistore
+		storeQuerier *storeQuerier
+
+		Schema               collections.Schema
+		params               collections.Item[types.Params]
+		resources            collections.Map[uint64, types.Resource]
+		resourceCount        collections.Sequence
+		resourcesByCreator   collections.KeySet[collections.Pair[string, uint64]]
+		resourceRevisions    collections.Map[collections.Pair[uint64, uint64], types.ResourceRevision]
+		resourcesByOwner     collections.KeySet[collections.Pair[string, uint64]]
+		transferOffers       collections.Map[uint64, types.ResourceTransferOffer]
+		resourceRoles        collections.Map[collections.Pair[uint64, string], types.ResourceRoleGrant]
+		resourcesByGrantee   collections.KeySet[collections.Pair[string, uint64]]
+		expiryHeightQueue    collections.KeySet[collections.Pair[int64, uint64]]
+		expiryTimeQueue      collections.KeySet[collections.Pair[time.Time, uint64]]
+		resourcesByAttribute collections.KeySet[collections.Triple[string, string, uint64]]
+		resourcesByTag       collections.KeySet[collections.Pair[string, uint64]]
+		resourceSchemas      collections.Map[collections.Pair[string, uint64], types.ResourceSchema]
+		namespaces           collections.Map[string, types.Namespace]
+		resourcesByNamespace collections.KeySet[collections.Pair[string, uint64]]
+		resourcesByName      collections.KeySet[collections.Pair[string, uint64]]
+		resourceTotals       collections.Item[types.ResourceTotals]
+		creatorCounts        collections.Map[string, uint64]
+		creatorRanks         collections.KeySet[collections.Pair[uint64, string]]
+		valueCounts          collections.Map[uint64, uint64]
+		port                 collections.Item[string]
+		replications         collections.Map[collections.Pair[uint64, string], types.ResourceReplication]
+		channelReplications  collections.KeySet[collections.Pair[string, uint64]]
+		replicas             collections.Map[collections.Pair[string, uint64], types.ResourceReplica]
+		namespaceCounts      collections.Map[string, uint64]
 	}
 )
 
@@ -29,17 +72,61 @@ func NewKeeper(
 	logger log.Logger,
 	authority string,
 
+	bankKeeper types.BankKeeper,
+	ibcKeeperFn func() *ibckeeper.Keeper,
+	capabilityScopedFn func(string) capabilitykeeper.ScopedKeeper,
 ) Keeper {
 	if _, err := sdk.AccAddressFromBech32(authority); err != nil {
 		panic(fmt.Sprintf("invalid authority address: %s", authority))
 	}
 
-	return Keeper{
+	sb := collections.NewSchemaBuilder(storeService)
+	k := Keeper{
 		cdc:          cdc,
 		storeService: storeService,
 		authority:    authority,
 		logger:       logger,
+		bankKeeper:   bankKeeper,
+		storeQuerier: &storeQuerier{},
+
+		ibcKeeperFn:        ibcKeeperFn,
+		capabilityScopedFn: capabilityScopedFn,
+
+		params:               collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
+		resources:            collections.NewMap(sb, types.ResourceKey, "resource", collections.Uint64Key, codec.CollValue[types.Resource](cdc)),
+		resourceCount:        collections.NewSequence(sb, types.ResourceCountKey, "resource_count"),
+		resourcesByCreator:   collections.NewKeySet(sb, types.ResourceCreatorKey, "resources_by_creator", collections.PairKeyCodec(collections.StringKey, collections.Uint64Key)),
+		resourceRevisions:    collections.NewMap(sb, types.ResourceRevisionKey, "resource_revisions", collections.PairKeyCodec(collections.Uint64Key, collections.Uint64Key), codec.CollValue[types.ResourceRevision](cdc)),
+		resourcesByOwner:     collections.NewKeySet(sb, types.ResourceOwnerKey, "resources_by_owner", collections.PairKeyCodec(collections.StringKey, collections.Uint64Key)),
+		transferOffers:       collections.NewMap(sb, types.ResourceTransferOfferKey, "resource_transfer_offers", collections.Uint64Key, codec.CollValue[types.ResourceTransferOffer](cdc)),
+		resourceRoles:        collections.NewMap(sb, types.ResourceRoleKey, "resource_roles", collections.PairKeyCodec(collections.Uint64Key, collections.StringKey), codec.CollValue[types.ResourceRoleGrant](cdc)),
+		resourcesByGrantee:   collections.NewKeySet(sb, types.ResourceGranteeKey, "resources_by_grantee", collections.PairKeyCodec(collections.StringKey, collections.Uint64Key)),
+		expiryHeightQueue:    collections.NewKeySet(sb, types.ResourceExpiryHeightKey, "resource_expiry_height_queue", collections.PairKeyCodec(collections.Int64Key, collections.Uint64Key)),
+		expiryTimeQueue:      collections.NewKeySet(sb, types.ResourceExpiryTimeKey, "resource_expiry_time_queue", collections.PairKeyCodec(sdk.TimeKey, collections.Uint64Key)),
+		resourcesByAttribute: collections.NewKeySet(sb, types.ResourceAttributeKey, "resources_by_attribute", collections.TripleKeyCodec(collections.StringKey, collections.StringKey, collections.Uint64Key)),
+		resourcesByTag:       collections.NewKeySet(sb, types.ResourceTagKey, "resources_by_tag", collections.PairKeyCodec(collections.StringKey, collections.Uint64Key)),
+		resourceSchemas:      collections.NewMap(sb, types.ResourceSchemaKey, "resource_schemas", collections.PairKeyCodec(collections.StringKey, collections.Uint64Key), codec.CollValue[types.ResourceSchema](cdc)),
+		namespaces:           collections.NewMap(sb, types.NamespaceKey, "namespaces", collections.StringKey, codec.CollValue[types.Namespace](cdc)),
+		resourcesByNamespace: collections.NewKeySet(sb, types.ResourceNamespaceKey, "resources_by_namespace", collections.PairKeyCodec(collections.StringKey, collections.Uint64Key)),
+		resourcesByName:      collections.NewKeySet(sb, types.ResourceNameKey, "resources_by_name", collections.PairKeyCodec(collections.StringKey, collections.Uint64Key)),
+		resourceTotals:       collections.NewItem(sb, types.ResourceTotalsKey, "resource_totals", codec.CollValue[types.ResourceTotals](cdc)),
+		creatorCounts:        collections.NewMap(sb, types.CreatorResourceCountKey, "creator_resource_counts", collections.StringKey, collections.Uint64Value),
+		creatorRanks:         collections.NewKeySet(sb, types.CreatorRankKey, "creator_ranks", collections.PairKeyCodec(collections.Uint64Key, collections.StringKey)),
+		valueCounts:          collections.NewMap(sb, types.ResourceValueCountKey, "resource_value_counts", collections.Uint64Key, collections.Uint64Value),
+		port:                 collections.NewItem(sb, types.PortKey, "port", collections.StringValue),
+		replications:         collections.NewMap(sb, types.ResourceReplicationKey, "resource_replications", collections.PairKeyCodec(collections.Uint64Key, collections.StringKey), codec.CollValue[types.ResourceReplication](cdc)),
+		channelReplications:  collections.NewKeySet(sb, types.ReplicationChannelKey, "resource_replications_by_channel", collections.PairKeyCodec(collections.StringKey, collections.Uint64Key)),
+		replicas:             collections.NewMap(sb, types.ResourceReplicaKey, "resource_replicas", collections.PairKeyCodec(collections.StringKey, collections.Uint64Key), codec.CollValue[types.ResourceReplica](cdc)),
+		namespaceCounts:      collections.NewMap(sb, types.NamespaceResourceCountKey, "namespace_resource_counts", collections.StringKey, collections.Uint64Value),
+	}
+
+	schema, err := sb.Build()
+	if err != nil {
+		panic(err)
 	}
+	k.Schema = schema
+
+	return k
 }
 
 // GetAuthority returns the module's authority.
@@ -0,0 +1,58 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	v2 "crude/x/crude/migrations/v2"
+	v3 "crude/x/crude/migrations/v3"
+	v4 "crude/x/crude/migrations/v4"
+	v5 "crude/x/crude/migrations/v5"
+	v6 "crude/x/crude/migrations/v6"
+	v7 "crude/x/crude/migrations/v7"
+	v8 "crude/x/crude/migrations/v8"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 migrates the crude store from consensus version 1 to 2.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	return v2.MigrateStore(ctx, m.keeper.storeService)
+}
+
+// Migrate2to3 migrates the crude store from consensus version 2 to 3.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	return v3.MigrateStore(ctx, m.keeper.storeService)
+}
+
+// Migrate3to4 migrates the crude store from consensus version 3 to 4.
+func (m Migrator) Migrate3to4(ctx sdk.Context) error {
+	return v4.MigrateStore(ctx, m.keeper.storeService)
+}
+
+// Migrate4to5 migrates the crude store from consensus version 4 to 5.
+func (m Migrator) Migrate4to5(ctx sdk.Context) error {
+	return v5.MigrateStore(ctx, m.keeper.storeService)
+}
+
+// Migrate5to6 migrates the crude store from consensus version 5 to 6.
+func (m Migrator) Migrate5to6(ctx sdk.Context) error {
+	return v6.MigrateStore(ctx, m.keeper.storeService)
+}
+
+// Migrate6to7 migrates the crude store from consensus version 6 to 7.
+func (m Migrator) Migrate6to7(ctx sdk.Context) error {
+	return v7.MigrateStore(ctx)
+}
+
+// Migrate7to8 migrates the crude store from consensus version 7 to 8.
+func (m Migrator) Migrate7to8(ctx sdk.Context) error {
+	return v8.MigrateStore(ctx, m.keeper)
+}
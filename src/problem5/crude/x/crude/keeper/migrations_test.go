@@ -0,0 +1,110 @@
+package keeper_test
+
+import (
+	"encoding/binary"
+	"testing"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
+
+	"crude/x/crude/keeper"
+	v2 "crude/x/crude/migrations/v2"
+	"crude/x/crude/types"
+)
+
+// TestMigrateFromV1 runs every migration on a v1 store, as a chain upgrading
+// from the first release does.
+func TestMigrateFromV1(t *testing.T) {
+	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContext(storeKey, storetypes.NewTransientStoreKey("transient_test"))
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	k := keeper.NewKeeper(cdc, runtime.NewKVStoreService(storeKey), log.NewNopLogger(), authtypes.NewModuleAddress(govtypes.ModuleName).String(), nil, nil, nil)
+
+	// Seed the store with the v1 encoding {1:id, 2:name, 3:value, 4:creator}
+	store := ctx.KVStore(storeKey)
+	values := []uint64{5, 0, 1 << 40}
+	creators := []string{"A", "B", "A"}
+	for id, value := range values {
+		var bz []byte
+		if id != 0 {
+			bz = protowire.AppendTag(bz, 1, protowire.VarintType)
+			bz = protowire.AppendVarint(bz, uint64(id))
+		}
+		bz = protowire.AppendTag(bz, 2, protowire.BytesType)
+		bz = protowire.AppendString(bz, "widget")
+		if value != 0 {
+			bz = protowire.AppendTag(bz, 3, protowire.VarintType)
+			bz = protowire.AppendVarint(bz, value)
+		}
+		bz = protowire.AppendTag(bz, 4, protowire.BytesType)
+		bz = protowire.AppendString(bz, creators[id])
+
+		key := append(types.KeyPrefix(v2.ResourceKey), types.KeyPrefix(v2.ResourceKey)...)
+		key = binary.BigEndian.AppendUint64(append(key, '/'), uint64(id))
+		store.Set(key, bz)
+	}
+	store.Set(types.KeyPrefix(v2.ResourceCountKey), binary.BigEndian.AppendUint64(nil, uint64(len(values))))
+	// v1 params had no field
+	store.Set(types.ParamsKey, []byte{})
+
+	m := keeper.NewMigrator(k)
+	for _, migrate := range []func(sdk.Context) error{
+		m.Migrate1to2, m.Migrate2to3, m.Migrate3to4, m.Migrate4to5, m.Migrate5to6, m.Migrate6to7, m.Migrate7to8,
+	} {
+		require.NoError(t, migrate(ctx))
+	}
+
+	// Every value survived as the uint64 arm of the payload
+	for id, value := range values {
+		resource, found := k.GetResource(ctx, uint64(id))
+		require.True(t, found)
+		require.Equal(t, types.Resource{
+			Id:      uint64(id),
+			Name:    "widget",
+			Owner:   creators[id],
+			Creator: creators[id],
+			Version: 1,
+			Payload: types.NewUint64Payload(value),
+		}, resource)
+	}
+	require.Equal(t, uint64(len(values)), k.GetResourceCount(ctx))
+
+	// and the indexes and aggregates match the migrated resources
+	require.Equal(t, []uint64{0, 2}, k.GetResourceIDsByCreator(ctx, "A"))
+	require.Equal(t, []uint64{1}, k.GetResourceIDsByOwner(ctx, "B"))
+	require.Equal(t, []uint64{0, 1, 2}, k.GetResourceIDsByName(ctx, "widget"))
+	totals := k.GetResourceTotals(ctx)
+	require.Equal(t, uint64(3), totals.LiveCount)
+	require.Equal(t, math.NewIntFromUint64(5+1<<40), totals.ValueSum)
+	for _, invariant := range []sdk.Invariant{
+		keeper.ResourceCountInvariant(k),
+		keeper.StoredValuesInvariant(k),
+		keeper.ResourceIndexesInvariant(k),
+		keeper.ResourceStatsInvariant(k),
+	} {
+		msg, broken := invariant(ctx)
+		require.False(t, broken, msg)
+	}
+	require.Equal(t, types.PortID, k.GetPort(ctx))
+
+	// The params shipped since v1 hold their defaults, so expired resources
+	// are pruned
+	require.Equal(t, types.DefaultParams(), k.GetParams(ctx))
+	ctx = ctx.WithBlockHeight(10)
+	resource, _ := k.GetResource(ctx, 1)
+	resource.ExpiryHeight = ctx.BlockHeight()
+	require.NoError(t, k.SetResource(ctx, resource))
+	require.NoError(t, k.PruneExpiredResources(ctx))
+	_, found := k.GetResource(ctx, 1)
+	require.False(t, found)
+}
@@ -0,0 +1,104 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"crude/x/crude/types"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func (k msgServer) CreateNamespace(goCtx context.Context, msg *types.MsgCreateNamespace) (*types.MsgCreateNamespaceResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := types.ValidateNamespaceName(msg.Name); err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidNamespace, err.Error())
+	}
+
+	// Checks that the name is not already taken
+	if _, found := k.GetNamespace(ctx, msg.Name); found {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("namespace %s already exists", msg.Name))
+	}
+
+	var namespace = types.Namespace{
+		Name:         msg.Name,
+		Admin:        msg.Creator,
+		MaxResources: msg.MaxResources,
+		DefaultRoles: msg.DefaultRoles,
+	}
+	if err := k.SetNamespace(ctx, namespace); err != nil {
+		return nil, err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventNamespaceCreated{
+		Name:  namespace.Name,
+		Admin: namespace.Admin,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCreateNamespaceResponse{}, nil
+}
+
+func (k msgServer) UpdateNamespace(goCtx context.Context, msg *types.MsgUpdateNamespace) (*types.MsgUpdateNamespaceResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Checks that the element exists
+	val, found := k.GetNamespace(ctx, msg.Name)
+	if !found {
+		return nil, errorsmod.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("namespace %s doesn't exist", msg.Name))
+	}
+
+	// Checks if the msg creator is the admin of the namespace
+	if msg.Creator != val.Admin {
+		return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "incorrect namespace admin")
+	}
+
+	namespace := val
+	if msg.Admin != "" {
+		namespace.Admin = msg.Admin
+	}
+	namespace.MaxResources = msg.MaxResources
+	namespace.DefaultRoles = msg.DefaultRoles
+	if err := k.SetNamespace(ctx, namespace); err != nil {
+		return nil, err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventNamespaceUpdated{
+		Name:   namespace.Name,
+		Signer: msg.Creator,
+		Admin:  namespace.Admin,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateNamespaceResponse{}, nil
+}
+
+// checkNamespaceCreation checks that creator may add a resource to a
+// namespace: the namespace exists, gives creator at least the editor role and
+// has room left in its quota. An empty namespace is always allowed.
+func (k Keeper) checkNamespaceCreation(ctx context.Context, name string, creator string) error {
+	if name == "" {
+		return nil
+	}
+
+	namespace, found := k.GetNamespace(ctx, name)
+	if !found {
+		return errorsmod.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("namespace %s doesn't exist", name))
+	}
+	if !namespace.RoleOf(creator).CanEdit() {
+		return errorsmod.Wrap(sdkerrors.ErrUnauthorized, "incorrect namespace admin or editor")
+	}
+	if namespace.MaxResources > 0 {
+		count := k.GetNamespaceResourceCount(ctx, name)
+		if count >= namespace.MaxResources {
+			return errorsmod.Wrapf(types.ErrQuotaExceeded, "namespace %s already holds %d resources", name, count)
+		}
+	}
+
+	return nil
+}
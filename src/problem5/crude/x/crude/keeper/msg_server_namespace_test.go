@@ -0,0 +1,142 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"crude/x/crude/types"
+)
+
+func TestResourceMsgServerCreateNamespace(t *testing.T) {
+	k, srv, ctx := setupMsgServer(t)
+	wctx := sdk.UnwrapSDKContext(ctx)
+
+	msg := &types.MsgCreateNamespace{
+		Creator:      "A",
+		Name:         "acme",
+		MaxResources: 2,
+		DefaultRoles: []types.NamespaceRoleGrant{{Address: "B", Role: types.ResourceRole_RESOURCE_ROLE_EDITOR}},
+	}
+	_, err := srv.CreateNamespace(wctx, msg)
+	require.NoError(t, err)
+	requireTypedEvent(t, wctx, &types.EventNamespaceCreated{Name: "acme", Admin: "A"})
+
+	namespace, found := k.GetNamespace(wctx, "acme")
+	require.True(t, found)
+	require.Equal(t, types.Namespace{Name: "acme", Admin: "A", MaxResources: 2, DefaultRoles: msg.DefaultRoles}, namespace)
+
+	// Names are unique
+	_, err = srv.CreateNamespace(wctx, &types.MsgCreateNamespace{Creator: "B", Name: "acme"})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+	_, err = srv.CreateNamespace(wctx, &types.MsgCreateNamespace{Creator: "B", Name: "acme/labs"})
+	require.ErrorIs(t, err, types.ErrInvalidNamespace)
+}
+
+func TestResourceMsgServerUpdateNamespace(t *testing.T) {
+	admin, editor, successor := "A", "B", "C"
+	k, srv, ctx := setupMsgServer(t)
+	wctx := sdk.UnwrapSDKContext(ctx)
+
+	_, err := srv.CreateNamespace(wctx, &types.MsgCreateNamespace{Creator: admin, Name: "acme", MaxResources: 1})
+	require.NoError(t, err)
+	_, err = srv.CreateResource(wctx, &types.MsgCreateResource{Creator: admin, Name: "a", Namespace: "acme"})
+	require.NoError(t, err)
+	_, err = srv.CreateResource(wctx, &types.MsgCreateResource{Creator: admin, Name: "b", Namespace: "acme"})
+	require.ErrorIs(t, err, types.ErrQuotaExceeded)
+
+	_, err = srv.UpdateNamespace(wctx, &types.MsgUpdateNamespace{Creator: admin, Name: "globex"})
+	require.ErrorIs(t, err, sdkerrors.ErrKeyNotFound)
+	_, err = srv.UpdateNamespace(wctx, &types.MsgUpdateNamespace{Creator: editor, Name: "acme", MaxResources: 10})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	// The admin raises the quota and replaces the default roles
+	roles := []types.NamespaceRoleGrant{{Address: editor, Role: types.ResourceRole_RESOURCE_ROLE_EDITOR}}
+	_, err = srv.UpdateNamespace(wctx, &types.MsgUpdateNamespace{Creator: admin, Name: "acme", MaxResources: 2, DefaultRoles: roles})
+	require.NoError(t, err)
+	requireTypedEvent(t, wctx, &types.EventNamespaceUpdated{Name: "acme", Signer: admin, Admin: admin})
+	namespace, found := k.GetNamespace(wctx, "acme")
+	require.True(t, found)
+	require.Equal(t, types.Namespace{Name: "acme", Admin: admin, MaxResources: 2, DefaultRoles: roles}, namespace)
+	_, err = srv.CreateResource(wctx, &types.MsgCreateResource{Creator: editor, Name: "b", Namespace: "acme"})
+	require.NoError(t, err)
+
+	// Handing the namespace over leaves the previous admin without rights
+	_, err = srv.UpdateNamespace(wctx, &types.MsgUpdateNamespace{Creator: admin, Name: "acme", Admin: successor, MaxResources: 2})
+	require.NoError(t, err)
+	requireTypedEvent(t, wctx, &types.EventNamespaceUpdated{Name: "acme", Signer: admin, Admin: successor})
+	_, err = srv.UpdateNamespace(wctx, &types.MsgUpdateNamespace{Creator: admin, Name: "acme"})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	rst, found := k.GetResource(wctx, 0)
+	require.True(t, found)
+	require.Equal(t, types.ResourceRole_RESOURCE_ROLE_ADMIN, k.GetResourceRole(wctx, rst, successor))
+
+	// Lowering the quota below the live resources only blocks new ones
+	_, err = srv.UpdateNamespace(wctx, &types.MsgUpdateNamespace{Creator: successor, Name: "acme", MaxResources: 1})
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), k.GetNamespaceResourceCount(wctx, "acme"))
+	_, err = srv.CreateResource(wctx, &types.MsgCreateResource{Creator: successor, Name: "c", Namespace: "acme"})
+	require.ErrorIs(t, err, types.ErrQuotaExceeded)
+}
+
+func TestResourceMsgServerNamespaceResources(t *testing.T) {
+	admin, editor, outsider := "A", "B", "C"
+	k, srv, ctx := setupMsgServer(t)
+	wctx := sdk.UnwrapSDKContext(ctx)
+
+	_, err := srv.CreateNamespace(wctx, &types.MsgCreateNamespace{
+		Creator:      admin,
+		Name:         "acme",
+		MaxResources: 2,
+		DefaultRoles: []types.NamespaceRoleGrant{{Address: editor, Role: types.ResourceRole_RESOURCE_ROLE_EDITOR}},
+	})
+	require.NoError(t, err)
+
+	_, err = srv.CreateResource(wctx, &types.MsgCreateResource{Creator: admin, Name: "a", Namespace: "globex"})
+	require.ErrorIs(t, err, sdkerrors.ErrKeyNotFound)
+	_, err = srv.CreateResource(wctx, &types.MsgCreateResource{Creator: outsider, Name: "a", Namespace: "acme"})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	// Editors of the namespace create resources in it
+	resp, err := srv.CreateResource(wctx, &types.MsgCreateResource{Creator: editor, Name: "a", Namespace: "acme"})
+	require.NoError(t, err)
+	_, err = srv.CreateResource(wctx, &types.MsgCreateResource{Creator: admin, Name: "b", Namespace: "acme"})
+	require.NoError(t, err)
+	require.Equal(t, []uint64{0, 1}, k.GetResourceIDsByNamespace(wctx, "acme"))
+	require.Equal(t, uint64(2), k.GetNamespaceResourceCount(wctx, "acme"))
+
+	// The namespace quota is independent of the creator quota
+	_, err = srv.CreateResource(wctx, &types.MsgCreateResource{Creator: admin, Name: "c", Namespace: "acme"})
+	require.ErrorIs(t, err, types.ErrQuotaExceeded)
+	_, err = srv.CreateResource(wctx, &types.MsgCreateResource{Creator: admin, Name: "c"})
+	require.NoError(t, err)
+
+	// The namespace admin administers the resources of its members and the
+	// default roles apply on top of their access control lists
+	rst, found := k.GetResource(wctx, resp.Id)
+	require.True(t, found)
+	require.Equal(t, "acme", rst.Namespace)
+	require.Equal(t, types.ResourceRole_RESOURCE_ROLE_OWNER, k.GetResourceRole(wctx, rst, editor))
+	require.Equal(t, types.ResourceRole_RESOURCE_ROLE_ADMIN, k.GetResourceRole(wctx, rst, admin))
+	require.Equal(t, types.ResourceRole_RESOURCE_ROLE_UNSPECIFIED, k.GetResourceRole(wctx, rst, outsider))
+
+	_, err = srv.UpdateResource(wctx, &types.MsgUpdateResource{Creator: outsider, Id: 1, Name: "b2"})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	_, err = srv.UpdateResource(wctx, &types.MsgUpdateResource{Creator: editor, Id: 1, Name: "b2"})
+	require.NoError(t, err)
+	rst, found = k.GetResource(wctx, 1)
+	require.True(t, found)
+	require.Equal(t, "acme", rst.Namespace)
+	_, err = srv.DeleteResource(wctx, &types.MsgDeleteResource{Creator: editor, Id: 1})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	_, err = srv.DeleteResource(wctx, &types.MsgDeleteResource{Creator: admin, Id: resp.Id})
+	require.NoError(t, err)
+
+	// Deleting frees room in the quota
+	require.Equal(t, []uint64{1}, k.GetResourceIDsByNamespace(wctx, "acme"))
+	require.Equal(t, uint64(1), k.GetNamespaceResourceCount(wctx, "acme"))
+	_, err = srv.CreateResource(wctx, &types.MsgCreateResource{Creator: editor, Name: "d", Namespace: "acme"})
+	require.NoError(t, err)
+}
@@ -9,21 +9,95 @@ import (
 	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 )
 
 func (k msgServer) CreateResource(goCtx context.Context, msg *types.MsgCreateResource) (*types.MsgCreateResourceResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
+	params := k.GetParams(ctx)
+	schema, err := k.resolveResourceSchema(ctx, params, msg.Schema)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkResourceName(params, schema, msg.Name); err != nil {
+		return nil, err
+	}
+	if err := k.checkUniqueName(ctx, params, msg.Name); err != nil {
+		return nil, err
+	}
+	if err := types.ValidateTags(msg.Tags); err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidTag, err.Error())
+	}
+
+	if err := k.checkNamespaceCreation(ctx, msg.Namespace, msg.Creator); err != nil {
+		return nil, err
+	}
+
+	// Checks that the creator stays within its quota
+	if params.MaxResourcesPerCreator > 0 {
+		count := uint64(len(k.GetResourceIDsByCreator(ctx, msg.Creator)))
+		if count >= params.MaxResourcesPerCreator {
+			return nil, errorsmod.Wrapf(types.ErrQuotaExceeded, "%s already created %d resources", msg.Creator, count)
+		}
+	}
+
+	if err := validateExpiry(ctx, msg.ExpiryHeight, msg.ExpiryTime); err != nil {
+		return nil, err
+	}
+
 	var resource = types.Resource{
-		Creator: msg.Creator,
-		Name:    msg.Name,
-		Value:   msg.Value,
+		Owner:        msg.Creator,
+		Creator:      msg.Creator,
+		Name:         msg.Name,
+		Payload:      msg.Payload.OrZero(),
+		ExpiryHeight: msg.ExpiryHeight,
+		ExpiryTime:   msg.ExpiryTime,
+		Version:      1,
+		ValueBounds:  msg.ValueBounds,
+		Tags:         types.SortTags(msg.Tags),
+		Schema:       schema.Ref(),
+		Namespace:    msg.Namespace,
 	}
 
-	id := k.AppendResource(
+	// The id is known ahead of the append so that hooks see the final resource
+	resource.Id = k.GetResourceCount(ctx)
+	if err := k.Hooks().BeforeResourceCreated(ctx, resource); err != nil {
+		return nil, err
+	}
+
+	if err := k.chargeCreationFee(ctx, msg.Creator, params.CreationFee); err != nil {
+		return nil, err
+	}
+
+	if err := k.SettleResourceDeposit(ctx, &resource, msg.Creator); err != nil {
+		return nil, err
+	}
+
+	id, err := k.AppendResource(
 		ctx,
 		resource,
 	)
+	if err != nil {
+		return nil, err
+	}
+
+	resource.Id = id
+	if _, err := k.AppendResourceRevision(ctx, resource, msg.Creator); err != nil {
+		return nil, err
+	}
+	if err := k.Hooks().AfterResourceCreated(ctx, resource); err != nil {
+		return nil, err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventResourceCreated{
+		Id:      id,
+		Creator: msg.Creator,
+		Name:    msg.Name,
+		Payload: resource.Payload,
+	}); err != nil {
+		return nil, err
+	}
 
 	return &types.MsgCreateResourceResponse{
 		Id: id,
@@ -33,30 +107,146 @@ func (k msgServer) CreateResource(goCtx context.Context, msg *types.MsgCreateRes
 func (k msgServer) UpdateResource(goCtx context.Context, msg *types.MsgUpdateResource) (*types.MsgUpdateResourceResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
+	// Checks that the element exists
+	val, found := k.GetResource(ctx, msg.Id)
+	if !found {
+		return nil, errorsmod.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("key %d doesn't exist", msg.Id))
+	}
+
 	var resource = types.Resource{
-		Creator: msg.Creator,
+		Owner:   val.Owner,
+		Creator: val.Creator,
 		Id:      msg.Id,
 		Name:    msg.Name,
-		Value:   msg.Value,
+		Payload: msg.Payload.OrZero(),
+		Deposit: val.Deposit,
+
+		ExpiryHeight: val.ExpiryHeight,
+		ExpiryTime:   val.ExpiryTime,
+		Version:      val.Version + 1,
+		ValueBounds:  val.ValueBounds,
+		Attributes:   val.Attributes,
+		Tags:         types.SortTags(msg.Tags),
+		Schema:       val.Schema,
+		Namespace:    val.Namespace,
+	}
+	if msg.ValueBounds != nil {
+		resource.ValueBounds = msg.ValueBounds
+	}
+	if msg.Schema != nil {
+		resource.Schema = msg.Schema
+	}
+
+	// Checks if the msg creator may edit the resource
+	if !k.GetResourceRole(ctx, val, msg.Creator).CanEdit() {
+		return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "incorrect owner or editor")
+	}
+
+	if err := checkExpectedVersion(val, msg.ExpectedVersion); err != nil {
+		return nil, err
+	}
+
+	params := k.GetParams(ctx)
+	schema, err := k.resolveResourceSchema(ctx, params, resource.Schema)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkResourceName(params, schema, msg.Name); err != nil {
+		return nil, err
+	}
+	// Only a rename is checked, so that names shared before the uniqueness
+	// param was set don't lock their resources
+	if msg.Name != val.Name {
+		if err := k.checkUniqueName(ctx, params, msg.Name); err != nil {
+			return nil, err
+		}
+	}
+	resource.Schema = schema.Ref()
+	if err := types.ValidateTags(msg.Tags); err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidTag, err.Error())
+	}
+	if err := checkValueBounds(resource); err != nil {
+		return nil, err
 	}
 
+	if err := k.Hooks().BeforeResourceUpdated(ctx, val, resource); err != nil {
+		return nil, err
+	}
+
+	// The signer tops up the deposit if the resource grew
+	if err := k.SettleResourceDeposit(ctx, &resource, msg.Creator); err != nil {
+		return nil, err
+	}
+
+	if err := k.SetResource(ctx, resource); err != nil {
+		return nil, err
+	}
+	if _, err := k.AppendResourceRevision(ctx, resource, msg.Creator); err != nil {
+		return nil, err
+	}
+	if err := k.Hooks().AfterResourceUpdated(ctx, val, resource); err != nil {
+		return nil, err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventResourceUpdated{
+		Id:         msg.Id,
+		Signer:     msg.Creator,
+		OldName:    val.Name,
+		NewName:    resource.Name,
+		OldPayload: val.Payload,
+		NewPayload: resource.Payload,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateResourceResponse{
+		Version: resource.Version,
+	}, nil
+}
+
+func (k msgServer) DeleteResource(goCtx context.Context, msg *types.MsgDeleteResource) (*types.MsgDeleteResourceResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
 	// Checks that the element exists
 	val, found := k.GetResource(ctx, msg.Id)
 	if !found {
 		return nil, errorsmod.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("key %d doesn't exist", msg.Id))
 	}
 
-	// Checks if the msg creator is the same as the current owner
-	if msg.Creator != val.Creator {
-		return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "incorrect owner")
+	// Checks if the msg creator may delete the resource
+	if !k.GetResourceRole(ctx, val, msg.Creator).CanAdmin() {
+		return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "incorrect owner or admin")
+	}
+
+	if err := checkExpectedVersion(val, msg.ExpectedVersion); err != nil {
+		return nil, err
+	}
+
+	if err := k.Hooks().BeforeResourceDeleted(ctx, val); err != nil {
+		return nil, err
 	}
 
-	k.SetResource(ctx, resource)
+	if err := k.RefundResourceDeposit(ctx, val); err != nil {
+		return nil, err
+	}
+	if err := k.RemoveResource(ctx, msg.Id); err != nil {
+		return nil, err
+	}
+	if err := k.Hooks().AfterResourceDeleted(ctx, val); err != nil {
+		return nil, err
+	}
 
-	return &types.MsgUpdateResourceResponse{}, nil
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventResourceDeleted{
+		Id:     msg.Id,
+		Signer: msg.Creator,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgDeleteResourceResponse{}, nil
 }
 
-func (k msgServer) DeleteResource(goCtx context.Context, msg *types.MsgDeleteResource) (*types.MsgDeleteResourceResponse, error) {
+func (k msgServer) RevertResource(goCtx context.Context, msg *types.MsgRevertResource) (*types.MsgRevertResourceResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
 	// Checks that the element exists
@@ -65,12 +255,100 @@ func (k msgServer) DeleteResource(goCtx context.Context, msg *types.MsgDeleteRes
 		return nil, errorsmod.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("key %d doesn't exist", msg.Id))
 	}
 
-	// Checks if the msg creator is the same as the current owner
-	if msg.Creator != val.Creator {
-		return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "incorrect owner")
+	// Checks if the msg creator may edit the resource
+	if !k.GetResourceRole(ctx, val, msg.Creator).CanEdit() {
+		return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "incorrect owner or editor")
 	}
 
-	k.RemoveResource(ctx, msg.Id)
+	// Checks that the revision exists
+	revision, found := k.GetResourceRevision(ctx, msg.Id, msg.Revision)
+	if !found {
+		return nil, errorsmod.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("revision %d of key %d doesn't exist", msg.Revision, msg.Id))
+	}
 
-	return &types.MsgDeleteResourceResponse{}, nil
+	// The name params or the schema may have changed since the revision was
+	// recorded
+	params := k.GetParams(ctx)
+	schema, err := k.resolveResourceSchema(ctx, params, val.Schema)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkResourceName(params, schema, revision.Name); err != nil {
+		return nil, err
+	}
+	if revision.Name != val.Name {
+		if err := k.checkUniqueName(ctx, params, revision.Name); err != nil {
+			return nil, err
+		}
+	}
+
+	resource := val
+	resource.Name = revision.Name
+	resource.Payload = revision.Payload.OrZero()
+	resource.Version++
+
+	// The bounds may have been tightened since the revision was recorded
+	if err := checkValueBounds(resource); err != nil {
+		return nil, err
+	}
+	if err := k.Hooks().BeforeResourceUpdated(ctx, val, resource); err != nil {
+		return nil, err
+	}
+
+	if err := k.SettleResourceDeposit(ctx, &resource, msg.Creator); err != nil {
+		return nil, err
+	}
+	if err := k.SetResource(ctx, resource); err != nil {
+		return nil, err
+	}
+
+	// The revert itself is recorded, history is never rewritten
+	if _, err := k.AppendResourceRevision(ctx, resource, msg.Creator); err != nil {
+		return nil, err
+	}
+	if err := k.Hooks().AfterResourceUpdated(ctx, val, resource); err != nil {
+		return nil, err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventResourceUpdated{
+		Id:         msg.Id,
+		Signer:     msg.Creator,
+		OldName:    val.Name,
+		NewName:    resource.Name,
+		OldPayload: val.Payload,
+		NewPayload: resource.Payload,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRevertResourceResponse{}, nil
+}
+
+// chargeCreationFee sends the creation fee from the creator to the fee collector
+func (k msgServer) chargeCreationFee(ctx context.Context, creator string, fee sdk.Coins) error {
+	if fee.IsZero() {
+		return nil
+	}
+
+	creatorAddr, err := sdk.AccAddressFromBech32(creator)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	return k.bankKeeper.SendCoinsFromAccountToModule(ctx, creatorAddr, authtypes.FeeCollectorName, fee)
+}
+
+// checkExpectedVersion fails if an expected version is set and differs from
+// the stored version of the resource
+func checkExpectedVersion(resource types.Resource, expected uint64) error {
+	if expected != 0 && expected != resource.Version {
+		return errorsmod.Wrapf(types.ErrVersionMismatch, "resource %d is at version %d, expected %d", resource.Id, resource.Version, expected)
+	}
+
+	return nil
+}
+
+// checkValueBounds fails if the payload of the resource is outside its bounds
+func checkValueBounds(resource types.Resource) error {
+	return resource.ValueBounds.CheckPayload(resource.Payload)
 }
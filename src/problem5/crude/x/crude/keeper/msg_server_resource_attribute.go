@@ -0,0 +1,110 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"crude/x/crude/types"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func (k msgServer) SetResourceAttribute(goCtx context.Context, msg *types.MsgSetResourceAttribute) (*types.MsgSetResourceAttributeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Checks that the element exists
+	val, found := k.GetResource(ctx, msg.Id)
+	if !found {
+		return nil, errorsmod.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("key %d doesn't exist", msg.Id))
+	}
+
+	// Checks if the msg creator may edit the resource
+	if !k.GetResourceRole(ctx, val, msg.Creator).CanEdit() {
+		return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "incorrect owner or editor")
+	}
+
+	params := k.GetParams(ctx)
+	if err := params.ValidateAttribute(types.ResourceAttribute{Key: msg.Key, Value: msg.Value}); err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidAttribute, err.Error())
+	}
+
+	resource := val
+	resource.SetAttribute(msg.Key, msg.Value)
+	resource.Version++
+	if params.MaxAttributesPerResource > 0 && uint64(len(resource.Attributes)) > params.MaxAttributesPerResource {
+		return nil, errorsmod.Wrapf(types.ErrInvalidAttribute, "resource %d already has %d attributes", msg.Id, len(val.Attributes))
+	}
+
+	if err := k.updateResourceAttributes(ctx, val, resource, msg.Creator); err != nil {
+		return nil, err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventResourceAttributeSet{
+		Id:     msg.Id,
+		Signer: msg.Creator,
+		Key:    msg.Key,
+		Value:  msg.Value,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetResourceAttributeResponse{}, nil
+}
+
+func (k msgServer) RemoveResourceAttribute(goCtx context.Context, msg *types.MsgRemoveResourceAttribute) (*types.MsgRemoveResourceAttributeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Checks that the element exists
+	val, found := k.GetResource(ctx, msg.Id)
+	if !found {
+		return nil, errorsmod.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("key %d doesn't exist", msg.Id))
+	}
+
+	// Checks if the msg creator may edit the resource
+	if !k.GetResourceRole(ctx, val, msg.Creator).CanEdit() {
+		return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "incorrect owner or editor")
+	}
+
+	resource := val
+	if !resource.RemoveAttribute(msg.Key) {
+		return nil, errorsmod.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("attribute %q of key %d doesn't exist", msg.Key, msg.Id))
+	}
+	resource.Version++
+
+	if err := k.updateResourceAttributes(ctx, val, resource, msg.Creator); err != nil {
+		return nil, err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventResourceAttributeRemoved{
+		Id:     msg.Id,
+		Signer: msg.Creator,
+		Key:    msg.Key,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRemoveResourceAttributeResponse{}, nil
+}
+
+// updateResourceAttributes stores a resource whose attributes changed and
+// records its new version, running the update hooks and settling the deposit
+// with the signer
+func (k msgServer) updateResourceAttributes(ctx sdk.Context, val, resource types.Resource, signer string) error {
+	if err := k.Hooks().BeforeResourceUpdated(ctx, val, resource); err != nil {
+		return err
+	}
+
+	if err := k.SettleResourceDeposit(ctx, &resource, signer); err != nil {
+		return err
+	}
+	if err := k.SetResource(ctx, resource); err != nil {
+		return err
+	}
+	if _, err := k.AppendResourceRevision(ctx, resource, signer); err != nil {
+		return err
+	}
+
+	return k.Hooks().AfterResourceUpdated(ctx, val, resource)
+}
@@ -0,0 +1,175 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	keepertest "crude/testutil/keeper"
+	"crude/x/crude/keeper"
+	"crude/x/crude/types"
+)
+
+func TestResourceMsgServerSetAttribute(t *testing.T) {
+	owner := "A"
+
+	tests := []struct {
+		desc    string
+		request *types.MsgSetResourceAttribute
+		err     error
+	}{
+		{
+			desc:    "Completed",
+			request: &types.MsgSetResourceAttribute{Creator: owner, Key: "colour", Value: "red"},
+		},
+		{
+			desc:    "Replaced",
+			request: &types.MsgSetResourceAttribute{Creator: owner, Key: "size", Value: "L"},
+		},
+		{
+			desc:    "KeyTooLong",
+			request: &types.MsgSetResourceAttribute{Creator: owner, Key: "temperature", Value: "hot"},
+			err:     types.ErrInvalidAttribute,
+		},
+		{
+			desc:    "ValueTooLong",
+			request: &types.MsgSetResourceAttribute{Creator: owner, Key: "colour", Value: "magenta"},
+			err:     types.ErrInvalidAttribute,
+		},
+		{
+			desc:    "TooManyAttributes",
+			request: &types.MsgSetResourceAttribute{Creator: owner, Key: "weight", Value: "1"},
+			err:     types.ErrInvalidAttribute,
+		},
+		{
+			desc:    "Unauthorized",
+			request: &types.MsgSetResourceAttribute{Creator: "B", Key: "colour", Value: "red"},
+			err:     sdkerrors.ErrUnauthorized,
+		},
+		{
+			desc:    "KeyNotFound",
+			request: &types.MsgSetResourceAttribute{Creator: owner, Id: 10, Key: "colour", Value: "red"},
+			err:     sdkerrors.ErrKeyNotFound,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			params := types.DefaultParams()
+			params.MaxAttributeKeyLength = 8
+			params.MaxAttributeValueLength = 4
+			params.MaxAttributesPerResource = 2
+			k, ctx := keepertest.CrudeKeeper(t)
+			require.NoError(t, k.SetParams(ctx, params))
+			srv := keeper.NewMsgServerImpl(k)
+			wctx := sdk.UnwrapSDKContext(ctx)
+
+			_, err := srv.CreateResource(wctx, &types.MsgCreateResource{Creator: owner})
+			require.NoError(t, err)
+			_, err = srv.SetResourceAttribute(wctx, &types.MsgSetResourceAttribute{Creator: owner, Key: "size", Value: "M"})
+			require.NoError(t, err)
+			_, err = srv.SetResourceAttribute(wctx, &types.MsgSetResourceAttribute{Creator: owner, Key: "shape", Value: "flat"})
+			require.NoError(t, err)
+			if tc.desc == "Completed" {
+				_, err = srv.RemoveResourceAttribute(wctx, &types.MsgRemoveResourceAttribute{Creator: owner, Key: "shape"})
+				require.NoError(t, err)
+			}
+
+			_, err = srv.SetResourceAttribute(wctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+
+			rst, found := k.GetResource(wctx, 0)
+			require.True(t, found)
+			value, found := rst.GetAttribute(tc.request.Key)
+			require.True(t, found)
+			require.Equal(t, tc.request.Value, value)
+			require.Equal(t, []uint64{0}, k.GetResourceIDsByAttribute(wctx, tc.request.Key, tc.request.Value))
+			requireTypedEvent(t, wctx, &types.EventResourceAttributeSet{Id: 0, Signer: owner, Key: tc.request.Key, Value: tc.request.Value})
+		})
+	}
+}
+
+func TestResourceMsgServerRemoveAttribute(t *testing.T) {
+	owner := "A"
+
+	tests := []struct {
+		desc    string
+		request *types.MsgRemoveResourceAttribute
+		err     error
+	}{
+		{
+			desc:    "Completed",
+			request: &types.MsgRemoveResourceAttribute{Creator: owner, Key: "colour"},
+		},
+		{
+			desc:    "AttributeNotFound",
+			request: &types.MsgRemoveResourceAttribute{Creator: owner, Key: "size"},
+			err:     sdkerrors.ErrKeyNotFound,
+		},
+		{
+			desc:    "Unauthorized",
+			request: &types.MsgRemoveResourceAttribute{Creator: "B", Key: "colour"},
+			err:     sdkerrors.ErrUnauthorized,
+		},
+		{
+			desc:    "KeyNotFound",
+			request: &types.MsgRemoveResourceAttribute{Creator: owner, Id: 10, Key: "colour"},
+			err:     sdkerrors.ErrKeyNotFound,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			k, srv, ctx := setupMsgServer(t)
+			wctx := sdk.UnwrapSDKContext(ctx)
+
+			_, err := srv.CreateResource(wctx, &types.MsgCreateResource{Creator: owner})
+			require.NoError(t, err)
+			_, err = srv.SetResourceAttribute(wctx, &types.MsgSetResourceAttribute{Creator: owner, Key: "colour", Value: "red"})
+			require.NoError(t, err)
+
+			_, err = srv.RemoveResourceAttribute(wctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+
+			rst, found := k.GetResource(wctx, 0)
+			require.True(t, found)
+			require.Empty(t, rst.Attributes)
+			require.Equal(t, uint64(3), rst.Version)
+			require.Empty(t, k.GetResourceIDsByAttribute(wctx, "colour", "red"))
+			// Both attribute changes recorded a revision after the creation
+			for revision := uint64(1); revision <= 2; revision++ {
+				val, found := k.GetResourceRevision(wctx, 0, revision)
+				require.True(t, found)
+				require.Equal(t, owner, val.Signer)
+			}
+			requireTypedEvent(t, wctx, &types.EventResourceAttributeRemoved{Id: 0, Signer: owner, Key: "colour"})
+		})
+	}
+}
+
+func TestResourceMsgServerAttributesSurviveUpdate(t *testing.T) {
+	owner := "A"
+	k, srv, ctx := setupMsgServer(t)
+	wctx := sdk.UnwrapSDKContext(ctx)
+
+	_, err := srv.CreateResource(wctx, &types.MsgCreateResource{Creator: owner})
+	require.NoError(t, err)
+	_, err = srv.SetResourceAttribute(wctx, &types.MsgSetResourceAttribute{Creator: owner, Key: "colour", Value: "red"})
+	require.NoError(t, err)
+	_, err = srv.UpdateResource(wctx, &types.MsgUpdateResource{Creator: owner, Name: "renamed"})
+	require.NoError(t, err)
+	require.Equal(t, []uint64{0}, k.GetResourceIDsByAttribute(wctx, "colour", "red"))
+
+	// Deleting the resource drops it from the index
+	_, err = srv.DeleteResource(wctx, &types.MsgDeleteResource{Creator: owner})
+	require.NoError(t, err)
+	require.Empty(t, k.GetResourceIDsByAttribute(wctx, "colour", "red"))
+}
@@ -0,0 +1,43 @@
+package keeper
+
+import (
+	"context"
+
+	"crude/x/crude/types"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func (k msgServer) BatchResourceOps(goCtx context.Context, msg *types.MsgBatchResourceOps) (*types.MsgBatchResourceOpsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Operations run against a cache that is only written once all of them
+	// succeeded, so a failing operation discards the whole batch
+	cacheCtx, write := ctx.CacheContext()
+
+	var ids []uint64
+	for i, op := range msg.Ops {
+		var err error
+		switch opMsg := op.Msg(msg.Creator).(type) {
+		case *types.MsgCreateResource:
+			var res *types.MsgCreateResourceResponse
+			if res, err = k.CreateResource(cacheCtx, opMsg); err == nil {
+				ids = append(ids, res.Id)
+			}
+		case *types.MsgUpdateResource:
+			_, err = k.UpdateResource(cacheCtx, opMsg)
+		case *types.MsgDeleteResource:
+			_, err = k.DeleteResource(cacheCtx, opMsg)
+		default:
+			err = errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "empty operation")
+		}
+		if err != nil {
+			return nil, errorsmod.Wrapf(err, "operation %d", i)
+		}
+	}
+	write()
+
+	return &types.MsgBatchResourceOpsResponse{Ids: ids}, nil
+}
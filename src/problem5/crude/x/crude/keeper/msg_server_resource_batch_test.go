@@ -0,0 +1,127 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"crude/x/crude/types"
+)
+
+func createOp(name string, value uint64) types.ResourceOp {
+	return types.ResourceOp{Op: &types.ResourceOp_Create{Create: &types.CreateResourceOp{Name: name, Payload: types.NewUint64Payload(value)}}}
+}
+
+func updateOp(id uint64, name string, value uint64) types.ResourceOp {
+	return types.ResourceOp{Op: &types.ResourceOp_Update{Update: &types.UpdateResourceOp{Id: id, Name: name, Payload: types.NewUint64Payload(value)}}}
+}
+
+func deleteOp(id uint64) types.ResourceOp {
+	return types.ResourceOp{Op: &types.ResourceOp_Delete{Delete: &types.DeleteResourceOp{Id: id}}}
+}
+
+func TestResourceMsgServerBatch(t *testing.T) {
+	creator := "A"
+	k, srv, goCtx := setupMsgServer(t)
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	res, err := srv.BatchResourceOps(ctx, &types.MsgBatchResourceOps{
+		Creator: creator,
+		Ops: []types.ResourceOp{
+			createOp("a", 1),
+			createOp("b", 2),
+			createOp("c", 3),
+			// later operations see the effects of earlier ones
+			updateOp(1, "b2", 20),
+			deleteOp(2),
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []uint64{0, 1, 2}, res.Ids)
+
+	rst, found := k.GetResource(ctx, 1)
+	require.True(t, found)
+	require.Equal(t, "b2", rst.Name)
+	require.Equal(t, uint64(20), rst.Payload.GetUint64Value())
+	_, found = k.GetResource(ctx, 2)
+	require.False(t, found)
+
+	// events of the operations reach the transaction
+	requireTypedEvent(t, ctx, &types.EventResourceDeleted{Id: 2, Signer: creator})
+}
+
+func TestResourceMsgServerBatchValueBounds(t *testing.T) {
+	k, srv, goCtx := setupMsgServer(t)
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	bounds := &types.ResourceValueBounds{Min: 1, Max: 10}
+
+	create := createOp("a", 5)
+	create.GetCreate().ValueBounds = bounds
+	_, err := srv.BatchResourceOps(ctx, &types.MsgBatchResourceOps{Creator: "A", Ops: []types.ResourceOp{create}})
+	require.NoError(t, err)
+	rst, found := k.GetResource(ctx, 0)
+	require.True(t, found)
+	require.Equal(t, bounds, rst.ValueBounds)
+
+	// An update without bounds keeps them, so the value must stay within
+	_, err = srv.BatchResourceOps(ctx, &types.MsgBatchResourceOps{Creator: "A", Ops: []types.ResourceOp{updateOp(0, "a", 20)}})
+	require.ErrorIs(t, err, types.ErrValueOutOfBounds)
+
+	// An update with bounds replaces them
+	update := updateOp(0, "a", 20)
+	update.GetUpdate().ValueBounds = &types.ResourceValueBounds{Min: 10, Max: 30}
+	_, err = srv.BatchResourceOps(ctx, &types.MsgBatchResourceOps{Creator: "A", Ops: []types.ResourceOp{update}})
+	require.NoError(t, err)
+	rst, found = k.GetResource(ctx, 0)
+	require.True(t, found)
+	require.Equal(t, update.GetUpdate().ValueBounds, rst.ValueBounds)
+	require.Equal(t, uint64(20), rst.Payload.GetUint64Value())
+}
+
+func TestResourceMsgServerBatchAtomic(t *testing.T) {
+	for _, tc := range []struct {
+		desc string
+		ops  []types.ResourceOp
+		err  error
+	}{
+		{
+			desc: "KeyNotFound",
+			ops:  []types.ResourceOp{createOp("a", 1), updateOp(5, "b", 2)},
+			err:  sdkerrors.ErrKeyNotFound,
+		},
+		{
+			desc: "Unauthorized",
+			ops:  []types.ResourceOp{createOp("a", 1), deleteOp(0)},
+			err:  sdkerrors.ErrUnauthorized,
+		},
+		{
+			desc: "InvalidName",
+			ops:  []types.ResourceOp{createOp("a", 1), createOp("a/b", 2)},
+			err:  types.ErrInvalidName,
+		},
+		{
+			desc: "EmptyOperation",
+			ops:  []types.ResourceOp{createOp("a", 1), {}},
+			err:  sdkerrors.ErrInvalidRequest,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			k, srv, goCtx := setupMsgServer(t)
+			ctx := sdk.UnwrapSDKContext(goCtx)
+			_, err := srv.CreateResource(ctx, &types.MsgCreateResource{Creator: "B", Name: "owned by B"})
+			require.NoError(t, err)
+			eventCount := len(ctx.EventManager().Events())
+
+			_, err = srv.BatchResourceOps(ctx, &types.MsgBatchResourceOps{Creator: "A", Ops: tc.ops})
+			require.ErrorIs(t, err, tc.err)
+			require.ErrorContains(t, err, "operation 1")
+
+			// nothing of the batch was applied
+			require.Len(t, k.GetAllResource(ctx), 1)
+			require.Equal(t, uint64(1), k.GetResourceCount(ctx))
+			require.Len(t, ctx.EventManager().Events(), eventCount)
+		})
+	}
+}
@@ -0,0 +1,57 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"crude/x/crude/types"
+)
+
+func TestResourceMsgServerPayload(t *testing.T) {
+	creator := "A"
+	k, srv, goCtx := setupMsgServer(t)
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// An unset payload is stored as a zero uint64
+	_, err := srv.CreateResource(ctx, &types.MsgCreateResource{Creator: creator})
+	require.NoError(t, err)
+	rst, _ := k.GetResource(ctx, 0)
+	require.Equal(t, types.NewUint64Payload(0), rst.Payload)
+
+	price := types.NewDecPayload(math.LegacyNewDecWithPrec(125, 2))
+	_, err = srv.CreateResource(ctx, &types.MsgCreateResource{Creator: creator, Name: "price", Payload: price})
+	require.NoError(t, err)
+	requireTypedEvent(t, ctx, &types.EventResourceCreated{Id: 1, Creator: creator, Name: "price", Payload: price})
+	rst, _ = k.GetResource(ctx, 1)
+	require.Equal(t, types.ValueTypeDec, rst.Payload.ValueType())
+	require.Equal(t, price, rst.Payload)
+
+	// Only uint64 payloads can be adjusted or bounded
+	_, err = srv.AdjustResourceValue(ctx, &types.MsgAdjustResourceValue{Creator: creator, Id: 1, Delta: 1})
+	require.ErrorIs(t, err, types.ErrInvalidPayload)
+	_, err = srv.UpdateResource(ctx, &types.MsgUpdateResource{Creator: creator, Id: 1, Payload: price, ValueBounds: &types.ResourceValueBounds{Max: 10}})
+	require.ErrorIs(t, err, types.ErrInvalidPayload)
+
+	// An update may change the type of the payload
+	config := types.NewJSONPayload(`{"retries":3}`)
+	_, err = srv.UpdateResource(ctx, &types.MsgUpdateResource{Creator: creator, Id: 1, Name: "config", Payload: config})
+	require.NoError(t, err)
+	requireTypedEvent(t, ctx, &types.EventResourceUpdated{
+		Id:         1,
+		Signer:     creator,
+		OldName:    "price",
+		NewName:    "config",
+		OldPayload: price,
+		NewPayload: config,
+	})
+
+	// A revert restores the typed payload of the revision
+	_, err = srv.RevertResource(ctx, &types.MsgRevertResource{Creator: creator, Id: 1, Revision: 0})
+	require.NoError(t, err)
+	rst, _ = k.GetResource(ctx, 1)
+	require.Equal(t, "price", rst.Name)
+	require.Equal(t, price, rst.Payload)
+}
@@ -0,0 +1,108 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"crude/x/crude/types"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func (k msgServer) RenewResource(goCtx context.Context, msg *types.MsgRenewResource) (*types.MsgRenewResourceResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Checks that the element exists
+	val, found := k.GetResource(ctx, msg.Id)
+	if !found {
+		return nil, errorsmod.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("key %d doesn't exist", msg.Id))
+	}
+
+	// Checks if the msg creator is the same as the current owner
+	if msg.Creator != val.Owner {
+		return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "incorrect owner")
+	}
+
+	if err := validateExpiry(ctx, msg.ExpiryHeight, msg.ExpiryTime); err != nil {
+		return nil, err
+	}
+	if err := validateExtension(val, msg.ExpiryHeight, msg.ExpiryTime); err != nil {
+		return nil, err
+	}
+
+	// The expiry is not part of the versioned content of the resource, so the
+	// version is left alone and no revision is recorded
+	resource := val
+	if msg.ExpiryHeight != 0 {
+		resource.ExpiryHeight = msg.ExpiryHeight
+	}
+	if msg.ExpiryTime != nil {
+		resource.ExpiryTime = msg.ExpiryTime
+	}
+	if err := k.Hooks().BeforeResourceUpdated(ctx, val, resource); err != nil {
+		return nil, err
+	}
+
+	if err := k.SettleResourceDeposit(ctx, &resource, msg.Creator); err != nil {
+		return nil, err
+	}
+	if err := k.SetResource(ctx, resource); err != nil {
+		return nil, err
+	}
+	if err := k.Hooks().AfterResourceUpdated(ctx, val, resource); err != nil {
+		return nil, err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventResourceRenewed{
+		Id:           msg.Id,
+		ExpiryHeight: resource.ExpiryHeight,
+		ExpiryTime:   resource.ExpiryTime,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRenewResourceResponse{}, nil
+}
+
+// validateExpiry checks that a requested expiry lies strictly in the future
+func validateExpiry(ctx sdk.Context, height int64, expiryTime *time.Time) error {
+	if height != 0 && height <= ctx.BlockHeight() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "expiry height %d is not after the current height %d", height, ctx.BlockHeight())
+	}
+	if expiryTime != nil && !expiryTime.After(ctx.BlockTime()) {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "expiry time %s is not after the current block time %s", expiryTime, ctx.BlockTime())
+	}
+
+	return nil
+}
+
+// validateExtension checks that a renewal does not bring the expiry of a
+// resource forward. It must set at least one bound, an unset one keeping the
+// current bound of its kind, and a set bound must not be earlier than the
+// current one, which must be set too.
+func validateExtension(resource types.Resource, height int64, expiryTime *time.Time) error {
+	if height == 0 && expiryTime == nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "renewal sets no expiry")
+	}
+	if height != 0 {
+		if resource.ExpiryHeight == 0 {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "resource %d does not expire by height", resource.Id)
+		}
+		if height < resource.ExpiryHeight {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "expiry height %d is before the current one %d", height, resource.ExpiryHeight)
+		}
+	}
+	if expiryTime != nil {
+		if resource.ExpiryTime == nil {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "resource %d does not expire by time", resource.Id)
+		}
+		if expiryTime.Before(*resource.ExpiryTime) {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "expiry time %s is before the current one %s", expiryTime, resource.ExpiryTime)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,147 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"crude/x/crude/types"
+)
+
+func TestResourceMsgServerRenew(t *testing.T) {
+	owner := "A"
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	past, soon, future, later := now.Add(-time.Hour), now.Add(30*time.Minute), now.Add(time.Hour), now.Add(2*time.Hour)
+
+	tests := []struct {
+		desc    string
+		request *types.MsgRenewResource
+		err     error
+	}{
+		{
+			desc:    "CompletedHeight",
+			request: &types.MsgRenewResource{Creator: owner, ExpiryHeight: 20, ExpiryTime: &future},
+		},
+		{
+			desc:    "CompletedTime",
+			request: &types.MsgRenewResource{Creator: owner, ExpiryHeight: 15, ExpiryTime: &later},
+		},
+		{
+			desc:    "CompletedKeepHeight",
+			request: &types.MsgRenewResource{Creator: owner, ExpiryTime: &later},
+		},
+		{
+			desc:    "CompletedKeepTime",
+			request: &types.MsgRenewResource{Creator: owner, ExpiryHeight: 20},
+		},
+		{
+			desc:    "NoExpiry",
+			request: &types.MsgRenewResource{Creator: owner},
+			err:     sdkerrors.ErrInvalidRequest,
+		},
+		{
+			desc:    "Unauthorized",
+			request: &types.MsgRenewResource{Creator: "B", ExpiryHeight: 20},
+			err:     sdkerrors.ErrUnauthorized,
+		},
+		{
+			desc:    "KeyNotFound",
+			request: &types.MsgRenewResource{Creator: owner, Id: 10, ExpiryHeight: 20},
+			err:     sdkerrors.ErrKeyNotFound,
+		},
+		{
+			desc:    "PastHeight",
+			request: &types.MsgRenewResource{Creator: owner, ExpiryHeight: 10},
+			err:     sdkerrors.ErrInvalidRequest,
+		},
+		{
+			desc:    "PastTime",
+			request: &types.MsgRenewResource{Creator: owner, ExpiryTime: &past},
+			err:     sdkerrors.ErrInvalidRequest,
+		},
+		{
+			desc:    "EarlierHeight",
+			request: &types.MsgRenewResource{Creator: owner, ExpiryHeight: 12, ExpiryTime: &future},
+			err:     sdkerrors.ErrInvalidRequest,
+		},
+		{
+			desc:    "EarlierTime",
+			request: &types.MsgRenewResource{Creator: owner, ExpiryHeight: 15, ExpiryTime: &soon},
+			err:     sdkerrors.ErrInvalidRequest,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			k, srv, goCtx := setupMsgServer(t)
+			ctx := sdk.UnwrapSDKContext(goCtx).WithBlockHeight(10).WithBlockTime(now)
+
+			_, err := srv.CreateResource(ctx, &types.MsgCreateResource{Creator: owner, ExpiryHeight: 15, ExpiryTime: &future})
+			require.NoError(t, err)
+
+			_, err = srv.RenewResource(ctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+
+			// An unset bound keeps the current one
+			height, expiryTime := tc.request.ExpiryHeight, tc.request.ExpiryTime
+			if height == 0 {
+				height = 15
+			}
+			if expiryTime == nil {
+				expiryTime = &future
+			}
+			resource, found := k.GetResource(ctx, tc.request.Id)
+			require.True(t, found)
+			require.Equal(t, height, resource.ExpiryHeight)
+			require.Equal(t, expiryTime, resource.ExpiryTime)
+
+			// Renewals leave the version and the history alone
+			require.Equal(t, uint64(1), resource.Version)
+			require.Len(t, k.GetAllResourceRevision(ctx), 1)
+
+			// The expiry was not brought forward
+			ids, err := k.GetExpiredResourceIDs(ctx, 14, soon, 10)
+			require.NoError(t, err)
+			require.Empty(t, ids)
+		})
+	}
+
+	t.Run("NewBound", func(t *testing.T) {
+		_, srv, goCtx := setupMsgServer(t)
+		ctx := sdk.UnwrapSDKContext(goCtx).WithBlockHeight(10).WithBlockTime(now)
+
+		// A permanent resource would expire earlier than it does
+		_, err := srv.CreateResource(ctx, &types.MsgCreateResource{Creator: owner})
+		require.NoError(t, err)
+		_, err = srv.RenewResource(ctx, &types.MsgRenewResource{Creator: owner, ExpiryHeight: 20})
+		require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+		_, err = srv.RenewResource(ctx, &types.MsgRenewResource{Creator: owner, ExpiryTime: &later})
+		require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+	})
+}
+
+func TestResourceRenewEvent(t *testing.T) {
+	owner := "A"
+	expiry := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	_, srv, goCtx := setupMsgServer(t)
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	earlier := expiry.Add(-time.Hour)
+	_, err := srv.CreateResource(ctx, &types.MsgCreateResource{Creator: owner, ExpiryHeight: 5, ExpiryTime: &earlier})
+	require.NoError(t, err)
+
+	_, err = srv.RenewResource(ctx, &types.MsgRenewResource{Creator: owner, ExpiryHeight: 10, ExpiryTime: &expiry})
+	require.NoError(t, err)
+	requireTypedEvent(t, ctx, &types.EventResourceRenewed{Id: 0, ExpiryHeight: 10, ExpiryTime: &expiry})
+
+	// The event carries the resulting expiry, the unset bound being kept
+	_, err = srv.RenewResource(ctx, &types.MsgRenewResource{Creator: owner, ExpiryHeight: 20})
+	require.NoError(t, err)
+	requireTypedEvent(t, ctx, &types.EventResourceRenewed{Id: 0, ExpiryHeight: 20, ExpiryTime: &expiry})
+}
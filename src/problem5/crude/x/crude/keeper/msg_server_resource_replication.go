@@ -0,0 +1,64 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"crude/x/crude/types"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func (k msgServer) ReplicateResource(goCtx context.Context, msg *types.MsgReplicateResource) (*types.MsgReplicateResourceResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Checks that the element exists
+	val, found := k.GetResource(ctx, msg.Id)
+	if !found {
+		return nil, errorsmod.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("key %d doesn't exist", msg.Id))
+	}
+
+	// Publishing the resource on another chain is left to those who may delete it
+	if !k.GetResourceRole(ctx, val, msg.Creator).CanAdmin() {
+		return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "incorrect owner or admin")
+	}
+
+	if _, found := k.GetResourceReplication(ctx, msg.Id, msg.ChannelId); found {
+		return nil, errorsmod.Wrapf(types.ErrAlreadyReplicated, "resource %d on channel %s", msg.Id, msg.ChannelId)
+	}
+	if msg.TimeoutPeriod < 0 {
+		return nil, errorsmod.Wrapf(types.ErrInvalidPacketTimeout, "negative timeout period %s", msg.TimeoutPeriod)
+	}
+
+	replication := types.ResourceReplication{
+		ResourceId:    msg.Id,
+		ChannelId:     msg.ChannelId,
+		TimeoutPeriod: msg.TimeoutPeriod,
+	}
+	sequence, err := k.sendReplicationPacket(ctx, replication, types.CrudePacketData{
+		Packet: &types.CrudePacketData_ResourceCreatePacket{
+			ResourceCreatePacket: &types.ResourceCreatePacketData{Resource: val},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := k.SetResourceReplication(ctx, replication); err != nil {
+		return nil, err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventResourceReplicationStarted{
+		Id:        msg.Id,
+		Signer:    msg.Creator,
+		ChannelId: msg.ChannelId,
+		Sequence:  sequence,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgReplicateResourceResponse{
+		Sequence: sequence,
+	}, nil
+}
@@ -0,0 +1,88 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"crude/x/crude/types"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func (k msgServer) GrantResourceRole(goCtx context.Context, msg *types.MsgGrantResourceRole) (*types.MsgGrantResourceRoleResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Checks that the element exists
+	val, found := k.GetResource(ctx, msg.Id)
+	if !found {
+		return nil, errorsmod.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("key %d doesn't exist", msg.Id))
+	}
+
+	// Checks if the msg creator may manage the access control list
+	if !k.GetResourceRole(ctx, val, msg.Creator).CanAdmin() {
+		return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "incorrect owner or admin")
+	}
+
+	if !msg.Role.IsGrantable() {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "role %s cannot be granted", msg.Role)
+	}
+	if msg.Address == val.Owner {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "the owner already holds every role")
+	}
+
+	grant := types.ResourceRoleGrant{
+		ResourceId: msg.Id,
+		Address:    msg.Address,
+		Role:       msg.Role,
+	}
+	if err := k.SetResourceRoleGrant(ctx, grant); err != nil {
+		return nil, err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventResourceRoleGranted{
+		Id:      msg.Id,
+		Signer:  msg.Creator,
+		Address: msg.Address,
+		Role:    msg.Role,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgGrantResourceRoleResponse{}, nil
+}
+
+func (k msgServer) RevokeResourceRole(goCtx context.Context, msg *types.MsgRevokeResourceRole) (*types.MsgRevokeResourceRoleResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Checks that the element exists
+	val, found := k.GetResource(ctx, msg.Id)
+	if !found {
+		return nil, errorsmod.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("key %d doesn't exist", msg.Id))
+	}
+
+	// Checks if the msg creator may manage the access control list
+	if !k.GetResourceRole(ctx, val, msg.Creator).CanAdmin() {
+		return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "incorrect owner or admin")
+	}
+
+	// Checks that the grant exists
+	if _, found := k.GetResourceRoleGrant(ctx, msg.Id, msg.Address); !found {
+		return nil, errorsmod.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("no role granted to %s on key %d", msg.Address, msg.Id))
+	}
+
+	if err := k.RemoveResourceRoleGrant(ctx, msg.Id, msg.Address); err != nil {
+		return nil, err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventResourceRoleRevoked{
+		Id:      msg.Id,
+		Signer:  msg.Creator,
+		Address: msg.Address,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRevokeResourceRoleResponse{}, nil
+}
@@ -0,0 +1,236 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"crude/x/crude/types"
+)
+
+func TestResourceMsgServerGrantRole(t *testing.T) {
+	owner, admin, editor := "A", "B", "C"
+
+	tests := []struct {
+		desc    string
+		request *types.MsgGrantResourceRole
+		err     error
+	}{
+		{
+			desc:    "CompletedByOwner",
+			request: &types.MsgGrantResourceRole{Creator: owner, Address: "D", Role: types.ResourceRole_RESOURCE_ROLE_EDITOR},
+		},
+		{
+			desc:    "CompletedByAdmin",
+			request: &types.MsgGrantResourceRole{Creator: admin, Address: "D", Role: types.ResourceRole_RESOURCE_ROLE_ADMIN},
+		},
+		{
+			desc:    "UnauthorizedEditor",
+			request: &types.MsgGrantResourceRole{Creator: editor, Address: "D", Role: types.ResourceRole_RESOURCE_ROLE_EDITOR},
+			err:     sdkerrors.ErrUnauthorized,
+		},
+		{
+			desc:    "KeyNotFound",
+			request: &types.MsgGrantResourceRole{Creator: owner, Id: 10, Address: "D", Role: types.ResourceRole_RESOURCE_ROLE_EDITOR},
+			err:     sdkerrors.ErrKeyNotFound,
+		},
+		{
+			desc:    "OwnerRole",
+			request: &types.MsgGrantResourceRole{Creator: owner, Address: "D", Role: types.ResourceRole_RESOURCE_ROLE_OWNER},
+			err:     sdkerrors.ErrInvalidRequest,
+		},
+		{
+			desc:    "GrantToOwner",
+			request: &types.MsgGrantResourceRole{Creator: admin, Address: owner, Role: types.ResourceRole_RESOURCE_ROLE_EDITOR},
+			err:     sdkerrors.ErrInvalidRequest,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			k, srv, ctx := setupMsgServer(t)
+			wctx := sdk.UnwrapSDKContext(ctx)
+
+			_, err := srv.CreateResource(wctx, &types.MsgCreateResource{Creator: owner})
+			require.NoError(t, err)
+			_, err = srv.GrantResourceRole(wctx, &types.MsgGrantResourceRole{Creator: owner, Address: admin, Role: types.ResourceRole_RESOURCE_ROLE_ADMIN})
+			require.NoError(t, err)
+			_, err = srv.GrantResourceRole(wctx, &types.MsgGrantResourceRole{Creator: owner, Address: editor, Role: types.ResourceRole_RESOURCE_ROLE_EDITOR})
+			require.NoError(t, err)
+
+			_, err = srv.GrantResourceRole(wctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+			grant, found := k.GetResourceRoleGrant(wctx, tc.request.Id, tc.request.Address)
+			require.True(t, found)
+			require.Equal(t, tc.request.Role, grant.Role)
+		})
+	}
+}
+
+func TestResourceMsgServerRevokeRole(t *testing.T) {
+	owner, admin, editor := "A", "B", "C"
+
+	tests := []struct {
+		desc    string
+		request *types.MsgRevokeResourceRole
+		err     error
+	}{
+		{
+			desc:    "CompletedByOwner",
+			request: &types.MsgRevokeResourceRole{Creator: owner, Address: editor},
+		},
+		{
+			desc:    "CompletedByAdmin",
+			request: &types.MsgRevokeResourceRole{Creator: admin, Address: editor},
+		},
+		{
+			desc:    "UnauthorizedEditor",
+			request: &types.MsgRevokeResourceRole{Creator: editor, Address: admin},
+			err:     sdkerrors.ErrUnauthorized,
+		},
+		{
+			desc:    "KeyNotFound",
+			request: &types.MsgRevokeResourceRole{Creator: owner, Id: 10, Address: editor},
+			err:     sdkerrors.ErrKeyNotFound,
+		},
+		{
+			desc:    "GrantNotFound",
+			request: &types.MsgRevokeResourceRole{Creator: owner, Address: "D"},
+			err:     sdkerrors.ErrKeyNotFound,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			k, srv, ctx := setupMsgServer(t)
+			wctx := sdk.UnwrapSDKContext(ctx)
+
+			_, err := srv.CreateResource(wctx, &types.MsgCreateResource{Creator: owner})
+			require.NoError(t, err)
+			_, err = srv.GrantResourceRole(wctx, &types.MsgGrantResourceRole{Creator: owner, Address: admin, Role: types.ResourceRole_RESOURCE_ROLE_ADMIN})
+			require.NoError(t, err)
+			_, err = srv.GrantResourceRole(wctx, &types.MsgGrantResourceRole{Creator: owner, Address: editor, Role: types.ResourceRole_RESOURCE_ROLE_EDITOR})
+			require.NoError(t, err)
+
+			_, err = srv.RevokeResourceRole(wctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+			_, found := k.GetResourceRoleGrant(wctx, tc.request.Id, tc.request.Address)
+			require.False(t, found)
+		})
+	}
+}
+
+func TestResourceMsgServerRoleEnforcement(t *testing.T) {
+	owner, admin, editor, stranger := "A", "B", "C", "D"
+
+	setup := func(t *testing.T) (types.MsgServer, sdk.Context) {
+		_, srv, ctx := setupMsgServer(t)
+		wctx := sdk.UnwrapSDKContext(ctx)
+
+		_, err := srv.CreateResource(wctx, &types.MsgCreateResource{Creator: owner})
+		require.NoError(t, err)
+		_, err = srv.GrantResourceRole(wctx, &types.MsgGrantResourceRole{Creator: owner, Address: admin, Role: types.ResourceRole_RESOURCE_ROLE_ADMIN})
+		require.NoError(t, err)
+		_, err = srv.GrantResourceRole(wctx, &types.MsgGrantResourceRole{Creator: owner, Address: editor, Role: types.ResourceRole_RESOURCE_ROLE_EDITOR})
+		require.NoError(t, err)
+		return srv, wctx
+	}
+
+	t.Run("Update", func(t *testing.T) {
+		srv, ctx := setup(t)
+		for _, signer := range []string{owner, admin, editor} {
+			_, err := srv.UpdateResource(ctx, &types.MsgUpdateResource{Creator: signer})
+			require.NoError(t, err)
+		}
+		_, err := srv.UpdateResource(ctx, &types.MsgUpdateResource{Creator: stranger})
+		require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	})
+	t.Run("Revert", func(t *testing.T) {
+		srv, ctx := setup(t)
+		_, err := srv.RevertResource(ctx, &types.MsgRevertResource{Creator: editor})
+		require.NoError(t, err)
+		_, err = srv.RevertResource(ctx, &types.MsgRevertResource{Creator: stranger})
+		require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	})
+	t.Run("DeleteByEditor", func(t *testing.T) {
+		srv, ctx := setup(t)
+		_, err := srv.DeleteResource(ctx, &types.MsgDeleteResource{Creator: editor})
+		require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	})
+	t.Run("DeleteByAdmin", func(t *testing.T) {
+		srv, ctx := setup(t)
+		_, err := srv.DeleteResource(ctx, &types.MsgDeleteResource{Creator: admin})
+		require.NoError(t, err)
+	})
+	t.Run("TransferByAdmin", func(t *testing.T) {
+		srv, ctx := setup(t)
+		_, err := srv.OfferResourceTransfer(ctx, &types.MsgOfferResourceTransfer{Creator: admin, Recipient: stranger})
+		require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	})
+}
+
+func TestResourceRoleGrantsCleared(t *testing.T) {
+	owner, editor, recipient := "A", "B", "C"
+
+	t.Run("OnTransfer", func(t *testing.T) {
+		k, srv, ctx := setupMsgServer(t)
+		wctx := sdk.UnwrapSDKContext(ctx)
+
+		_, err := srv.CreateResource(wctx, &types.MsgCreateResource{Creator: owner})
+		require.NoError(t, err)
+		_, err = srv.GrantResourceRole(wctx, &types.MsgGrantResourceRole{Creator: owner, Address: editor, Role: types.ResourceRole_RESOURCE_ROLE_EDITOR})
+		require.NoError(t, err)
+		_, err = srv.OfferResourceTransfer(wctx, &types.MsgOfferResourceTransfer{Creator: owner, Recipient: recipient})
+		require.NoError(t, err)
+		_, err = srv.AcceptResourceTransfer(wctx, &types.MsgAcceptResourceTransfer{Creator: recipient})
+		require.NoError(t, err)
+
+		_, found := k.GetResourceRoleGrant(wctx, 0, editor)
+		require.False(t, found)
+		_, err = srv.UpdateResource(wctx, &types.MsgUpdateResource{Creator: editor})
+		require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	})
+	t.Run("OnDelete", func(t *testing.T) {
+		k, srv, ctx := setupMsgServer(t)
+		wctx := sdk.UnwrapSDKContext(ctx)
+
+		_, err := srv.CreateResource(wctx, &types.MsgCreateResource{Creator: owner})
+		require.NoError(t, err)
+		_, err = srv.GrantResourceRole(wctx, &types.MsgGrantResourceRole{Creator: owner, Address: editor, Role: types.ResourceRole_RESOURCE_ROLE_EDITOR})
+		require.NoError(t, err)
+		_, err = srv.DeleteResource(wctx, &types.MsgDeleteResource{Creator: owner})
+		require.NoError(t, err)
+
+		require.Empty(t, k.GetAllResourceRoleGrant(wctx))
+	})
+}
+
+func TestResourceRoleEvents(t *testing.T) {
+	owner, editor := "A", "B"
+	_, srv, goCtx := setupMsgServer(t)
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	_, err := srv.CreateResource(ctx, &types.MsgCreateResource{Creator: owner})
+	require.NoError(t, err)
+
+	_, err = srv.GrantResourceRole(ctx, &types.MsgGrantResourceRole{Creator: owner, Address: editor, Role: types.ResourceRole_RESOURCE_ROLE_EDITOR})
+	require.NoError(t, err)
+	requireTypedEvent(t, ctx, &types.EventResourceRoleGranted{
+		Id:      0,
+		Signer:  owner,
+		Address: editor,
+		Role:    types.ResourceRole_RESOURCE_ROLE_EDITOR,
+	})
+
+	_, err = srv.RevokeResourceRole(ctx, &types.MsgRevokeResourceRole{Creator: owner, Address: editor})
+	require.NoError(t, err)
+	requireTypedEvent(t, ctx, &types.EventResourceRoleRevoked{Id: 0, Signer: owner, Address: editor})
+}
@@ -0,0 +1,131 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"crude/x/crude/types"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func (k msgServer) RegisterResourceSchema(goCtx context.Context, msg *types.MsgRegisterResourceSchema) (*types.MsgRegisterResourceSchemaResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := types.ValidateSchemaID(msg.Id); err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidSchema, err.Error())
+	}
+	if err := k.GetParams(ctx).ValidateSchemaDocument(msg.Document); err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidSchema, err.Error())
+	}
+	if _, err := types.CompileSchema(msg.Document); err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidSchema, err.Error())
+	}
+
+	// The first version of an id makes the creator its owner, only the owner
+	// registers the following ones
+	var schema = types.ResourceSchema{
+		Id:       msg.Id,
+		Version:  1,
+		Owner:    msg.Creator,
+		Document: msg.Document,
+	}
+	if latest, found := k.GetLatestResourceSchema(ctx, msg.Id); found {
+		if latest.Owner != msg.Creator {
+			return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "incorrect schema owner")
+		}
+		schema.Version = latest.Version + 1
+	}
+
+	if err := k.SetResourceSchema(ctx, schema); err != nil {
+		return nil, err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventResourceSchemaRegistered{
+		Id:      schema.Id,
+		Version: schema.Version,
+		Owner:   schema.Owner,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRegisterResourceSchemaResponse{
+		Version: schema.Version,
+	}, nil
+}
+
+func (k msgServer) ApproveResourceSchema(goCtx context.Context, req *types.MsgApproveResourceSchema) (*types.MsgApproveResourceSchemaResponse, error) {
+	if k.GetAuthority() != req.Authority {
+		return nil, errorsmod.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.GetAuthority(), req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	schema, found := k.GetResourceSchema(ctx, req.Id, req.Version)
+	if !found {
+		return nil, errorsmod.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("schema %s version %d doesn't exist", req.Id, req.Version))
+	}
+
+	schema.Approved = true
+	if err := k.SetResourceSchema(ctx, schema); err != nil {
+		return nil, err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventResourceSchemaApproved{
+		Id:        schema.Id,
+		Version:   schema.Version,
+		Authority: req.Authority,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgApproveResourceSchemaResponse{}, nil
+}
+
+// resolveResourceSchema returns the schema a reference points at, nil for an
+// unset reference. Version zero resolves to the latest version.
+func (k Keeper) resolveResourceSchema(ctx context.Context, params types.Params, ref *types.ResourceSchemaRef) (*types.ResourceSchema, error) {
+	if ref == nil {
+		return nil, nil
+	}
+
+	var (
+		schema types.ResourceSchema
+		found  bool
+	)
+	if ref.Version == 0 {
+		schema, found = k.GetLatestResourceSchema(ctx, ref.Id)
+	} else {
+		schema, found = k.GetResourceSchema(ctx, ref.Id, ref.Version)
+	}
+	if !found {
+		return nil, errorsmod.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("schema %s version %d doesn't exist", ref.Id, ref.Version))
+	}
+	if params.OnlyApprovedSchemas && !schema.Approved {
+		return nil, errorsmod.Wrapf(types.ErrInvalidSchema, "schema %s version %d is not approved", schema.Id, schema.Version)
+	}
+
+	return &schema, nil
+}
+
+// checkResourceName checks a name against the name params, or against the
+// schema of the resource if it has one. The schema then takes the place of the
+// charset, which JSON documents seldom fit in.
+func checkResourceName(params types.Params, schema *types.ResourceSchema, name string) error {
+	if schema == nil {
+		if err := params.ValidateName(name); err != nil {
+			return errorsmod.Wrap(types.ErrInvalidName, err.Error())
+		}
+		return nil
+	}
+
+	if err := params.ValidateNameLength(name); err != nil {
+		return errorsmod.Wrap(types.ErrInvalidName, err.Error())
+	}
+	if err := schema.ValidateDocument(name); err != nil {
+		return errorsmod.Wrapf(types.ErrSchemaViolation, "schema %s version %d: %s", schema.Id, schema.Version, err)
+	}
+
+	return nil
+}
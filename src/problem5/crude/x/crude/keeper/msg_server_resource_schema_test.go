@@ -0,0 +1,130 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"crude/x/crude/types"
+)
+
+const productSchema = `{"type":"object","required":["sku"],"properties":{"sku":{"type":"string"}}}`
+
+func TestResourceMsgServerRegisterSchema(t *testing.T) {
+	k, srv, ctx := setupMsgServer(t)
+	wctx := sdk.UnwrapSDKContext(ctx)
+
+	resp, err := srv.RegisterResourceSchema(wctx, &types.MsgRegisterResourceSchema{Creator: "A", Id: "product", Document: `{"type":"object"}`})
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), resp.Version)
+	requireTypedEvent(t, wctx, &types.EventResourceSchemaRegistered{Id: "product", Version: 1, Owner: "A"})
+
+	// Only the owner registers the following versions
+	_, err = srv.RegisterResourceSchema(wctx, &types.MsgRegisterResourceSchema{Creator: "B", Id: "product", Document: productSchema})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	resp, err = srv.RegisterResourceSchema(wctx, &types.MsgRegisterResourceSchema{Creator: "A", Id: "product", Document: productSchema})
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), resp.Version)
+
+	latest, found := k.GetLatestResourceSchema(wctx, "product")
+	require.True(t, found)
+	require.Equal(t, types.ResourceSchema{Id: "product", Version: 2, Owner: "A", Document: productSchema}, latest)
+	first, found := k.GetResourceSchema(wctx, "product", 1)
+	require.True(t, found)
+	require.Equal(t, `{"type":"object"}`, first.Document)
+
+	// Ids sharing a prefix are distinct schemas
+	_, found = k.GetLatestResourceSchema(wctx, "prod")
+	require.False(t, found)
+
+	_, err = srv.RegisterResourceSchema(wctx, &types.MsgRegisterResourceSchema{Creator: "A", Id: "broken", Document: `{"type":1}`})
+	require.ErrorIs(t, err, types.ErrInvalidSchema)
+
+	params := types.DefaultParams()
+	params.MaxSchemaLength = 8
+	require.NoError(t, k.SetParams(wctx, params))
+	_, err = srv.RegisterResourceSchema(wctx, &types.MsgRegisterResourceSchema{Creator: "A", Id: "long", Document: productSchema})
+	require.ErrorIs(t, err, types.ErrInvalidSchema)
+}
+
+func TestResourceMsgServerApproveSchema(t *testing.T) {
+	k, srv, ctx := setupMsgServer(t)
+	wctx := sdk.UnwrapSDKContext(ctx)
+
+	_, err := srv.RegisterResourceSchema(wctx, &types.MsgRegisterResourceSchema{Creator: "A", Id: "product", Document: productSchema})
+	require.NoError(t, err)
+
+	_, err = srv.ApproveResourceSchema(wctx, &types.MsgApproveResourceSchema{Authority: "A", Id: "product", Version: 1})
+	require.ErrorIs(t, err, types.ErrInvalidSigner)
+	_, err = srv.ApproveResourceSchema(wctx, &types.MsgApproveResourceSchema{Authority: k.GetAuthority(), Id: "product", Version: 2})
+	require.ErrorIs(t, err, sdkerrors.ErrKeyNotFound)
+
+	// Only approved schemas may be referenced once the param is set
+	params := types.DefaultParams()
+	params.OnlyApprovedSchemas = true
+	require.NoError(t, k.SetParams(wctx, params))
+	_, err = srv.CreateResource(wctx, &types.MsgCreateResource{Creator: "A", Name: `{"sku":"a-1"}`, Schema: &types.ResourceSchemaRef{Id: "product"}})
+	require.ErrorIs(t, err, types.ErrInvalidSchema)
+
+	_, err = srv.ApproveResourceSchema(wctx, &types.MsgApproveResourceSchema{Authority: k.GetAuthority(), Id: "product", Version: 1})
+	require.NoError(t, err)
+	requireTypedEvent(t, wctx, &types.EventResourceSchemaApproved{Id: "product", Version: 1, Authority: k.GetAuthority()})
+	schema, found := k.GetResourceSchema(wctx, "product", 1)
+	require.True(t, found)
+	require.True(t, schema.Approved)
+
+	_, err = srv.CreateResource(wctx, &types.MsgCreateResource{Creator: "A", Name: `{"sku":"a-1"}`, Schema: &types.ResourceSchemaRef{Id: "product"}})
+	require.NoError(t, err)
+}
+
+func TestResourceMsgServerSchemaValidation(t *testing.T) {
+	creator := "A"
+	k, srv, ctx := setupMsgServer(t)
+	wctx := sdk.UnwrapSDKContext(ctx)
+
+	_, err := srv.RegisterResourceSchema(wctx, &types.MsgRegisterResourceSchema{Creator: creator, Id: "product", Document: `{"type":"object"}`})
+	require.NoError(t, err)
+
+	_, err = srv.CreateResource(wctx, &types.MsgCreateResource{Creator: creator, Name: `{"sku":"a-1"}`, Schema: &types.ResourceSchemaRef{Id: "missing"}})
+	require.ErrorIs(t, err, sdkerrors.ErrKeyNotFound)
+	_, err = srv.CreateResource(wctx, &types.MsgCreateResource{Creator: creator, Name: `[1]`, Schema: &types.ResourceSchemaRef{Id: "product"}})
+	require.ErrorIs(t, err, types.ErrSchemaViolation)
+
+	// The schema replaces the charset of the name params, not their length
+	resp, err := srv.CreateResource(wctx, &types.MsgCreateResource{Creator: creator, Name: `{"sku":"a-1"}`, Schema: &types.ResourceSchemaRef{Id: "product"}})
+	require.NoError(t, err)
+	_, err = srv.CreateResource(wctx, &types.MsgCreateResource{Creator: creator, Name: `{"sku":"a-1"}`})
+	require.ErrorIs(t, err, types.ErrInvalidName)
+
+	// The latest version is pinned when the resource is created
+	rst, found := k.GetResource(wctx, resp.Id)
+	require.True(t, found)
+	require.Equal(t, &types.ResourceSchemaRef{Id: "product", Version: 1}, rst.Schema)
+
+	// Updates keep the pinned version unless another schema is named
+	_, err = srv.RegisterResourceSchema(wctx, &types.MsgRegisterResourceSchema{Creator: creator, Id: "product", Document: productSchema})
+	require.NoError(t, err)
+	_, err = srv.UpdateResource(wctx, &types.MsgUpdateResource{Creator: creator, Id: resp.Id, Name: `{"price":1}`})
+	require.NoError(t, err)
+	_, err = srv.UpdateResource(wctx, &types.MsgUpdateResource{Creator: creator, Id: resp.Id, Name: `{"price":1}`, Schema: &types.ResourceSchemaRef{Id: "product"}})
+	require.ErrorIs(t, err, types.ErrSchemaViolation)
+	_, err = srv.UpdateResource(wctx, &types.MsgUpdateResource{Creator: creator, Id: resp.Id, Name: `{"sku":"a-2"}`, Schema: &types.ResourceSchemaRef{Id: "product"}})
+	require.NoError(t, err)
+	rst, found = k.GetResource(wctx, resp.Id)
+	require.True(t, found)
+	require.Equal(t, &types.ResourceSchemaRef{Id: "product", Version: 2}, rst.Schema)
+
+	// A revert is checked against the current schema
+	_, err = srv.RevertResource(wctx, &types.MsgRevertResource{Creator: creator, Id: resp.Id, Revision: 1})
+	require.ErrorIs(t, err, types.ErrSchemaViolation)
+	_, err = srv.RevertResource(wctx, &types.MsgRevertResource{Creator: creator, Id: resp.Id, Revision: 0})
+	require.NoError(t, err)
+
+	// Batch operations go through the same checks
+	_, err = srv.BatchResourceOps(wctx, &types.MsgBatchResourceOps{Creator: creator, Ops: []types.ResourceOp{
+		{Op: &types.ResourceOp_Create{Create: &types.CreateResourceOp{Name: `{}`, Schema: &types.ResourceSchemaRef{Id: "product", Version: 2}}}},
+	}})
+	require.ErrorIs(t, err, types.ErrSchemaViolation)
+}
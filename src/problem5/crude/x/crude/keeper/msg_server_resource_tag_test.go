@@ -0,0 +1,41 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"crude/x/crude/types"
+)
+
+func TestResourceMsgServerTags(t *testing.T) {
+	creator := "A"
+	k, srv, ctx := setupMsgServer(t)
+	wctx := sdk.UnwrapSDKContext(ctx)
+
+	_, err := srv.CreateResource(wctx, &types.MsgCreateResource{Creator: creator, Tags: []string{"team:payments", "env:prod"}})
+	require.NoError(t, err)
+	_, err = srv.CreateResource(wctx, &types.MsgCreateResource{Creator: creator, Tags: []string{"env:prod"}})
+	require.NoError(t, err)
+
+	rst, found := k.GetResource(wctx, 0)
+	require.True(t, found)
+	require.Equal(t, []string{"env:prod", "team:payments"}, rst.Tags)
+	require.Equal(t, []uint64{0, 1}, k.GetResourceIDsByTag(wctx, "env:prod"))
+	require.Equal(t, []uint64{0}, k.GetResourceIDsByTag(wctx, "team:payments"))
+
+	// An update replaces the tags
+	_, err = srv.UpdateResource(wctx, &types.MsgUpdateResource{Creator: creator, Id: 0, Tags: []string{"env:test"}})
+	require.NoError(t, err)
+	require.Equal(t, []uint64{1}, k.GetResourceIDsByTag(wctx, "env:prod"))
+	require.Empty(t, k.GetResourceIDsByTag(wctx, "team:payments"))
+	require.Equal(t, []uint64{0}, k.GetResourceIDsByTag(wctx, "env:test"))
+
+	_, err = srv.UpdateResource(wctx, &types.MsgUpdateResource{Creator: creator, Id: 1, Tags: []string{"env:test", "env:test"}})
+	require.ErrorIs(t, err, types.ErrInvalidTag)
+
+	_, err = srv.DeleteResource(wctx, &types.MsgDeleteResource{Creator: creator, Id: 0})
+	require.NoError(t, err)
+	require.Empty(t, k.GetResourceIDsByTag(wctx, "env:test"))
+}
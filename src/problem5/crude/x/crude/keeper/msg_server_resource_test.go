@@ -5,8 +5,12 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/stretchr/testify/require"
 
+	keepertest "crude/testutil/keeper"
+	"crude/testutil/sample"
+	"crude/x/crude/keeper"
 	"crude/x/crude/types"
 )
 
@@ -44,6 +48,15 @@ func TestResourceMsgServerUpdate(t *testing.T) {
 			request: &types.MsgUpdateResource{Creator: creator, Id: 10},
 			err:     sdkerrors.ErrKeyNotFound,
 		},
+		{
+			desc:    "ExpectedVersion",
+			request: &types.MsgUpdateResource{Creator: creator, ExpectedVersion: 1},
+		},
+		{
+			desc:    "VersionMismatch",
+			request: &types.MsgUpdateResource{Creator: creator, ExpectedVersion: 2},
+			err:     types.ErrVersionMismatch,
+		},
 	}
 	for _, tc := range tests {
 		t.Run(tc.desc, func(t *testing.T) {
@@ -85,6 +98,15 @@ func TestResourceMsgServerDelete(t *testing.T) {
 			request: &types.MsgDeleteResource{Creator: creator, Id: 10},
 			err:     sdkerrors.ErrKeyNotFound,
 		},
+		{
+			desc:    "ExpectedVersion",
+			request: &types.MsgDeleteResource{Creator: creator, ExpectedVersion: 1},
+		},
+		{
+			desc:    "VersionMismatch",
+			request: &types.MsgDeleteResource{Creator: creator, ExpectedVersion: 2},
+			err:     types.ErrVersionMismatch,
+		},
 	}
 	for _, tc := range tests {
 		t.Run(tc.desc, func(t *testing.T) {
@@ -102,3 +124,214 @@ func TestResourceMsgServerDelete(t *testing.T) {
 		})
 	}
 }
+
+func TestResourceMsgServerVersion(t *testing.T) {
+	creator := "A"
+	k, srv, ctx := setupMsgServer(t)
+	wctx := sdk.UnwrapSDKContext(ctx)
+
+	_, err := srv.CreateResource(wctx, &types.MsgCreateResource{Creator: creator, Name: "a", ExpiryHeight: 100})
+	require.NoError(t, err)
+	rst, _ := k.GetResource(wctx, 0)
+	require.Equal(t, uint64(1), rst.Version)
+
+	resp, err := srv.UpdateResource(wctx, &types.MsgUpdateResource{Creator: creator, Name: "b", ExpectedVersion: 1})
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), resp.Version)
+
+	// A client racing on the version it read first loses
+	_, err = srv.UpdateResource(wctx, &types.MsgUpdateResource{Creator: creator, Name: "c", ExpectedVersion: 1})
+	require.ErrorIs(t, err, types.ErrVersionMismatch)
+
+	_, err = srv.RevertResource(wctx, &types.MsgRevertResource{Creator: creator, Revision: 0})
+	require.NoError(t, err)
+	rst, _ = k.GetResource(wctx, 0)
+	require.Equal(t, "a", rst.Name)
+	require.Equal(t, uint64(3), rst.Version)
+
+	// Renewals do not change the version
+	_, err = srv.RenewResource(wctx, &types.MsgRenewResource{Creator: creator, ExpiryHeight: 200})
+	require.NoError(t, err)
+	rst, _ = k.GetResource(wctx, 0)
+	require.Equal(t, uint64(3), rst.Version)
+}
+
+func TestResourceMsgServerRevert(t *testing.T) {
+	creator := "A"
+
+	tests := []struct {
+		desc    string
+		request *types.MsgRevertResource
+		err     error
+	}{
+		{
+			desc:    "Completed",
+			request: &types.MsgRevertResource{Creator: creator, Revision: 0},
+		},
+		{
+			desc:    "Unauthorized",
+			request: &types.MsgRevertResource{Creator: "B"},
+			err:     sdkerrors.ErrUnauthorized,
+		},
+		{
+			desc:    "KeyNotFound",
+			request: &types.MsgRevertResource{Creator: creator, Id: 10},
+			err:     sdkerrors.ErrKeyNotFound,
+		},
+		{
+			desc:    "RevisionNotFound",
+			request: &types.MsgRevertResource{Creator: creator, Revision: 10},
+			err:     sdkerrors.ErrKeyNotFound,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			k, srv, ctx := setupMsgServer(t)
+			wctx := sdk.UnwrapSDKContext(ctx)
+
+			_, err := srv.CreateResource(wctx, &types.MsgCreateResource{Creator: creator, Name: "first", Payload: types.NewUint64Payload(1)})
+			require.NoError(t, err)
+			_, err = srv.UpdateResource(wctx, &types.MsgUpdateResource{Creator: creator, Name: "second", Payload: types.NewUint64Payload(2)})
+			require.NoError(t, err)
+
+			_, err = srv.RevertResource(wctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+
+			resource, found := k.GetResource(wctx, tc.request.Id)
+			require.True(t, found)
+			require.Equal(t, "first", resource.Name)
+			require.Equal(t, uint64(1), resource.Payload.GetUint64Value())
+
+			// The revert is appended to the history
+			revision, found := k.GetResourceRevision(wctx, tc.request.Id, 2)
+			require.True(t, found)
+			require.Equal(t, "first", revision.Name)
+			require.Equal(t, creator, revision.Signer)
+		})
+	}
+}
+
+func TestResourceMsgServerParams(t *testing.T) {
+	creator := sample.AccAddress()
+	fee := sdk.NewCoins(sdk.NewInt64Coin("stake", 10))
+
+	setup := func(t *testing.T, params types.Params) (*keepertest.BankKeeper, types.MsgServer, sdk.Context) {
+		bank := keepertest.NewBankKeeper()
+		k, ctx := keepertest.CrudeKeeperWithBank(t, bank)
+		require.NoError(t, k.SetParams(ctx, params))
+		return bank, keeper.NewMsgServerImpl(k), ctx
+	}
+
+	t.Run("NameTooLong", func(t *testing.T) {
+		_, srv, ctx := setup(t, types.NewParams(3, "", 0, nil, nil, 0, 0, 0, 0, 0, false, false))
+		_, err := srv.CreateResource(ctx, &types.MsgCreateResource{Creator: creator, Name: "abcd"})
+		require.ErrorIs(t, err, types.ErrInvalidName)
+	})
+	t.Run("NameCharset", func(t *testing.T) {
+		_, srv, ctx := setup(t, types.DefaultParams())
+		_, err := srv.CreateResource(ctx, &types.MsgCreateResource{Creator: creator, Name: "a/b"})
+		require.ErrorIs(t, err, types.ErrInvalidName)
+		_, err = srv.CreateResource(ctx, &types.MsgCreateResource{Creator: creator, Name: "a-b"})
+		require.NoError(t, err)
+		_, err = srv.UpdateResource(ctx, &types.MsgUpdateResource{Creator: creator, Name: "a/b"})
+		require.ErrorIs(t, err, types.ErrInvalidName)
+	})
+	t.Run("UniqueNames", func(t *testing.T) {
+		_, srv, ctx := setup(t, types.NewParams(0, "", 0, nil, nil, 0, 0, 0, 0, 0, false, true))
+		_, err := srv.CreateResource(ctx, &types.MsgCreateResource{Creator: creator, Name: "a"})
+		require.NoError(t, err)
+		_, err = srv.CreateResource(ctx, &types.MsgCreateResource{Creator: creator, Name: "a"})
+		require.ErrorIs(t, err, types.ErrDuplicateName)
+		_, err = srv.CreateResource(ctx, &types.MsgCreateResource{Creator: creator, Name: "b"})
+		require.NoError(t, err)
+
+		// Renames are checked, keeping the current name is not
+		_, err = srv.UpdateResource(ctx, &types.MsgUpdateResource{Creator: creator, Id: 1, Name: "a"})
+		require.ErrorIs(t, err, types.ErrDuplicateName)
+		_, err = srv.UpdateResource(ctx, &types.MsgUpdateResource{Creator: creator, Id: 0, Name: "a", Payload: types.NewUint64Payload(1)})
+		require.NoError(t, err)
+		_, err = srv.UpdateResource(ctx, &types.MsgUpdateResource{Creator: creator, Id: 0, Name: "c"})
+		require.NoError(t, err)
+
+		// The former name is free again, which reverts are checked against too
+		_, err = srv.UpdateResource(ctx, &types.MsgUpdateResource{Creator: creator, Id: 1, Name: "a"})
+		require.NoError(t, err)
+		_, err = srv.RevertResource(ctx, &types.MsgRevertResource{Creator: creator, Id: 0, Revision: 0})
+		require.ErrorIs(t, err, types.ErrDuplicateName)
+	})
+	t.Run("Quota", func(t *testing.T) {
+		_, srv, ctx := setup(t, types.NewParams(0, "", 2, nil, nil, 0, 0, 0, 0, 0, false, false))
+		for i := 0; i < 2; i++ {
+			_, err := srv.CreateResource(ctx, &types.MsgCreateResource{Creator: creator})
+			require.NoError(t, err)
+		}
+		_, err := srv.CreateResource(ctx, &types.MsgCreateResource{Creator: creator})
+		require.ErrorIs(t, err, types.ErrQuotaExceeded)
+
+		// Deleting a resource frees a slot
+		_, err = srv.DeleteResource(ctx, &types.MsgDeleteResource{Creator: creator, Id: 0})
+		require.NoError(t, err)
+		_, err = srv.CreateResource(ctx, &types.MsgCreateResource{Creator: creator})
+		require.NoError(t, err)
+	})
+	t.Run("CreationFee", func(t *testing.T) {
+		bank, srv, ctx := setup(t, types.NewParams(0, "", 0, fee, nil, 0, 0, 0, 0, 0, false, false))
+		_, err := srv.CreateResource(ctx, &types.MsgCreateResource{Creator: creator})
+		require.ErrorIs(t, err, sdkerrors.ErrInsufficientFunds)
+
+		bank.Fund(sdk.MustAccAddressFromBech32(creator), fee)
+		_, err = srv.CreateResource(ctx, &types.MsgCreateResource{Creator: creator})
+		require.NoError(t, err)
+		require.Equal(t, fee, bank.ModuleBalance(authtypes.FeeCollectorName))
+		require.True(t, bank.SpendableCoins(ctx, sdk.MustAccAddressFromBech32(creator)).IsZero())
+	})
+}
+
+func TestResourceMsgServerEvents(t *testing.T) {
+	creator := "A"
+	_, srv, goCtx := setupMsgServer(t)
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	_, err := srv.CreateResource(ctx, &types.MsgCreateResource{Creator: creator, Name: "first", Payload: types.NewUint64Payload(1)})
+	require.NoError(t, err)
+	requireTypedEvent(t, ctx, &types.EventResourceCreated{Id: 0, Creator: creator, Name: "first", Payload: types.NewUint64Payload(1)})
+
+	_, err = srv.UpdateResource(ctx, &types.MsgUpdateResource{Creator: creator, Name: "second", Payload: types.NewUint64Payload(2)})
+	require.NoError(t, err)
+	requireTypedEvent(t, ctx, &types.EventResourceUpdated{
+		Id:         0,
+		Signer:     creator,
+		OldName:    "first",
+		NewName:    "second",
+		OldPayload: types.NewUint64Payload(1),
+		NewPayload: types.NewUint64Payload(2),
+	})
+
+	_, err = srv.RevertResource(ctx, &types.MsgRevertResource{Creator: creator, Revision: 0})
+	require.NoError(t, err)
+	requireTypedEvent(t, ctx, &types.EventResourceUpdated{
+		Id:         0,
+		Signer:     creator,
+		OldName:    "second",
+		NewName:    "first",
+		OldPayload: types.NewUint64Payload(2),
+		NewPayload: types.NewUint64Payload(1),
+	})
+
+	_, err = srv.DeleteResource(ctx, &types.MsgDeleteResource{Creator: creator})
+	require.NoError(t, err)
+	requireTypedEvent(t, ctx, &types.EventResourceDeleted{Id: 0, Signer: creator})
+}
+
+func TestResourceMsgServerNoEventOnFailure(t *testing.T) {
+	_, srv, goCtx := setupMsgServer(t)
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	_, err := srv.UpdateResource(ctx, &types.MsgUpdateResource{Creator: "A", Id: 10})
+	require.ErrorIs(t, err, sdkerrors.ErrKeyNotFound)
+	require.Empty(t, ctx.EventManager().Events())
+}
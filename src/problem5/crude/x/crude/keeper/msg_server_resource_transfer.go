@@ -0,0 +1,139 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"crude/x/crude/types"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func (k msgServer) OfferResourceTransfer(goCtx context.Context, msg *types.MsgOfferResourceTransfer) (*types.MsgOfferResourceTransferResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Checks that the element exists
+	val, found := k.GetResource(ctx, msg.Id)
+	if !found {
+		return nil, errorsmod.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("key %d doesn't exist", msg.Id))
+	}
+
+	// Checks if the msg creator is the same as the current owner
+	if msg.Creator != val.Owner {
+		return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "incorrect owner")
+	}
+
+	if msg.Recipient == val.Owner {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "recipient already owns the resource")
+	}
+
+	// Any previous offer for the resource is replaced
+	offer := types.ResourceTransferOffer{
+		ResourceId: msg.Id,
+		Owner:      val.Owner,
+		Recipient:  msg.Recipient,
+	}
+	if err := k.SetResourceTransferOffer(ctx, offer); err != nil {
+		return nil, err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventResourceTransferOffered{
+		Id:        msg.Id,
+		Owner:     val.Owner,
+		Recipient: msg.Recipient,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgOfferResourceTransferResponse{}, nil
+}
+
+func (k msgServer) AcceptResourceTransfer(goCtx context.Context, msg *types.MsgAcceptResourceTransfer) (*types.MsgAcceptResourceTransferResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Checks that the offer exists
+	offer, found := k.GetResourceTransferOffer(ctx, msg.Id)
+	if !found {
+		return nil, errorsmod.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("no transfer offer for key %d", msg.Id))
+	}
+
+	// Checks if the msg creator is the recipient of the offer
+	if msg.Creator != offer.Recipient {
+		return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "incorrect recipient")
+	}
+
+	val, found := k.GetResource(ctx, msg.Id)
+	if !found {
+		return nil, errorsmod.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("key %d doesn't exist", msg.Id))
+	}
+
+	// The offer is only valid while the offering account still owns the resource
+	if val.Owner != offer.Owner {
+		return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "offer owner no longer owns the resource")
+	}
+
+	// The deposit follows the resource, it is refunded to the new owner on deletion
+	resource := val
+	resource.Owner = offer.Recipient
+	resource.Version++
+	if err := k.Hooks().BeforeResourceUpdated(ctx, val, resource); err != nil {
+		return nil, err
+	}
+
+	if err := k.SetResource(ctx, resource); err != nil {
+		return nil, err
+	}
+	if _, err := k.AppendResourceRevision(ctx, resource, msg.Creator); err != nil {
+		return nil, err
+	}
+	if err := k.RemoveResourceTransferOffer(ctx, msg.Id); err != nil {
+		return nil, err
+	}
+	// Roles granted by the previous owner do not carry over
+	if err := k.RemoveResourceRoleGrants(ctx, msg.Id); err != nil {
+		return nil, err
+	}
+	if err := k.Hooks().AfterResourceUpdated(ctx, val, resource); err != nil {
+		return nil, err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventResourceTransferred{
+		Id:            msg.Id,
+		PreviousOwner: offer.Owner,
+		NewOwner:      offer.Recipient,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgAcceptResourceTransferResponse{}, nil
+}
+
+func (k msgServer) CancelResourceTransfer(goCtx context.Context, msg *types.MsgCancelResourceTransfer) (*types.MsgCancelResourceTransferResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Checks that the offer exists
+	offer, found := k.GetResourceTransferOffer(ctx, msg.Id)
+	if !found {
+		return nil, errorsmod.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("no transfer offer for key %d", msg.Id))
+	}
+
+	// Checks if the msg creator is the account that made the offer
+	if msg.Creator != offer.Owner {
+		return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "incorrect owner")
+	}
+
+	if err := k.RemoveResourceTransferOffer(ctx, msg.Id); err != nil {
+		return nil, err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventResourceTransferCancelled{
+		Id:    msg.Id,
+		Owner: offer.Owner,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCancelResourceTransferResponse{}, nil
+}
@@ -0,0 +1,200 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"crude/x/crude/types"
+)
+
+func TestResourceMsgServerOfferTransfer(t *testing.T) {
+	owner, recipient := "A", "B"
+
+	tests := []struct {
+		desc    string
+		request *types.MsgOfferResourceTransfer
+		err     error
+	}{
+		{
+			desc:    "Completed",
+			request: &types.MsgOfferResourceTransfer{Creator: owner, Recipient: recipient},
+		},
+		{
+			desc:    "Unauthorized",
+			request: &types.MsgOfferResourceTransfer{Creator: recipient, Recipient: recipient},
+			err:     sdkerrors.ErrUnauthorized,
+		},
+		{
+			desc:    "KeyNotFound",
+			request: &types.MsgOfferResourceTransfer{Creator: owner, Id: 10, Recipient: recipient},
+			err:     sdkerrors.ErrKeyNotFound,
+		},
+		{
+			desc:    "SelfTransfer",
+			request: &types.MsgOfferResourceTransfer{Creator: owner, Recipient: owner},
+			err:     sdkerrors.ErrInvalidRequest,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			k, srv, ctx := setupMsgServer(t)
+			wctx := sdk.UnwrapSDKContext(ctx)
+
+			_, err := srv.CreateResource(wctx, &types.MsgCreateResource{Creator: owner})
+			require.NoError(t, err)
+
+			_, err = srv.OfferResourceTransfer(wctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+			offer, found := k.GetResourceTransferOffer(wctx, tc.request.Id)
+			require.True(t, found)
+			require.Equal(t, types.ResourceTransferOffer{ResourceId: 0, Owner: owner, Recipient: recipient}, offer)
+		})
+	}
+}
+
+func TestResourceMsgServerAcceptTransfer(t *testing.T) {
+	owner, recipient := "A", "B"
+
+	tests := []struct {
+		desc    string
+		request *types.MsgAcceptResourceTransfer
+		err     error
+	}{
+		{
+			desc:    "Completed",
+			request: &types.MsgAcceptResourceTransfer{Creator: recipient},
+		},
+		{
+			desc:    "Unauthorized",
+			request: &types.MsgAcceptResourceTransfer{Creator: "C"},
+			err:     sdkerrors.ErrUnauthorized,
+		},
+		{
+			desc:    "KeyNotFound",
+			request: &types.MsgAcceptResourceTransfer{Creator: recipient, Id: 10},
+			err:     sdkerrors.ErrKeyNotFound,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			k, srv, ctx := setupMsgServer(t)
+			wctx := sdk.UnwrapSDKContext(ctx)
+
+			_, err := srv.CreateResource(wctx, &types.MsgCreateResource{Creator: owner})
+			require.NoError(t, err)
+			_, err = srv.OfferResourceTransfer(wctx, &types.MsgOfferResourceTransfer{Creator: owner, Recipient: recipient})
+			require.NoError(t, err)
+
+			_, err = srv.AcceptResourceTransfer(wctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+
+			resource, found := k.GetResource(wctx, 0)
+			require.True(t, found)
+			require.Equal(t, recipient, resource.Owner)
+			require.Equal(t, uint64(2), resource.Version)
+			require.Equal(t, owner, resource.Creator)
+			revision, found := k.GetResourceRevision(wctx, 0, 1)
+			require.True(t, found)
+			require.Equal(t, recipient, revision.Signer)
+			_, found = k.GetResourceTransferOffer(wctx, 0)
+			require.False(t, found)
+
+			// The previous owner lost its rights
+			_, err = srv.UpdateResource(wctx, &types.MsgUpdateResource{Creator: owner})
+			require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+			_, err = srv.UpdateResource(wctx, &types.MsgUpdateResource{Creator: recipient})
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestResourceMsgServerAcceptStaleTransfer(t *testing.T) {
+	k, srv, ctx := setupMsgServer(t)
+	wctx := sdk.UnwrapSDKContext(ctx)
+
+	_, err := srv.CreateResource(wctx, &types.MsgCreateResource{Creator: "A"})
+	require.NoError(t, err)
+	require.NoError(t, k.SetResourceTransferOffer(wctx, types.ResourceTransferOffer{ResourceId: 0, Owner: "C", Recipient: "B"}))
+
+	_, err = srv.AcceptResourceTransfer(wctx, &types.MsgAcceptResourceTransfer{Creator: "B"})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+}
+
+func TestResourceMsgServerCancelTransfer(t *testing.T) {
+	owner, recipient := "A", "B"
+
+	tests := []struct {
+		desc    string
+		request *types.MsgCancelResourceTransfer
+		err     error
+	}{
+		{
+			desc:    "Completed",
+			request: &types.MsgCancelResourceTransfer{Creator: owner},
+		},
+		{
+			desc:    "Unauthorized",
+			request: &types.MsgCancelResourceTransfer{Creator: recipient},
+			err:     sdkerrors.ErrUnauthorized,
+		},
+		{
+			desc:    "KeyNotFound",
+			request: &types.MsgCancelResourceTransfer{Creator: owner, Id: 10},
+			err:     sdkerrors.ErrKeyNotFound,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			k, srv, ctx := setupMsgServer(t)
+			wctx := sdk.UnwrapSDKContext(ctx)
+
+			_, err := srv.CreateResource(wctx, &types.MsgCreateResource{Creator: owner})
+			require.NoError(t, err)
+			_, err = srv.OfferResourceTransfer(wctx, &types.MsgOfferResourceTransfer{Creator: owner, Recipient: recipient})
+			require.NoError(t, err)
+
+			_, err = srv.CancelResourceTransfer(wctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+			_, found := k.GetResourceTransferOffer(wctx, 0)
+			require.False(t, found)
+		})
+	}
+}
+
+func TestResourceTransferEvents(t *testing.T) {
+	owner, recipient := "A", "B"
+	_, srv, goCtx := setupMsgServer(t)
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	_, err := srv.CreateResource(ctx, &types.MsgCreateResource{Creator: owner})
+	require.NoError(t, err)
+
+	_, err = srv.OfferResourceTransfer(ctx, &types.MsgOfferResourceTransfer{Creator: owner, Recipient: recipient})
+	require.NoError(t, err)
+	requireTypedEvent(t, ctx, &types.EventResourceTransferOffered{Id: 0, Owner: owner, Recipient: recipient})
+
+	_, err = srv.CancelResourceTransfer(ctx, &types.MsgCancelResourceTransfer{Creator: owner})
+	require.NoError(t, err)
+	requireTypedEvent(t, ctx, &types.EventResourceTransferCancelled{Id: 0, Owner: owner})
+
+	_, err = srv.OfferResourceTransfer(ctx, &types.MsgOfferResourceTransfer{Creator: owner, Recipient: recipient})
+	require.NoError(t, err)
+	_, err = srv.AcceptResourceTransfer(ctx, &types.MsgAcceptResourceTransfer{Creator: recipient})
+	require.NoError(t, err)
+	requireTypedEvent(t, ctx, &types.EventResourceTransferred{Id: 0, PreviousOwner: owner, NewOwner: recipient})
+}
@@ -0,0 +1,72 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"crude/x/crude/types"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func (k msgServer) AdjustResourceValue(goCtx context.Context, msg *types.MsgAdjustResourceValue) (*types.MsgAdjustResourceValueResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Checks that the element exists
+	val, found := k.GetResource(ctx, msg.Id)
+	if !found {
+		return nil, errorsmod.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("key %d doesn't exist", msg.Id))
+	}
+
+	// Checks if the msg creator may edit the resource
+	if !k.GetResourceRole(ctx, val, msg.Creator).CanEdit() {
+		return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "incorrect owner or editor")
+	}
+
+	// Only uint64 payloads can be adjusted
+	oldValue, ok := val.Payload.Uint64()
+	if !ok {
+		return nil, errorsmod.Wrapf(types.ErrInvalidPayload, "resource %d holds a %s value, not a uint64", msg.Id, val.Payload.ValueType())
+	}
+	value, err := types.AdjustValue(oldValue, msg.Delta, val.ValueBounds)
+	if err != nil {
+		return nil, err
+	}
+
+	resource := val
+	resource.Payload = types.NewUint64Payload(value)
+	resource.Version++
+	if err := k.Hooks().BeforeResourceUpdated(ctx, val, resource); err != nil {
+		return nil, err
+	}
+
+	if err := k.SettleResourceDeposit(ctx, &resource, msg.Creator); err != nil {
+		return nil, err
+	}
+	if err := k.SetResource(ctx, resource); err != nil {
+		return nil, err
+	}
+	if _, err := k.AppendResourceRevision(ctx, resource, msg.Creator); err != nil {
+		return nil, err
+	}
+	if err := k.Hooks().AfterResourceUpdated(ctx, val, resource); err != nil {
+		return nil, err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventResourceValueAdjusted{
+		Id:       msg.Id,
+		Signer:   msg.Creator,
+		Delta:    msg.Delta,
+		OldValue: oldValue,
+		NewValue: value,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgAdjustResourceValueResponse{
+		Value:   value,
+		Version: resource.Version,
+	}, nil
+}
@@ -0,0 +1,129 @@
+package keeper_test
+
+import (
+	"math"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"crude/x/crude/types"
+)
+
+func TestResourceMsgServerAdjustValue(t *testing.T) {
+	owner := "A"
+
+	tests := []struct {
+		desc    string
+		value   uint64
+		bounds  *types.ResourceValueBounds
+		request *types.MsgAdjustResourceValue
+		result  uint64
+		err     error
+	}{
+		{
+			desc:    "Increment",
+			value:   5,
+			request: &types.MsgAdjustResourceValue{Creator: owner, Delta: 3},
+			result:  8,
+		},
+		{
+			desc:    "Decrement",
+			value:   5,
+			request: &types.MsgAdjustResourceValue{Creator: owner, Delta: -5},
+			result:  0,
+		},
+		{
+			desc:    "Overflow",
+			value:   math.MaxUint64,
+			request: &types.MsgAdjustResourceValue{Creator: owner, Delta: 1},
+			err:     types.ErrValueOverflow,
+		},
+		{
+			desc:    "Underflow",
+			value:   5,
+			request: &types.MsgAdjustResourceValue{Creator: owner, Delta: -6},
+			err:     types.ErrValueUnderflow,
+		},
+		{
+			desc:    "AboveMaxBound",
+			value:   5,
+			bounds:  &types.ResourceValueBounds{Min: 1, Max: 10},
+			request: &types.MsgAdjustResourceValue{Creator: owner, Delta: 6},
+			err:     types.ErrValueOverflow,
+		},
+		{
+			desc:    "BelowMinBound",
+			value:   5,
+			bounds:  &types.ResourceValueBounds{Min: 1, Max: 10},
+			request: &types.MsgAdjustResourceValue{Creator: owner, Delta: -5},
+			err:     types.ErrValueUnderflow,
+		},
+		{
+			desc:    "Unauthorized",
+			request: &types.MsgAdjustResourceValue{Creator: "B", Delta: 1},
+			err:     sdkerrors.ErrUnauthorized,
+		},
+		{
+			desc:    "KeyNotFound",
+			request: &types.MsgAdjustResourceValue{Creator: owner, Id: 10, Delta: 1},
+			err:     sdkerrors.ErrKeyNotFound,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			k, srv, goCtx := setupMsgServer(t)
+			ctx := sdk.UnwrapSDKContext(goCtx)
+			_, err := srv.CreateResource(ctx, &types.MsgCreateResource{Creator: owner, Payload: types.NewUint64Payload(tc.value), ValueBounds: tc.bounds})
+			require.NoError(t, err)
+
+			resp, err := srv.AdjustResourceValue(ctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				rst, _ := k.GetResource(ctx, 0)
+				require.Equal(t, tc.value, rst.Payload.GetUint64Value())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.result, resp.Value)
+			require.Equal(t, uint64(2), resp.Version)
+
+			rst, found := k.GetResource(ctx, 0)
+			require.True(t, found)
+			require.Equal(t, tc.result, rst.Payload.GetUint64Value())
+			requireTypedEvent(t, ctx, &types.EventResourceValueAdjusted{
+				Id:       0,
+				Signer:   owner,
+				Delta:    tc.request.Delta,
+				OldValue: tc.value,
+				NewValue: tc.result,
+			})
+		})
+	}
+}
+
+func TestResourceMsgServerValueBounds(t *testing.T) {
+	owner := "A"
+	k, srv, goCtx := setupMsgServer(t)
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	_, err := srv.CreateResource(ctx, &types.MsgCreateResource{Creator: owner, Payload: types.NewUint64Payload(5), ValueBounds: &types.ResourceValueBounds{Min: 0, Max: 10}})
+	require.NoError(t, err)
+
+	// Updates keep the stored bounds unless they replace them
+	_, err = srv.UpdateResource(ctx, &types.MsgUpdateResource{Creator: owner, Payload: types.NewUint64Payload(11)})
+	require.ErrorIs(t, err, types.ErrValueOutOfBounds)
+	_, err = srv.UpdateResource(ctx, &types.MsgUpdateResource{Creator: owner, Payload: types.NewUint64Payload(11), ValueBounds: &types.ResourceValueBounds{Min: 0, Max: 20}})
+	require.NoError(t, err)
+	_, err = srv.UpdateResource(ctx, &types.MsgUpdateResource{Creator: owner, Payload: types.NewUint64Payload(20)})
+	require.NoError(t, err)
+	rst, _ := k.GetResource(ctx, 0)
+	require.Equal(t, &types.ResourceValueBounds{Min: 0, Max: 20}, rst.ValueBounds)
+
+	// Reverting to a value outside of the current bounds fails
+	_, err = srv.UpdateResource(ctx, &types.MsgUpdateResource{Creator: owner, Payload: types.NewUint64Payload(15), ValueBounds: &types.ResourceValueBounds{Min: 12, Max: 20}})
+	require.NoError(t, err)
+	_, err = srv.RevertResource(ctx, &types.MsgRevertResource{Creator: owner, Revision: 0})
+	require.ErrorIs(t, err, types.ErrValueOutOfBounds)
+}
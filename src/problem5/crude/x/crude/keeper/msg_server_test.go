@@ -1,9 +1,13 @@
 package keeper_test
 
 import (
+	"bytes"
 	"context"
 	"testing"
 
+	abci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
 	"github.com/stretchr/testify/require"
 
 	keepertest "crude/testutil/keeper"
@@ -16,6 +20,29 @@ func setupMsgServer(t testing.TB) (keeper.Keeper, types.MsgServer, context.Conte
 	return k, keeper.NewMsgServerImpl(k), ctx
 }
 
+// requireTypedEvent asserts that the last event of its type emitted on ctx equals expected
+func requireTypedEvent(t testing.TB, ctx sdk.Context, expected proto.Message) {
+	t.Helper()
+
+	events := ctx.EventManager().Events()
+	for i := len(events) - 1; i >= 0; i-- {
+		if events[i].Type != proto.MessageName(expected) {
+			continue
+		}
+		got, err := sdk.ParseTypedEvent(abci.Event(events[i]))
+		require.NoError(t, err)
+		// Encodings are compared as proto.Equal cannot compare custom types
+		// such as LegacyDec
+		expectedBz, err := proto.Marshal(expected)
+		require.NoError(t, err)
+		gotBz, err := proto.Marshal(got)
+		require.NoError(t, err)
+		require.Truef(t, bytes.Equal(expectedBz, gotBz), "expected %v, got %v", expected, got)
+		return
+	}
+	require.Failf(t, "event not emitted", "no %s event", proto.MessageName(expected))
+}
+
 func TestMsgServer(t *testing.T) {
 	k, ms, ctx := setupMsgServer(t)
 	require.NotNil(t, ms)
@@ -3,6 +3,7 @@ package keeper_test
 import (
 	"testing"
 
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 
@@ -39,6 +40,17 @@ func TestMsgUpdateParams(t *testing.T) {
 			},
 			expErr: false,
 		},
+		{
+			name: "invalid creation fee",
+			input: &types.MsgUpdateParams{
+				Authority: k.GetAuthority(),
+				Params: types.Params{
+					CreationFee: sdk.Coins{sdk.Coin{Denom: "stake", Amount: math.NewInt(-1)}},
+				},
+			},
+			expErr:    true,
+			expErrMsg: "invalid creation fee",
+		},
 		{
 			name: "all good",
 			input: &types.MsgUpdateParams{
@@ -58,6 +70,10 @@ func TestMsgUpdateParams(t *testing.T) {
 				require.Contains(t, err.Error(), tc.expErrMsg)
 			} else {
 				require.NoError(t, err)
+				requireTypedEvent(t, wctx, &types.EventParamsUpdated{
+					Authority: tc.input.Authority,
+					Params:    tc.input.Params,
+				})
 			}
 		})
 	}
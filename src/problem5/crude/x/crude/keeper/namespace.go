@@ -0,0 +1,73 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"crude/x/crude/types"
+
+	"cosmossdk.io/collections"
+)
+
+// SetNamespace set a specific namespace in the store
+func (k Keeper) SetNamespace(ctx context.Context, namespace types.Namespace) error {
+	return k.namespaces.Set(ctx, namespace.Name, namespace)
+}
+
+// GetNamespace returns a namespace from its name
+func (k Keeper) GetNamespace(ctx context.Context, name string) (val types.Namespace, found bool) {
+	val, err := k.namespaces.Get(ctx, name)
+	if errors.Is(err, collections.ErrNotFound) {
+		return val, false
+	}
+	if err != nil {
+		panic(err)
+	}
+
+	return val, true
+}
+
+// GetAllNamespace returns all namespaces
+func (k Keeper) GetAllNamespace(ctx context.Context) (list []types.Namespace) {
+	err := k.namespaces.Walk(ctx, nil, func(_ string, val types.Namespace) (bool, error) {
+		list = append(list, val)
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	return
+}
+
+// GetResourceIDsByNamespace returns the ids of the resources of a namespace
+func (k Keeper) GetResourceIDsByNamespace(ctx context.Context, namespace string) (ids []uint64) {
+	rng := collections.NewPrefixedPairRange[string, uint64](namespace)
+	err := k.resourcesByNamespace.Walk(ctx, rng, func(key collections.Pair[string, uint64]) (bool, error) {
+		ids = append(ids, key.K2())
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	return
+}
+
+// insertIntoNamespaceIndex indexes the resource under its namespace, if any
+func (k Keeper) insertIntoNamespaceIndex(ctx context.Context, resource types.Resource) error {
+	if resource.Namespace == "" {
+		return nil
+	}
+
+	return k.resourcesByNamespace.Set(ctx, collections.Join(resource.Namespace, resource.Id))
+}
+
+// removeFromNamespaceIndex drops the resource from the index of its namespace
+func (k Keeper) removeFromNamespaceIndex(ctx context.Context, resource types.Resource) error {
+	if resource.Namespace == "" {
+		return nil
+	}
+
+	return k.resourcesByNamespace.Remove(ctx, collections.Join(resource.Namespace, resource.Id))
+}
@@ -2,32 +2,27 @@ package keeper
 
 import (
 	"context"
+	"errors"
 
-	"github.com/cosmos/cosmos-sdk/runtime"
+	"cosmossdk.io/collections"
 
 	"crude/x/crude/types"
 )
 
 // GetParams get all parameters as types.Params
 func (k Keeper) GetParams(ctx context.Context) (params types.Params) {
-	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
-	bz := store.Get(types.ParamsKey)
-	if bz == nil {
+	params, err := k.params.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) {
 		return params
 	}
+	if err != nil {
+		panic(err)
+	}
 
-	k.cdc.MustUnmarshal(bz, &params)
 	return params
 }
 
 // SetParams set the params
 func (k Keeper) SetParams(ctx context.Context, params types.Params) error {
-	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
-	bz, err := k.cdc.Marshal(&params)
-	if err != nil {
-		return err
-	}
-	store.Set(types.ParamsKey, bz)
-
-	return nil
+	return k.params.Set(ctx, params)
 }
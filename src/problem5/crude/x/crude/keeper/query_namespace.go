@@ -0,0 +1,41 @@
+package keeper
+
+import (
+	"context"
+
+	"crude/x/crude/types"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) NamespaceAll(ctx context.Context, req *types.QueryAllNamespaceRequest) (*types.QueryAllNamespaceResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	namespaces, pageRes, err := query.CollectionPaginate(ctx, k.namespaces, req.Pagination, func(_ string, namespace types.Namespace) (types.Namespace, error) {
+		return namespace, nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllNamespaceResponse{Namespace: namespaces, Pagination: pageRes}, nil
+}
+
+func (k Keeper) Namespace(ctx context.Context, req *types.QueryGetNamespaceRequest) (*types.QueryGetNamespaceResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	namespace, found := k.GetNamespace(ctx, req.Name)
+	if !found {
+		return nil, sdkerrors.ErrKeyNotFound
+	}
+
+	return &types.QueryGetNamespaceResponse{Namespace: namespace}, nil
+}
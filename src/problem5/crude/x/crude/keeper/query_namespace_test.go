@@ -0,0 +1,131 @@
+package keeper_test
+
+import (
+	"context"
+	"strconv"
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	keepertest "crude/testutil/keeper"
+	"crude/testutil/nullify"
+	"crude/x/crude/keeper"
+	"crude/x/crude/types"
+)
+
+func createNNamespace(keeper keeper.Keeper, ctx context.Context, n int) []types.Namespace {
+	items := make([]types.Namespace, n)
+	for i := range items {
+		items[i].Name = "ns-" + strconv.Itoa(i)
+		items[i].Admin = "A"
+		items[i].MaxResources = uint64(i)
+		if err := keeper.SetNamespace(ctx, items[i]); err != nil {
+			panic(err)
+		}
+	}
+	return items
+}
+
+func TestNamespaceQuerySingle(t *testing.T) {
+	keeper, ctx := keepertest.CrudeKeeper(t)
+	msgs := createNNamespace(keeper, ctx, 2)
+	tests := []struct {
+		desc     string
+		request  *types.QueryGetNamespaceRequest
+		response *types.QueryGetNamespaceResponse
+		err      error
+	}{
+		{
+			desc:     "First",
+			request:  &types.QueryGetNamespaceRequest{Name: msgs[0].Name},
+			response: &types.QueryGetNamespaceResponse{Namespace: msgs[0]},
+		},
+		{
+			desc:     "Second",
+			request:  &types.QueryGetNamespaceRequest{Name: msgs[1].Name},
+			response: &types.QueryGetNamespaceResponse{Namespace: msgs[1]},
+		},
+		{
+			desc:    "KeyNotFound",
+			request: &types.QueryGetNamespaceRequest{Name: "acme"},
+			err:     sdkerrors.ErrKeyNotFound,
+		},
+		{
+			desc: "InvalidRequest",
+			err:  status.Error(codes.InvalidArgument, "invalid request"),
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			response, err := keeper.Namespace(ctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t,
+					nullify.Fill(tc.response),
+					nullify.Fill(response),
+				)
+			}
+		})
+	}
+}
+
+func TestNamespaceQueryPaginated(t *testing.T) {
+	keeper, ctx := keepertest.CrudeKeeper(t)
+	msgs := createNNamespace(keeper, ctx, 5)
+
+	request := func(next []byte, offset, limit uint64, total bool) *types.QueryAllNamespaceRequest {
+		return &types.QueryAllNamespaceRequest{
+			Pagination: &query.PageRequest{
+				Key:        next,
+				Offset:     offset,
+				Limit:      limit,
+				CountTotal: total,
+			},
+		}
+	}
+	t.Run("ByOffset", func(t *testing.T) {
+		step := 2
+		for i := 0; i < len(msgs); i += step {
+			resp, err := keeper.NamespaceAll(ctx, request(nil, uint64(i), uint64(step), false))
+			require.NoError(t, err)
+			require.LessOrEqual(t, len(resp.Namespace), step)
+			require.Subset(t,
+				nullify.Fill(msgs),
+				nullify.Fill(resp.Namespace),
+			)
+		}
+	})
+	t.Run("ByKey", func(t *testing.T) {
+		step := 2
+		var next []byte
+		for i := 0; i < len(msgs); i += step {
+			resp, err := keeper.NamespaceAll(ctx, request(next, 0, uint64(step), false))
+			require.NoError(t, err)
+			require.LessOrEqual(t, len(resp.Namespace), step)
+			require.Subset(t,
+				nullify.Fill(msgs),
+				nullify.Fill(resp.Namespace),
+			)
+			next = resp.Pagination.NextKey
+		}
+	})
+	t.Run("Total", func(t *testing.T) {
+		resp, err := keeper.NamespaceAll(ctx, request(nil, 0, 0, true))
+		require.NoError(t, err)
+		require.Equal(t, len(msgs), int(resp.Pagination.Total))
+		require.ElementsMatch(t,
+			nullify.Fill(msgs),
+			nullify.Fill(resp.Namespace),
+		)
+	})
+	t.Run("InvalidRequest", func(t *testing.T) {
+		_, err := keeper.NamespaceAll(ctx, nil)
+		require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
+	})
+}
@@ -2,11 +2,12 @@ package keeper
 
 import (
 	"context"
+	"fmt"
+	"strings"
 
 	"crude/x/crude/types"
 
-	"cosmossdk.io/store/prefix"
-	"github.com/cosmos/cosmos-sdk/runtime"
+	"cosmossdk.io/collections"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/query"
 	"google.golang.org/grpc/codes"
@@ -17,21 +18,67 @@ func (k Keeper) ResourceAll(ctx context.Context, req *types.QueryAllResourceRequ
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "invalid request")
 	}
+	if req.Namespace != "" {
+		if err := types.ValidateNamespaceName(req.Namespace); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+	if err := types.ValidateNamePrefix(req.NamePrefix); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err := req.ValueRange.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
 
-	var resources []types.Resource
-
-	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
-	resourceStore := prefix.NewStore(store, types.KeyPrefix(types.ResourceKey))
+	pageReq := req.Pagination
+	if req.Reverse {
+		if pageReq == nil {
+			pageReq = &query.PageRequest{}
+		} else {
+			copied := *pageReq
+			pageReq = &copied
+		}
+		pageReq.Reverse = true
+	}
 
-	pageRes, err := query.Paginate(resourceStore, req.Pagination, func(key []byte, value []byte) error {
-		var resource types.Resource
-		if err := k.cdc.Unmarshal(value, &resource); err != nil {
-			return err
+	if req.CountOnly {
+		count, err := k.countResources(ctx, req)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
 		}
+		return &types.QueryAllResourceResponse{Count: count}, nil
+	}
 
-		resources = append(resources, resource)
-		return nil
-	})
+	match := func(resource types.Resource) bool {
+		return resourceMatches(req, resource)
+	}
+
+	var (
+		resources []types.Resource
+		pageRes   *query.PageResponse
+		err       error
+	)
+	switch {
+	case req.Namespace != "":
+		resources, pageRes, err = k.filterIndexedResources(ctx, "namespace", k.resourcesByNamespace, req.Namespace, pageReq, match)
+	case req.Creator != "":
+		resources, pageRes, err = k.filterIndexedResources(ctx, "creator", k.resourcesByCreator, req.Creator, pageReq, match)
+	case req.NamePrefix != "":
+		resources, pageRes, err = k.filterResourcesByNamePrefix(ctx, req.NamePrefix, pageReq, match)
+	default:
+		// The value range has no index, so it is checked on every resource
+		resources, pageRes, err = query.CollectionFilteredPaginate(
+			ctx,
+			k.resources,
+			pageReq,
+			func(_ uint64, resource types.Resource) (bool, error) {
+				return match(resource), nil
+			},
+			func(_ uint64, resource types.Resource) (types.Resource, error) {
+				return resource, nil
+			},
+		)
+	}
 
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
@@ -40,6 +87,62 @@ func (k Keeper) ResourceAll(ctx context.Context, req *types.QueryAllResourceRequ
 	return &types.QueryAllResourceResponse{Resource: resources, Pagination: pageRes}, nil
 }
 
+// countResources counts the resources matching a request in countOnly mode.
+// The index picked as in listing mode is walked over the range of its key
+// only, and the resources are loaded only when other filters remain to be
+// checked. Without an index filter, the value range is counted from the value
+// aggregates and no filter at all from the live count.
+func (k Keeper) countResources(ctx context.Context, req *types.QueryAllResourceRequest) (uint64, error) {
+	var (
+		count    uint64
+		filtered bool
+	)
+	countID := func(id uint64) error {
+		if !filtered {
+			count++
+			return nil
+		}
+		resource, found := k.GetResource(ctx, id)
+		if !found {
+			return fmt.Errorf("resource %d indexed but not found", id)
+		}
+		if resourceMatches(req, resource) {
+			count++
+		}
+		return nil
+	}
+	walkIndex := func(index collections.KeySet[collections.Pair[string, uint64]], key string) error {
+		return index.Walk(ctx, collections.NewPrefixedPairRange[string, uint64](key), func(entry collections.Pair[string, uint64]) (bool, error) {
+			return false, countID(entry.K2())
+		})
+	}
+
+	var err error
+	switch {
+	case req.Namespace != "":
+		filtered = req.Creator != "" || req.NamePrefix != "" || req.ValueRange != nil
+		err = walkIndex(k.resourcesByNamespace, req.Namespace)
+	case req.Creator != "":
+		filtered = req.NamePrefix != "" || req.ValueRange != nil
+		err = walkIndex(k.resourcesByCreator, req.Creator)
+	case req.NamePrefix != "":
+		filtered = req.ValueRange != nil
+		err = k.walkNamePrefix(ctx, req.NamePrefix, func(entry collections.Pair[string, uint64]) error {
+			return countID(entry.K2())
+		})
+	case req.ValueRange != nil:
+		ranger := new(collections.Range[uint64]).StartInclusive(req.ValueRange.Min).EndInclusive(req.ValueRange.Max)
+		err = k.valueCounts.Walk(ctx, ranger, func(_, n uint64) (bool, error) {
+			count += n
+			return false, nil
+		})
+	default:
+		count = k.GetResourceTotals(ctx).LiveCount
+	}
+
+	return count, err
+}
+
 func (k Keeper) Resource(ctx context.Context, req *types.QueryGetResourceRequest) (*types.QueryGetResourceResponse, error) {
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "invalid request")
@@ -52,3 +155,65 @@ func (k Keeper) Resource(ctx context.Context, req *types.QueryGetResourceRequest
 
 	return &types.QueryGetResourceResponse{Resource: resource}, nil
 }
+
+// resourceMatches returns true if the resource passes every filter of the request
+func resourceMatches(req *types.QueryAllResourceRequest, resource types.Resource) bool {
+	if req.Namespace != "" && resource.Namespace != req.Namespace {
+		return false
+	}
+	if req.Creator != "" && resource.Creator != req.Creator {
+		return false
+	}
+	if !strings.HasPrefix(resource.Name, req.NamePrefix) {
+		return false
+	}
+	if req.ValueRange != nil {
+		value, ok := resource.Payload.Uint64()
+		if !ok || !req.ValueRange.Contains(value) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// filterIndexedResources pages through the resources indexed under key by a
+// (key, id) index, in id order. A nil match keeps every resource.
+func (k Keeper) filterIndexedResources(
+	ctx context.Context,
+	name string,
+	index collections.KeySet[collections.Pair[string, uint64]],
+	key string,
+	pageReq *query.PageRequest,
+	match func(types.Resource) bool,
+) ([]types.Resource, *query.PageResponse, error) {
+	indexed := func(entry collections.Pair[string, uint64]) (types.Resource, error) {
+		resource, found := k.GetResource(ctx, entry.K2())
+		if !found {
+			return resource, fmt.Errorf("resource %d indexed for %s %s not found", entry.K2(), name, key)
+		}
+		return resource, nil
+	}
+
+	var predicate func(collections.Pair[string, uint64], collections.NoValue) (bool, error)
+	if match != nil {
+		predicate = func(entry collections.Pair[string, uint64], _ collections.NoValue) (bool, error) {
+			resource, err := indexed(entry)
+			if err != nil {
+				return false, err
+			}
+			return match(resource), nil
+		}
+	}
+
+	return query.CollectionFilteredPaginate(
+		ctx,
+		index,
+		pageReq,
+		predicate,
+		func(entry collections.Pair[string, uint64], _ collections.NoValue) (types.Resource, error) {
+			return indexed(entry)
+		},
+		query.WithCollectionPaginationPairPrefix[string, uint64](key),
+	)
+}
@@ -0,0 +1,24 @@
+package keeper
+
+import (
+	"context"
+
+	"crude/x/crude/types"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) ResourceByName(ctx context.Context, req *types.QueryResourceByNameRequest) (*types.QueryResourceByNameResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	resource, found := k.GetResourceByName(ctx, req.Name)
+	if !found {
+		return nil, sdkerrors.ErrKeyNotFound
+	}
+
+	return &types.QueryResourceByNameResponse{Resource: resource}, nil
+}
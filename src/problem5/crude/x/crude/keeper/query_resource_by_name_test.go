@@ -0,0 +1,76 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	keepertest "crude/testutil/keeper"
+	"crude/testutil/nullify"
+	"crude/x/crude/types"
+)
+
+func TestResourceByNameQuery(t *testing.T) {
+	keeper, ctx := keepertest.CrudeKeeper(t)
+	var msgs []types.Resource
+	for _, name := range []string{"b", "ab", "b"} {
+		item := types.Resource{Name: name}
+		id, err := keeper.AppendResource(ctx, item)
+		require.NoError(t, err)
+		item.Id = id
+		msgs = append(msgs, item)
+	}
+
+	tests := []struct {
+		desc     string
+		request  *types.QueryResourceByNameRequest
+		response *types.QueryResourceByNameResponse
+		err      error
+	}{
+		{
+			desc:     "Unique",
+			request:  &types.QueryResourceByNameRequest{Name: "ab"},
+			response: &types.QueryResourceByNameResponse{Resource: msgs[1]},
+		},
+		{
+			desc:     "LowestId",
+			request:  &types.QueryResourceByNameRequest{Name: "b"},
+			response: &types.QueryResourceByNameResponse{Resource: msgs[0]},
+		},
+		{
+			desc:    "Prefix",
+			request: &types.QueryResourceByNameRequest{Name: "a"},
+			err:     sdkerrors.ErrKeyNotFound,
+		},
+		{
+			desc: "InvalidRequest",
+			err:  status.Error(codes.InvalidArgument, "invalid request"),
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			response, err := keeper.ResourceByName(ctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t,
+					nullify.Fill(tc.response),
+					nullify.Fill(response),
+				)
+			}
+		})
+	}
+
+	// Renaming and removing keep the lookup current
+	renamed := msgs[0]
+	renamed.Name = "c"
+	require.NoError(t, keeper.SetResource(ctx, renamed))
+	require.NoError(t, keeper.RemoveResource(ctx, msgs[1].Id))
+	require.Equal(t, []uint64{2}, keeper.GetResourceIDsByName(ctx, "b"))
+	require.Empty(t, keeper.GetResourceIDsByName(ctx, "ab"))
+	require.Equal(t, []uint64{0}, keeper.GetResourceIDsByName(ctx, "c"))
+}
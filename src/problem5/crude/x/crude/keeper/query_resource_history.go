@@ -0,0 +1,48 @@
+package keeper
+
+import (
+	"context"
+
+	"crude/x/crude/types"
+
+	"cosmossdk.io/collections"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) ResourceHistory(ctx context.Context, req *types.QueryResourceHistoryRequest) (*types.QueryResourceHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	revisions, pageRes, err := query.CollectionPaginate(
+		ctx,
+		k.resourceRevisions,
+		req.Pagination,
+		func(_ collections.Pair[uint64, uint64], revision types.ResourceRevision) (types.ResourceRevision, error) {
+			return revision, nil
+		},
+		query.WithCollectionPaginationPairPrefix[uint64, uint64](req.Id),
+	)
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryResourceHistoryResponse{Revisions: revisions, Pagination: pageRes}, nil
+}
+
+func (k Keeper) ResourceAtRevision(ctx context.Context, req *types.QueryResourceAtRevisionRequest) (*types.QueryResourceAtRevisionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	revision, found := k.GetResourceRevision(ctx, req.Id, req.Revision)
+	if !found {
+		return nil, sdkerrors.ErrKeyNotFound
+	}
+
+	return &types.QueryResourceAtRevisionResponse{Revision: revision}, nil
+}
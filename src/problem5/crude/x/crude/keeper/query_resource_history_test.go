@@ -0,0 +1,75 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"crude/x/crude/types"
+)
+
+func TestResourceHistoryQuery(t *testing.T) {
+	k, srv, ctx := setupMsgServer(t)
+	wctx := sdk.UnwrapSDKContext(ctx).WithBlockHeight(7)
+	creator := "A"
+
+	_, err := srv.CreateResource(wctx, &types.MsgCreateResource{Creator: creator, Name: "v0", Payload: types.NewUint64Payload(0)})
+	require.NoError(t, err)
+	_, err = srv.CreateResource(wctx, &types.MsgCreateResource{Creator: creator, Name: "other"})
+	require.NoError(t, err)
+	for i := uint64(1); i < 5; i++ {
+		_, err = srv.UpdateResource(wctx, &types.MsgUpdateResource{Creator: creator, Id: 0, Name: "v", Payload: types.NewUint64Payload(i)})
+		require.NoError(t, err)
+	}
+
+	t.Run("History", func(t *testing.T) {
+		resp, err := k.ResourceHistory(wctx, &types.QueryResourceHistoryRequest{Id: 0})
+		require.NoError(t, err)
+		require.Len(t, resp.Revisions, 5)
+		for i, revision := range resp.Revisions {
+			require.Equal(t, uint64(0), revision.ResourceId)
+			require.Equal(t, uint64(i), revision.Revision)
+			require.Equal(t, uint64(i), revision.Payload.GetUint64Value())
+			require.Equal(t, int64(7), revision.BlockHeight)
+			require.Equal(t, creator, revision.Signer)
+		}
+	})
+	t.Run("Paginated", func(t *testing.T) {
+		var next []byte
+		var all []types.ResourceRevision
+		for {
+			resp, err := k.ResourceHistory(wctx, &types.QueryResourceHistoryRequest{
+				Id:         0,
+				Pagination: &query.PageRequest{Key: next, Limit: 2},
+			})
+			require.NoError(t, err)
+			require.LessOrEqual(t, len(resp.Revisions), 2)
+			all = append(all, resp.Revisions...)
+			next = resp.Pagination.NextKey
+			if next == nil {
+				break
+			}
+		}
+		require.Len(t, all, 5)
+	})
+	t.Run("AtRevision", func(t *testing.T) {
+		resp, err := k.ResourceAtRevision(wctx, &types.QueryResourceAtRevisionRequest{Id: 0, Revision: 3})
+		require.NoError(t, err)
+		require.Equal(t, uint64(3), resp.Revision.Payload.GetUint64Value())
+	})
+	t.Run("RevisionNotFound", func(t *testing.T) {
+		_, err := k.ResourceAtRevision(wctx, &types.QueryResourceAtRevisionRequest{Id: 1, Revision: 1})
+		require.ErrorIs(t, err, sdkerrors.ErrKeyNotFound)
+	})
+	t.Run("InvalidRequest", func(t *testing.T) {
+		_, err := k.ResourceHistory(wctx, nil)
+		require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
+		_, err = k.ResourceAtRevision(wctx, nil)
+		require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
+	})
+}
@@ -0,0 +1,56 @@
+package keeper
+
+import (
+	"context"
+
+	"crude/x/crude/types"
+
+	"cosmossdk.io/collections"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) ResourceReplications(ctx context.Context, req *types.QueryResourceReplicationsRequest) (*types.QueryResourceReplicationsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if _, found := k.GetResource(ctx, req.Id); !found {
+		return nil, sdkerrors.ErrKeyNotFound
+	}
+
+	replications, pageRes, err := query.CollectionPaginate(
+		ctx,
+		k.replications,
+		req.Pagination,
+		func(_ collections.Pair[uint64, string], replication types.ResourceReplication) (types.ResourceReplication, error) {
+			return replication, nil
+		},
+		query.WithCollectionPaginationPairPrefix[uint64, string](req.Id),
+	)
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryResourceReplicationsResponse{Replication: replications, Pagination: pageRes}, nil
+}
+
+func (k Keeper) ResourceReplica(ctx context.Context, req *types.QueryResourceReplicaRequest) (*types.QueryResourceReplicaResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	replica, found := k.GetResourceReplica(ctx, req.ChannelId, req.SourceId)
+	if !found {
+		return nil, sdkerrors.ErrKeyNotFound
+	}
+	resource, found := k.GetResource(ctx, replica.ResourceId)
+	if !found {
+		return nil, status.Error(codes.Internal, "replica resource is missing")
+	}
+
+	return &types.QueryResourceReplicaResponse{Replica: replica, Resource: resource}, nil
+}
@@ -0,0 +1,150 @@
+package keeper_test
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	keepertest "crude/testutil/keeper"
+	"crude/testutil/nullify"
+	"crude/x/crude/keeper"
+	"crude/x/crude/types"
+)
+
+func createNResourceReplication(keeper keeper.Keeper, ctx context.Context, id uint64, n int) []types.ResourceReplication {
+	items := make([]types.ResourceReplication, n)
+	for i := range items {
+		items[i].ResourceId = id
+		items[i].ChannelId = "channel-" + strconv.Itoa(i)
+		items[i].TimeoutPeriod = time.Duration(i) * time.Minute
+		if err := keeper.SetResourceReplication(ctx, items[i]); err != nil {
+			panic(err)
+		}
+	}
+	return items
+}
+
+func TestResourceReplicationStore(t *testing.T) {
+	keeper, ctx := keepertest.CrudeKeeper(t)
+	resources := createNResource(keeper, ctx, 2)
+	items := createNResourceReplication(keeper, ctx, resources[0].Id, 2)
+	other := createNResourceReplication(keeper, ctx, resources[1].Id, 1)
+
+	require.ElementsMatch(t, items, keeper.GetResourceReplications(ctx, resources[0].Id))
+	require.Equal(t, []uint64{resources[0].Id, resources[1].Id}, keeper.GetChannelReplicationIDs(ctx, "channel-0"))
+	require.Equal(t, []uint64{resources[0].Id}, keeper.GetChannelReplicationIDs(ctx, "channel-1"))
+
+	// Removing a resource drops its replications and their channel index
+	require.NoError(t, keeper.RemoveResource(ctx, resources[0].Id))
+	require.Empty(t, keeper.GetResourceReplications(ctx, resources[0].Id))
+	require.Equal(t, []uint64{resources[1].Id}, keeper.GetChannelReplicationIDs(ctx, "channel-0"))
+	require.Empty(t, keeper.GetChannelReplicationIDs(ctx, "channel-1"))
+	require.Equal(t, other, keeper.GetAllResourceReplication(ctx))
+
+	// Closing a channel stops the replications on it
+	require.NoError(t, keeper.StopChannelReplications(ctx, "channel-0", "channel closed"))
+	require.Empty(t, keeper.GetAllResourceReplication(ctx))
+}
+
+func TestResourceReplicationsQuery(t *testing.T) {
+	keeper, ctx := keepertest.CrudeKeeper(t)
+	resources := createNResource(keeper, ctx, 2)
+	msgs := createNResourceReplication(keeper, ctx, resources[0].Id, 5)
+	createNResourceReplication(keeper, ctx, resources[1].Id, 2)
+
+	request := func(next []byte, offset, limit uint64, total bool) *types.QueryResourceReplicationsRequest {
+		return &types.QueryResourceReplicationsRequest{
+			Id: resources[0].Id,
+			Pagination: &query.PageRequest{
+				Key:        next,
+				Offset:     offset,
+				Limit:      limit,
+				CountTotal: total,
+			},
+		}
+	}
+	t.Run("ByOffset", func(t *testing.T) {
+		step := 2
+		for i := 0; i < len(msgs); i += step {
+			resp, err := keeper.ResourceReplications(ctx, request(nil, uint64(i), uint64(step), false))
+			require.NoError(t, err)
+			require.LessOrEqual(t, len(resp.Replication), step)
+			require.Subset(t,
+				nullify.Fill(msgs),
+				nullify.Fill(resp.Replication),
+			)
+		}
+	})
+	t.Run("Total", func(t *testing.T) {
+		resp, err := keeper.ResourceReplications(ctx, request(nil, 0, 0, true))
+		require.NoError(t, err)
+		require.Equal(t, len(msgs), int(resp.Pagination.Total))
+		require.ElementsMatch(t,
+			nullify.Fill(msgs),
+			nullify.Fill(resp.Replication),
+		)
+	})
+	t.Run("KeyNotFound", func(t *testing.T) {
+		_, err := keeper.ResourceReplications(ctx, &types.QueryResourceReplicationsRequest{Id: 100})
+		require.ErrorIs(t, err, sdkerrors.ErrKeyNotFound)
+	})
+	t.Run("InvalidRequest", func(t *testing.T) {
+		_, err := keeper.ResourceReplications(ctx, nil)
+		require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
+	})
+}
+
+func TestResourceReplicaQuery(t *testing.T) {
+	keeper, ctx := keepertest.CrudeKeeper(t)
+	resources := createNResource(keeper, ctx, 1)
+	replica := types.ResourceReplica{ChannelId: "channel-0", SourceId: 7, ResourceId: resources[0].Id}
+	require.NoError(t, keeper.SetResourceReplica(ctx, replica))
+
+	tests := []struct {
+		desc     string
+		request  *types.QueryResourceReplicaRequest
+		response *types.QueryResourceReplicaResponse
+		err      error
+	}{
+		{
+			desc:     "Found",
+			request:  &types.QueryResourceReplicaRequest{ChannelId: "channel-0", SourceId: 7},
+			response: &types.QueryResourceReplicaResponse{Replica: replica, Resource: resources[0]},
+		},
+		{
+			desc:    "OtherChannel",
+			request: &types.QueryResourceReplicaRequest{ChannelId: "channel-1", SourceId: 7},
+			err:     sdkerrors.ErrKeyNotFound,
+		},
+		{
+			desc:    "KeyNotFound",
+			request: &types.QueryResourceReplicaRequest{ChannelId: "channel-0", SourceId: 8},
+			err:     sdkerrors.ErrKeyNotFound,
+		},
+		{
+			desc: "InvalidRequest",
+			err:  status.Error(codes.InvalidArgument, "invalid request"),
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			response, err := keeper.ResourceReplica(ctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t,
+					nullify.Fill(tc.response),
+					nullify.Fill(response),
+				)
+			}
+		})
+	}
+}
@@ -0,0 +1,67 @@
+package keeper
+
+import (
+	"context"
+
+	"crude/x/crude/types"
+
+	"cosmossdk.io/collections"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) ResourceRoles(ctx context.Context, req *types.QueryResourceRolesRequest) (*types.QueryResourceRolesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	resource, found := k.GetResource(ctx, req.Id)
+	if !found {
+		return nil, sdkerrors.ErrKeyNotFound
+	}
+
+	grants, pageRes, err := query.CollectionPaginate(
+		ctx,
+		k.resourceRoles,
+		req.Pagination,
+		func(_ collections.Pair[uint64, string], grant types.ResourceRoleGrant) (types.ResourceRoleGrant, error) {
+			return grant, nil
+		},
+		query.WithCollectionPaginationPairPrefix[uint64, string](req.Id),
+	)
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryResourceRolesResponse{Owner: resource.Owner, Grants: grants, Pagination: pageRes}, nil
+}
+
+// EditableResources lists the grants held by an address. Resources the address
+// owns are listed by ResourcesByOwner.
+func (k Keeper) EditableResources(ctx context.Context, req *types.QueryEditableResourcesRequest) (*types.QueryEditableResourcesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if req.Address == "" {
+		return nil, status.Error(codes.InvalidArgument, "address cannot be empty")
+	}
+
+	grants, pageRes, err := query.CollectionPaginate(
+		ctx,
+		k.resourcesByGrantee,
+		req.Pagination,
+		func(key collections.Pair[string, uint64], _ collections.NoValue) (types.ResourceRoleGrant, error) {
+			return k.resourceRoles.Get(ctx, collections.Join(key.K2(), key.K1()))
+		},
+		query.WithCollectionPaginationPairPrefix[string, uint64](req.Address),
+	)
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryEditableResourcesResponse{Grants: grants, Pagination: pageRes}, nil
+}
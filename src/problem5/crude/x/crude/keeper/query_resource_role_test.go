@@ -0,0 +1,103 @@
+package keeper_test
+
+import (
+	"strconv"
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	keepertest "crude/testutil/keeper"
+	"crude/testutil/nullify"
+	"crude/x/crude/types"
+)
+
+func TestResourceRolesQuery(t *testing.T) {
+	keeper, ctx := keepertest.CrudeKeeper(t)
+	id, err := keeper.AppendResource(ctx, types.Resource{Owner: "A"})
+	require.NoError(t, err)
+
+	var grants []types.ResourceRoleGrant
+	for i := 0; i < 5; i++ {
+		grant := types.ResourceRoleGrant{
+			ResourceId: id,
+			Address:    strconv.Itoa(i),
+			Role:       types.ResourceRole_RESOURCE_ROLE_EDITOR,
+		}
+		require.NoError(t, keeper.SetResourceRoleGrant(ctx, grant))
+		grants = append(grants, grant)
+	}
+
+	t.Run("Paginated", func(t *testing.T) {
+		step := 2
+		var next []byte
+		var got []types.ResourceRoleGrant
+		for i := 0; i < len(grants); i += step {
+			resp, err := keeper.ResourceRoles(ctx, &types.QueryResourceRolesRequest{
+				Id:         id,
+				Pagination: &query.PageRequest{Key: next, Limit: uint64(step)},
+			})
+			require.NoError(t, err)
+			require.Equal(t, "A", resp.Owner)
+			require.LessOrEqual(t, len(resp.Grants), step)
+			got = append(got, resp.Grants...)
+			next = resp.Pagination.NextKey
+		}
+		require.ElementsMatch(t, nullify.Fill(grants), nullify.Fill(got))
+	})
+	t.Run("KeyNotFound", func(t *testing.T) {
+		_, err := keeper.ResourceRoles(ctx, &types.QueryResourceRolesRequest{Id: 10})
+		require.ErrorIs(t, err, sdkerrors.ErrKeyNotFound)
+	})
+	t.Run("InvalidRequest", func(t *testing.T) {
+		_, err := keeper.ResourceRoles(ctx, nil)
+		require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
+	})
+}
+
+func TestEditableResourcesQuery(t *testing.T) {
+	keeper, ctx := keepertest.CrudeKeeper(t)
+
+	var grants []types.ResourceRoleGrant
+	for i := 0; i < 6; i++ {
+		id, err := keeper.AppendResource(ctx, types.Resource{Owner: "A"})
+		require.NoError(t, err)
+		grant := types.ResourceRoleGrant{
+			ResourceId: id,
+			Address:    []string{"B", "C"}[i%2],
+			Role:       types.ResourceRole_RESOURCE_ROLE_ADMIN,
+		}
+		require.NoError(t, keeper.SetResourceRoleGrant(ctx, grant))
+		if grant.Address == "B" {
+			grants = append(grants, grant)
+		}
+	}
+
+	t.Run("Total", func(t *testing.T) {
+		resp, err := keeper.EditableResources(ctx, &types.QueryEditableResourcesRequest{
+			Address:    "B",
+			Pagination: &query.PageRequest{CountTotal: true},
+		})
+		require.NoError(t, err)
+		require.Equal(t, len(grants), int(resp.Pagination.Total))
+		require.ElementsMatch(t, nullify.Fill(grants), nullify.Fill(resp.Grants))
+	})
+	t.Run("Revoked", func(t *testing.T) {
+		require.NoError(t, keeper.RemoveResourceRoleGrant(ctx, grants[0].ResourceId, "B"))
+		resp, err := keeper.EditableResources(ctx, &types.QueryEditableResourcesRequest{Address: "B"})
+		require.NoError(t, err)
+		require.ElementsMatch(t, nullify.Fill(grants[1:]), nullify.Fill(resp.Grants))
+	})
+	t.Run("OwnerNotListed", func(t *testing.T) {
+		resp, err := keeper.EditableResources(ctx, &types.QueryEditableResourcesRequest{Address: "A"})
+		require.NoError(t, err)
+		require.Empty(t, resp.Grants)
+	})
+	t.Run("InvalidRequest", func(t *testing.T) {
+		_, err := keeper.EditableResources(ctx, nil)
+		require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
+	})
+}
@@ -0,0 +1,50 @@
+package keeper
+
+import (
+	"context"
+
+	"crude/x/crude/types"
+
+	"cosmossdk.io/collections"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) ResourceSchemaAll(ctx context.Context, req *types.QueryAllResourceSchemaRequest) (*types.QueryAllResourceSchemaResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	schemas, pageRes, err := query.CollectionPaginate(ctx, k.resourceSchemas, req.Pagination, func(_ collections.Pair[string, uint64], schema types.ResourceSchema) (types.ResourceSchema, error) {
+		return schema, nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllResourceSchemaResponse{ResourceSchema: schemas, Pagination: pageRes}, nil
+}
+
+func (k Keeper) ResourceSchema(ctx context.Context, req *types.QueryGetResourceSchemaRequest) (*types.QueryGetResourceSchemaResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var (
+		schema types.ResourceSchema
+		found  bool
+	)
+	if req.Version == 0 {
+		schema, found = k.GetLatestResourceSchema(ctx, req.Id)
+	} else {
+		schema, found = k.GetResourceSchema(ctx, req.Id, req.Version)
+	}
+	if !found {
+		return nil, sdkerrors.ErrKeyNotFound
+	}
+
+	return &types.QueryGetResourceSchemaResponse{ResourceSchema: schema}, nil
+}
@@ -0,0 +1,138 @@
+package keeper_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	keepertest "crude/testutil/keeper"
+	"crude/testutil/nullify"
+	"crude/x/crude/keeper"
+	"crude/x/crude/types"
+)
+
+// createNResourceSchema registers n versions of the schema "product"
+func createNResourceSchema(keeper keeper.Keeper, ctx context.Context, n int) []types.ResourceSchema {
+	items := make([]types.ResourceSchema, n)
+	for i := range items {
+		items[i].Id = "product"
+		items[i].Version = uint64(i + 1)
+		items[i].Owner = "A"
+		items[i].Document = fmt.Sprintf(`{"maxProperties":%d}`, i)
+		if err := keeper.SetResourceSchema(ctx, items[i]); err != nil {
+			panic(err)
+		}
+	}
+	return items
+}
+
+func TestResourceSchemaQuerySingle(t *testing.T) {
+	keeper, ctx := keepertest.CrudeKeeper(t)
+	msgs := createNResourceSchema(keeper, ctx, 2)
+	tests := []struct {
+		desc     string
+		request  *types.QueryGetResourceSchemaRequest
+		response *types.QueryGetResourceSchemaResponse
+		err      error
+	}{
+		{
+			desc:     "First",
+			request:  &types.QueryGetResourceSchemaRequest{Id: "product", Version: 1},
+			response: &types.QueryGetResourceSchemaResponse{ResourceSchema: msgs[0]},
+		},
+		{
+			desc:     "Latest",
+			request:  &types.QueryGetResourceSchemaRequest{Id: "product"},
+			response: &types.QueryGetResourceSchemaResponse{ResourceSchema: msgs[1]},
+		},
+		{
+			desc:    "VersionNotFound",
+			request: &types.QueryGetResourceSchemaRequest{Id: "product", Version: 3},
+			err:     sdkerrors.ErrKeyNotFound,
+		},
+		{
+			desc:    "KeyNotFound",
+			request: &types.QueryGetResourceSchemaRequest{Id: "order"},
+			err:     sdkerrors.ErrKeyNotFound,
+		},
+		{
+			desc: "InvalidRequest",
+			err:  status.Error(codes.InvalidArgument, "invalid request"),
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			response, err := keeper.ResourceSchema(ctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t,
+					nullify.Fill(tc.response),
+					nullify.Fill(response),
+				)
+			}
+		})
+	}
+}
+
+func TestResourceSchemaQueryPaginated(t *testing.T) {
+	keeper, ctx := keepertest.CrudeKeeper(t)
+	msgs := createNResourceSchema(keeper, ctx, 5)
+
+	request := func(next []byte, offset, limit uint64, total bool) *types.QueryAllResourceSchemaRequest {
+		return &types.QueryAllResourceSchemaRequest{
+			Pagination: &query.PageRequest{
+				Key:        next,
+				Offset:     offset,
+				Limit:      limit,
+				CountTotal: total,
+			},
+		}
+	}
+	t.Run("ByOffset", func(t *testing.T) {
+		step := 2
+		for i := 0; i < len(msgs); i += step {
+			resp, err := keeper.ResourceSchemaAll(ctx, request(nil, uint64(i), uint64(step), false))
+			require.NoError(t, err)
+			require.LessOrEqual(t, len(resp.ResourceSchema), step)
+			require.Subset(t,
+				nullify.Fill(msgs),
+				nullify.Fill(resp.ResourceSchema),
+			)
+		}
+	})
+	t.Run("ByKey", func(t *testing.T) {
+		step := 2
+		var next []byte
+		for i := 0; i < len(msgs); i += step {
+			resp, err := keeper.ResourceSchemaAll(ctx, request(next, 0, uint64(step), false))
+			require.NoError(t, err)
+			require.LessOrEqual(t, len(resp.ResourceSchema), step)
+			require.Subset(t,
+				nullify.Fill(msgs),
+				nullify.Fill(resp.ResourceSchema),
+			)
+			next = resp.Pagination.NextKey
+		}
+	})
+	t.Run("Total", func(t *testing.T) {
+		resp, err := keeper.ResourceSchemaAll(ctx, request(nil, 0, 0, true))
+		require.NoError(t, err)
+		require.Equal(t, len(msgs), int(resp.Pagination.Total))
+		require.ElementsMatch(t,
+			nullify.Fill(msgs),
+			nullify.Fill(resp.ResourceSchema),
+		)
+	})
+	t.Run("InvalidRequest", func(t *testing.T) {
+		_, err := keeper.ResourceSchemaAll(ctx, nil)
+		require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
+	})
+}
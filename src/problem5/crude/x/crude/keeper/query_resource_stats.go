@@ -0,0 +1,46 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"crude/x/crude/types"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// defaultTopCreators is the number of creators ranked when none is requested
+	defaultTopCreators = 10
+
+	// maxTopCreators caps the number of creators ranked by a query
+	maxTopCreators = 100
+)
+
+func (k Keeper) ResourceStats(ctx context.Context, req *types.QueryResourceStatsRequest) (*types.QueryResourceStatsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	top := int(req.Top)
+	if top == 0 {
+		top = defaultTopCreators
+	}
+	if top > maxTopCreators {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("cannot rank more than %d creators", maxTopCreators))
+	}
+
+	totals := k.GetResourceTotals(ctx)
+	min, max, _ := k.GetResourceValueRange(ctx)
+
+	return &types.QueryResourceStatsResponse{
+		LiveCount:    totals.LiveCount,
+		CreatorCount: totals.CreatorCount,
+		ValueCount:   totals.ValueCount,
+		ValueSum:     totals.ValueSum,
+		ValueMin:     min,
+		ValueMax:     max,
+		TopCreators:  k.GetTopCreators(ctx, top),
+	}, nil
+}
@@ -0,0 +1,86 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	keepertest "crude/testutil/keeper"
+	"crude/x/crude/keeper"
+	"crude/x/crude/types"
+)
+
+func TestResourceStatsQuery(t *testing.T) {
+	k, ctx := keepertest.CrudeKeeper(t)
+	srv := keeper.NewMsgServerImpl(k)
+
+	resp, err := k.ResourceStats(ctx, &types.QueryResourceStatsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, &types.QueryResourceStatsResponse{ValueSum: math.ZeroInt()}, resp)
+
+	for _, msg := range []*types.MsgCreateResource{
+		{Creator: "A", Name: "a", Payload: types.NewUint64Payload(5)},
+		{Creator: "B", Name: "b", Payload: types.NewUint64Payload(30)},
+		{Creator: "C", Name: "c", Payload: types.NewUint64Payload(30)},
+		{Creator: "B", Name: "d", Payload: types.NewStringPayload("x")},
+		{Creator: "C", Name: "e", Payload: types.NewUint64Payload(2)},
+		{Creator: "C", Name: "f"},
+	} {
+		_, err := srv.CreateResource(ctx, msg)
+		require.NoError(t, err)
+	}
+
+	resp, err = k.ResourceStats(ctx, &types.QueryResourceStatsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, &types.QueryResourceStatsResponse{
+		LiveCount:    6,
+		CreatorCount: 3,
+		ValueCount:   5,
+		ValueSum:     math.NewInt(67),
+		ValueMin:     0,
+		ValueMax:     30,
+		TopCreators: []types.CreatorResourceCount{
+			{Creator: "C", Count: 3},
+			{Creator: "B", Count: 2},
+			{Creator: "A", Count: 1},
+		},
+	}, resp)
+
+	t.Run("Incremental", func(t *testing.T) {
+		// Moving away from the extremes and deleting update every aggregate
+		_, err := srv.UpdateResource(ctx, &types.MsgUpdateResource{Creator: "C", Id: 5, Name: "f", Payload: types.NewUint64Payload(3)})
+		require.NoError(t, err)
+		_, err = srv.UpdateResource(ctx, &types.MsgUpdateResource{Creator: "B", Id: 1, Name: "b", Payload: types.NewStringPayload("y")})
+		require.NoError(t, err)
+		_, err = srv.DeleteResource(ctx, &types.MsgDeleteResource{Creator: "C", Id: 2})
+		require.NoError(t, err)
+		_, err = srv.DeleteResource(ctx, &types.MsgDeleteResource{Creator: "A", Id: 0})
+		require.NoError(t, err)
+
+		resp, err := k.ResourceStats(ctx, &types.QueryResourceStatsRequest{Top: 1})
+		require.NoError(t, err)
+		require.Equal(t, &types.QueryResourceStatsResponse{
+			LiveCount:    4,
+			CreatorCount: 2,
+			ValueCount:   2,
+			ValueSum:     math.NewInt(5),
+			ValueMin:     2,
+			ValueMax:     3,
+			TopCreators:  []types.CreatorResourceCount{{Creator: "B", Count: 2}},
+		}, resp)
+
+		msg, broken := keeper.ResourceStatsInvariant(k)(ctx)
+		require.False(t, broken, msg)
+	})
+	t.Run("TooManyCreators", func(t *testing.T) {
+		_, err := k.ResourceStats(ctx, &types.QueryResourceStatsRequest{Top: 101})
+		require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "cannot rank more than 100 creators"))
+	})
+	t.Run("InvalidRequest", func(t *testing.T) {
+		_, err := k.ResourceStats(ctx, nil)
+		require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
+	})
+}
@@ -113,3 +113,134 @@ func TestResourceQueryPaginated(t *testing.T) {
 		require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
 	})
 }
+
+func TestResourceQueryFiltered(t *testing.T) {
+	keeper, ctx := keepertest.CrudeKeeper(t)
+	items := []types.Resource{
+		{Creator: "A", Name: "apple", Payload: types.NewUint64Payload(5)},
+		{Creator: "B", Name: "apricot", Payload: types.NewUint64Payload(15)},
+		{Creator: "A", Name: "banana", Payload: types.NewUint64Payload(25)},
+		{Creator: "A", Name: "avocado", Payload: types.NewStringPayload("ripe")},
+		{Creator: "B", Name: "blueberry", Payload: types.NewUint64Payload(10), Namespace: "acme"},
+		{Creator: "A", Name: "almond", Payload: types.NewUint64Payload(12), Namespace: "acme"},
+	}
+	for i := range items {
+		id, err := keeper.AppendResource(ctx, items[i])
+		require.NoError(t, err)
+		items[i].Id = id
+	}
+	ids := func(resources []types.Resource) []uint64 {
+		var ids []uint64
+		for _, resource := range resources {
+			ids = append(ids, resource.Id)
+		}
+		return ids
+	}
+
+	for _, tc := range []struct {
+		desc    string
+		request *types.QueryAllResourceRequest
+		ids     []uint64
+		count   uint64
+		err     error
+	}{
+		{
+			desc:    "Creator",
+			request: &types.QueryAllResourceRequest{Creator: "A"},
+			ids:     []uint64{0, 2, 3, 5},
+		},
+		{
+			desc:    "NamePrefix",
+			request: &types.QueryAllResourceRequest{NamePrefix: "a"},
+			ids:     []uint64{5, 0, 1, 3},
+		},
+		{
+			desc:    "ValueRange",
+			request: &types.QueryAllResourceRequest{ValueRange: &types.ResourceValueBounds{Min: 10, Max: 20}},
+			ids:     []uint64{1, 4, 5},
+		},
+		{
+			desc: "Combined",
+			request: &types.QueryAllResourceRequest{
+				Creator:    "A",
+				NamePrefix: "a",
+				ValueRange: &types.ResourceValueBounds{Min: 0, Max: 20},
+			},
+			ids: []uint64{0, 5},
+		},
+		{
+			desc:    "NamespaceAndCreator",
+			request: &types.QueryAllResourceRequest{Namespace: "acme", Creator: "B"},
+			ids:     []uint64{4},
+		},
+		{
+			desc:    "Reverse",
+			request: &types.QueryAllResourceRequest{Creator: "A", Reverse: true},
+			ids:     []uint64{5, 3, 2, 0},
+		},
+		{
+			desc:    "ReverseNamePrefix",
+			request: &types.QueryAllResourceRequest{NamePrefix: "a", Reverse: true},
+			ids:     []uint64{3, 1, 0, 5},
+		},
+		{
+			desc: "ReverseLimit",
+			request: &types.QueryAllResourceRequest{
+				Pagination: &query.PageRequest{Limit: 2},
+				Reverse:    true,
+			},
+			ids: []uint64{5, 4},
+		},
+		{
+			desc:    "CountOnly",
+			request: &types.QueryAllResourceRequest{NamePrefix: "a", ValueRange: &types.ResourceValueBounds{Min: 10, Max: 20}, CountOnly: true},
+			count:   2,
+		},
+		{
+			desc:    "CountOnlyCreator",
+			request: &types.QueryAllResourceRequest{Creator: "A", CountOnly: true},
+			count:   4,
+		},
+		{
+			desc:    "CountOnlyNamespaceAndCreator",
+			request: &types.QueryAllResourceRequest{Namespace: "acme", Creator: "B", CountOnly: true},
+			count:   1,
+		},
+		{
+			desc:    "CountOnlyNamePrefix",
+			request: &types.QueryAllResourceRequest{NamePrefix: "ap", CountOnly: true},
+			count:   2,
+		},
+		{
+			desc:    "CountOnlyValueRange",
+			request: &types.QueryAllResourceRequest{ValueRange: &types.ResourceValueBounds{Min: 10, Max: 25}, CountOnly: true},
+			count:   4,
+		},
+		{
+			desc:    "CountOnlyIgnoresPagination",
+			request: &types.QueryAllResourceRequest{Pagination: &query.PageRequest{Limit: 1}, CountOnly: true},
+			count:   uint64(len(items)),
+		},
+		{
+			desc:    "InvalidValueRange",
+			request: &types.QueryAllResourceRequest{ValueRange: &types.ResourceValueBounds{Min: 20, Max: 10}},
+			err:     status.Error(codes.InvalidArgument, "min value 20 is greater than max value 10"),
+		},
+		{
+			desc:    "NULNamePrefix",
+			request: &types.QueryAllResourceRequest{NamePrefix: "ap\x00", CountOnly: true},
+			err:     status.Error(codes.InvalidArgument, "name prefix contains a NUL byte"),
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			resp, err := keeper.ResourceAll(ctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.ids, ids(resp.Resource))
+			require.Equal(t, tc.count, resp.Count)
+		})
+	}
+}
@@ -0,0 +1,41 @@
+package keeper
+
+import (
+	"context"
+
+	"crude/x/crude/types"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) ResourceTransferOfferAll(ctx context.Context, req *types.QueryAllResourceTransferOfferRequest) (*types.QueryAllResourceTransferOfferResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	offers, pageRes, err := query.CollectionPaginate(ctx, k.transferOffers, req.Pagination, func(_ uint64, offer types.ResourceTransferOffer) (types.ResourceTransferOffer, error) {
+		return offer, nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllResourceTransferOfferResponse{ResourceTransferOffer: offers, Pagination: pageRes}, nil
+}
+
+func (k Keeper) ResourceTransferOffer(ctx context.Context, req *types.QueryGetResourceTransferOfferRequest) (*types.QueryGetResourceTransferOfferResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	offer, found := k.GetResourceTransferOffer(ctx, req.ResourceId)
+	if !found {
+		return nil, sdkerrors.ErrKeyNotFound
+	}
+
+	return &types.QueryGetResourceTransferOfferResponse{ResourceTransferOffer: offer}, nil
+}
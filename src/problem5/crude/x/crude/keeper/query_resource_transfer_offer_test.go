@@ -0,0 +1,130 @@
+package keeper_test
+
+import (
+	"context"
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	keepertest "crude/testutil/keeper"
+	"crude/testutil/nullify"
+	"crude/x/crude/keeper"
+	"crude/x/crude/types"
+)
+
+func createNResourceTransferOffer(keeper keeper.Keeper, ctx context.Context, n int) []types.ResourceTransferOffer {
+	items := make([]types.ResourceTransferOffer, n)
+	for i := range items {
+		items[i].ResourceId = uint64(i)
+		items[i].Owner = "A"
+		items[i].Recipient = "B"
+		if err := keeper.SetResourceTransferOffer(ctx, items[i]); err != nil {
+			panic(err)
+		}
+	}
+	return items
+}
+
+func TestResourceTransferOfferQuerySingle(t *testing.T) {
+	keeper, ctx := keepertest.CrudeKeeper(t)
+	msgs := createNResourceTransferOffer(keeper, ctx, 2)
+	tests := []struct {
+		desc     string
+		request  *types.QueryGetResourceTransferOfferRequest
+		response *types.QueryGetResourceTransferOfferResponse
+		err      error
+	}{
+		{
+			desc:     "First",
+			request:  &types.QueryGetResourceTransferOfferRequest{ResourceId: msgs[0].ResourceId},
+			response: &types.QueryGetResourceTransferOfferResponse{ResourceTransferOffer: msgs[0]},
+		},
+		{
+			desc:     "Second",
+			request:  &types.QueryGetResourceTransferOfferRequest{ResourceId: msgs[1].ResourceId},
+			response: &types.QueryGetResourceTransferOfferResponse{ResourceTransferOffer: msgs[1]},
+		},
+		{
+			desc:    "KeyNotFound",
+			request: &types.QueryGetResourceTransferOfferRequest{ResourceId: uint64(len(msgs))},
+			err:     sdkerrors.ErrKeyNotFound,
+		},
+		{
+			desc: "InvalidRequest",
+			err:  status.Error(codes.InvalidArgument, "invalid request"),
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			response, err := keeper.ResourceTransferOffer(ctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t,
+					nullify.Fill(tc.response),
+					nullify.Fill(response),
+				)
+			}
+		})
+	}
+}
+
+func TestResourceTransferOfferQueryPaginated(t *testing.T) {
+	keeper, ctx := keepertest.CrudeKeeper(t)
+	msgs := createNResourceTransferOffer(keeper, ctx, 5)
+
+	request := func(next []byte, offset, limit uint64, total bool) *types.QueryAllResourceTransferOfferRequest {
+		return &types.QueryAllResourceTransferOfferRequest{
+			Pagination: &query.PageRequest{
+				Key:        next,
+				Offset:     offset,
+				Limit:      limit,
+				CountTotal: total,
+			},
+		}
+	}
+	t.Run("ByOffset", func(t *testing.T) {
+		step := 2
+		for i := 0; i < len(msgs); i += step {
+			resp, err := keeper.ResourceTransferOfferAll(ctx, request(nil, uint64(i), uint64(step), false))
+			require.NoError(t, err)
+			require.LessOrEqual(t, len(resp.ResourceTransferOffer), step)
+			require.Subset(t,
+				nullify.Fill(msgs),
+				nullify.Fill(resp.ResourceTransferOffer),
+			)
+		}
+	})
+	t.Run("ByKey", func(t *testing.T) {
+		step := 2
+		var next []byte
+		for i := 0; i < len(msgs); i += step {
+			resp, err := keeper.ResourceTransferOfferAll(ctx, request(next, 0, uint64(step), false))
+			require.NoError(t, err)
+			require.LessOrEqual(t, len(resp.ResourceTransferOffer), step)
+			require.Subset(t,
+				nullify.Fill(msgs),
+				nullify.Fill(resp.ResourceTransferOffer),
+			)
+			next = resp.Pagination.NextKey
+		}
+	})
+	t.Run("Total", func(t *testing.T) {
+		resp, err := keeper.ResourceTransferOfferAll(ctx, request(nil, 0, 0, true))
+		require.NoError(t, err)
+		require.Equal(t, len(msgs), int(resp.Pagination.Total))
+		require.ElementsMatch(t,
+			nullify.Fill(msgs),
+			nullify.Fill(resp.ResourceTransferOffer),
+		)
+	})
+	t.Run("InvalidRequest", func(t *testing.T) {
+		_, err := keeper.ResourceTransferOfferAll(ctx, nil)
+		require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
+	})
+}
@@ -0,0 +1,56 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"crude/x/crude/types"
+
+	"cosmossdk.io/collections"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) ResourceWithProof(ctx context.Context, req *types.QueryResourceWithProofRequest) (*types.QueryResourceWithProofResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if k.storeQuerier.Queryable == nil {
+		return nil, status.Error(codes.Unavailable, "resource proofs are not available on this node")
+	}
+
+	key, err := collections.EncodeKeyWithPrefix(types.ResourceKey, collections.Uint64Key, req.Id)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	// Proofs come from the committed IAVL tree at the queried height, which
+	// the context of a query only reads through a cache
+	res, err := k.storeQuerier.Query(&storetypes.RequestQuery{
+		Path:   fmt.Sprintf("/%s/key", types.StoreKey),
+		Data:   key,
+		Height: sdk.UnwrapSDKContext(ctx).BlockHeight(),
+		Prove:  true,
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	resp := &types.QueryResourceWithProofResponse{
+		Key:    key,
+		Value:  res.Value,
+		Proof:  res.ProofOps,
+		Height: res.Height,
+	}
+	if len(res.Value) > 0 {
+		var resource types.Resource
+		if err := k.cdc.Unmarshal(res.Value, &resource); err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		resp.Resource = &resource
+	}
+
+	return resp, nil
+}
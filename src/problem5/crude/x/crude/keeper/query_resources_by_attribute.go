@@ -0,0 +1,45 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"crude/x/crude/types"
+
+	"cosmossdk.io/collections"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) ResourcesByAttribute(ctx context.Context, req *types.QueryResourcesByAttributeRequest) (*types.QueryResourcesByAttributeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if err := (types.ResourceAttribute{Key: req.Key, Value: req.Value}).Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	resources, pageRes, err := query.CollectionPaginate(
+		ctx,
+		k.resourcesByAttribute,
+		req.Pagination,
+		func(key collections.Triple[string, string, uint64], _ collections.NoValue) (types.Resource, error) {
+			resource, found := k.GetResource(ctx, key.K3())
+			if !found {
+				return resource, fmt.Errorf("resource %d indexed for attribute %s=%s not found", key.K3(), req.Key, req.Value)
+			}
+			return resource, nil
+		},
+		func(o *query.CollectionsPaginateOptions[collections.Triple[string, string, uint64]]) {
+			prefix := collections.TripleSuperPrefix[string, string, uint64](req.Key, req.Value)
+			o.Prefix = &prefix
+		},
+	)
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryResourcesByAttributeResponse{Resource: resources, Pagination: pageRes}, nil
+}
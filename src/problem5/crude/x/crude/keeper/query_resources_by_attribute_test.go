@@ -0,0 +1,88 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	keepertest "crude/testutil/keeper"
+	"crude/testutil/nullify"
+	"crude/x/crude/types"
+)
+
+func TestResourcesByAttributeQueryPaginated(t *testing.T) {
+	keeper, ctx := keepertest.CrudeKeeper(t)
+	var msgs []types.Resource
+	for i := 0; i < 10; i++ {
+		colour := []string{"red", "redder"}[i%2]
+		item := types.Resource{Attributes: []types.ResourceAttribute{{Key: "colour", Value: colour}}}
+		id, err := keeper.AppendResource(ctx, item)
+		require.NoError(t, err)
+		item.Id = id
+		if colour == "red" {
+			msgs = append(msgs, item)
+		}
+	}
+
+	request := func(next []byte, offset, limit uint64, total bool) *types.QueryResourcesByAttributeRequest {
+		return &types.QueryResourcesByAttributeRequest{
+			Key:   "colour",
+			Value: "red",
+			Pagination: &query.PageRequest{
+				Key:        next,
+				Offset:     offset,
+				Limit:      limit,
+				CountTotal: total,
+			},
+		}
+	}
+	t.Run("ByOffset", func(t *testing.T) {
+		step := 2
+		for i := 0; i < len(msgs); i += step {
+			resp, err := keeper.ResourcesByAttribute(ctx, request(nil, uint64(i), uint64(step), false))
+			require.NoError(t, err)
+			require.LessOrEqual(t, len(resp.Resource), step)
+			require.Subset(t,
+				nullify.Fill(msgs),
+				nullify.Fill(resp.Resource),
+			)
+		}
+	})
+	t.Run("ByKey", func(t *testing.T) {
+		step := 2
+		var next []byte
+		for i := 0; i < len(msgs); i += step {
+			resp, err := keeper.ResourcesByAttribute(ctx, request(next, 0, uint64(step), false))
+			require.NoError(t, err)
+			require.LessOrEqual(t, len(resp.Resource), step)
+			require.Subset(t,
+				nullify.Fill(msgs),
+				nullify.Fill(resp.Resource),
+			)
+			next = resp.Pagination.NextKey
+		}
+	})
+	t.Run("Total", func(t *testing.T) {
+		resp, err := keeper.ResourcesByAttribute(ctx, request(nil, 0, 0, true))
+		require.NoError(t, err)
+		require.Equal(t, len(msgs), int(resp.Pagination.Total))
+		require.ElementsMatch(t,
+			nullify.Fill(msgs),
+			nullify.Fill(resp.Resource),
+		)
+	})
+	t.Run("UnknownAttribute", func(t *testing.T) {
+		resp, err := keeper.ResourcesByAttribute(ctx, &types.QueryResourcesByAttributeRequest{Key: "colour", Value: "blue"})
+		require.NoError(t, err)
+		require.Empty(t, resp.Resource)
+	})
+	t.Run("InvalidRequest", func(t *testing.T) {
+		_, err := keeper.ResourcesByAttribute(ctx, nil)
+		require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
+		_, err = keeper.ResourcesByAttribute(ctx, &types.QueryResourcesByAttributeRequest{Value: "red"})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
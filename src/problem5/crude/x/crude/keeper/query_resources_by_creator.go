@@ -0,0 +1,42 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"crude/x/crude/types"
+
+	"cosmossdk.io/collections"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) ResourcesByCreator(ctx context.Context, req *types.QueryResourcesByCreatorRequest) (*types.QueryResourcesByCreatorResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if req.Creator == "" {
+		return nil, status.Error(codes.InvalidArgument, "creator cannot be empty")
+	}
+
+	resources, pageRes, err := query.CollectionPaginate(
+		ctx,
+		k.resourcesByCreator,
+		req.Pagination,
+		func(key collections.Pair[string, uint64], _ collections.NoValue) (types.Resource, error) {
+			resource, found := k.GetResource(ctx, key.K2())
+			if !found {
+				return resource, fmt.Errorf("resource %d indexed for creator %s not found", key.K2(), req.Creator)
+			}
+			return resource, nil
+		},
+		query.WithCollectionPaginationPairPrefix[string, uint64](req.Creator),
+	)
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryResourcesByCreatorResponse{Resource: resources, Pagination: pageRes}, nil
+}
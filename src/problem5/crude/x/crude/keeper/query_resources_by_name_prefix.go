@@ -0,0 +1,91 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"crude/x/crude/types"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) ResourcesByNamePrefix(ctx context.Context, req *types.QueryResourcesByNamePrefixRequest) (*types.QueryResourcesByNamePrefixResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if req.Prefix == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty name prefix")
+	}
+	if err := types.ValidateNamePrefix(req.Prefix); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	resources, pageRes, err := k.filterResourcesByNamePrefix(ctx, req.Prefix, req.Pagination, nil)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryResourcesByNamePrefixResponse{Resource: resources, Pagination: pageRes}, nil
+}
+
+// filterResourcesByNamePrefix pages through the resources whose name starts
+// with namePrefix, in name order. A nil match keeps every resource.
+func (k Keeper) filterResourcesByNamePrefix(ctx context.Context, namePrefix string, pageReq *query.PageRequest, match func(types.Resource) bool) ([]types.Resource, *query.PageResponse, error) {
+	var resources []types.Resource
+	pageRes, err := query.FilteredPaginate(k.namePrefixStore(ctx, namePrefix), pageReq, func(key, _ []byte, accumulate bool) (bool, error) {
+		_, entry, err := nameIndexKeyCodec.Decode(append([]byte(namePrefix), key...))
+		if err != nil {
+			return false, err
+		}
+		resource, found := k.GetResource(ctx, entry.K2())
+		if !found {
+			return false, fmt.Errorf("resource %d indexed for name %q not found", entry.K2(), entry.K1())
+		}
+		if match != nil && !match(resource) {
+			return false, nil
+		}
+		if accumulate {
+			resources = append(resources, resource)
+		}
+		return true, nil
+	})
+
+	return resources, pageRes, err
+}
+
+// walkNamePrefix calls fn with the name index entries of the names starting
+// with namePrefix, in name order.
+func (k Keeper) walkNamePrefix(ctx context.Context, namePrefix string, fn func(entry collections.Pair[string, uint64]) error) error {
+	iterator := k.namePrefixStore(ctx, namePrefix).Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		_, entry, err := nameIndexKeyCodec.Decode(append([]byte(namePrefix), iterator.Key()...))
+		if err != nil {
+			return err
+		}
+		if err := fn(entry); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// nameIndexKeyCodec encodes the (name, id) keys of the name index
+var nameIndexKeyCodec = collections.PairKeyCodec(collections.StringKey, collections.Uint64Key)
+
+// namePrefixStore returns the name index entries of the names starting with
+// namePrefix, keyed by the rest of their key. Names are the leading part of
+// the name index keys, so these entries are exactly the keys starting with
+// the prefix. Collections only range over whole key parts, hence the raw store.
+func (k Keeper) namePrefixStore(ctx context.Context, namePrefix string) storetypes.KVStore {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	return prefix.NewStore(store, append(append([]byte{}, types.ResourceNameKey.Bytes()...), namePrefix...))
+}
@@ -0,0 +1,98 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	keepertest "crude/testutil/keeper"
+	"crude/testutil/nullify"
+	"crude/x/crude/types"
+)
+
+func TestResourcesByNamePrefixQueryPaginated(t *testing.T) {
+	keeper, ctx := keepertest.CrudeKeeper(t)
+	var msgs []types.Resource
+	for _, name := range []string{"item-3", "other", "item-1", "ite", "item-2", "item-1", "items"} {
+		item := types.Resource{Name: name}
+		id, err := keeper.AppendResource(ctx, item)
+		require.NoError(t, err)
+		item.Id = id
+		if len(name) >= 5 && name[:5] == "item-" {
+			msgs = append(msgs, item)
+		}
+	}
+
+	request := func(next []byte, offset, limit uint64, total bool) *types.QueryResourcesByNamePrefixRequest {
+		return &types.QueryResourcesByNamePrefixRequest{
+			Prefix: "item-",
+			Pagination: &query.PageRequest{
+				Key:        next,
+				Offset:     offset,
+				Limit:      limit,
+				CountTotal: total,
+			},
+		}
+	}
+	t.Run("ByOffset", func(t *testing.T) {
+		step := 2
+		for i := 0; i < len(msgs); i += step {
+			resp, err := keeper.ResourcesByNamePrefix(ctx, request(nil, uint64(i), uint64(step), false))
+			require.NoError(t, err)
+			require.LessOrEqual(t, len(resp.Resource), step)
+			require.Subset(t,
+				nullify.Fill(msgs),
+				nullify.Fill(resp.Resource),
+			)
+		}
+	})
+	t.Run("ByKey", func(t *testing.T) {
+		step := 2
+		var next []byte
+		for i := 0; i < len(msgs); i += step {
+			resp, err := keeper.ResourcesByNamePrefix(ctx, request(next, 0, uint64(step), false))
+			require.NoError(t, err)
+			require.LessOrEqual(t, len(resp.Resource), step)
+			require.Subset(t,
+				nullify.Fill(msgs),
+				nullify.Fill(resp.Resource),
+			)
+			next = resp.Pagination.NextKey
+		}
+	})
+	t.Run("Total", func(t *testing.T) {
+		resp, err := keeper.ResourcesByNamePrefix(ctx, request(nil, 0, 0, true))
+		require.NoError(t, err)
+		require.Equal(t, len(msgs), int(resp.Pagination.Total))
+		require.ElementsMatch(t,
+			nullify.Fill(msgs),
+			nullify.Fill(resp.Resource),
+		)
+	})
+	t.Run("NameOrder", func(t *testing.T) {
+		resp, err := keeper.ResourcesByNamePrefix(ctx, request(nil, 0, 0, false))
+		require.NoError(t, err)
+		var names []string
+		var ids []uint64
+		for _, resource := range resp.Resource {
+			names = append(names, resource.Name)
+			ids = append(ids, resource.Id)
+		}
+		require.Equal(t, []string{"item-1", "item-1", "item-2", "item-3"}, names)
+		require.Equal(t, []uint64{2, 5, 4, 0}, ids)
+	})
+	t.Run("InvalidRequest", func(t *testing.T) {
+		_, err := keeper.ResourcesByNamePrefix(ctx, nil)
+		require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
+		_, err = keeper.ResourcesByNamePrefix(ctx, &types.QueryResourcesByNamePrefixRequest{})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+	t.Run("NULPrefix", func(t *testing.T) {
+		// A NUL byte would reach past the name into the id part of the keys
+		_, err := keeper.ResourcesByNamePrefix(ctx, &types.QueryResourcesByNamePrefixRequest{Prefix: "item-1\x00"})
+		require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "name prefix contains a NUL byte"))
+	})
+}
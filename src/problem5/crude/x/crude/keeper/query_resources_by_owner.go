@@ -0,0 +1,42 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"crude/x/crude/types"
+
+	"cosmossdk.io/collections"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) ResourcesByOwner(ctx context.Context, req *types.QueryResourcesByOwnerRequest) (*types.QueryResourcesByOwnerResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if req.Owner == "" {
+		return nil, status.Error(codes.InvalidArgument, "owner cannot be empty")
+	}
+
+	resources, pageRes, err := query.CollectionPaginate(
+		ctx,
+		k.resourcesByOwner,
+		req.Pagination,
+		func(key collections.Pair[string, uint64], _ collections.NoValue) (types.Resource, error) {
+			resource, found := k.GetResource(ctx, key.K2())
+			if !found {
+				return resource, fmt.Errorf("resource %d indexed for owner %s not found", key.K2(), req.Owner)
+			}
+			return resource, nil
+		},
+		query.WithCollectionPaginationPairPrefix[string, uint64](req.Owner),
+	)
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryResourcesByOwnerResponse{Resource: resources, Pagination: pageRes}, nil
+}
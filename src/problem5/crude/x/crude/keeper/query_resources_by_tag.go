@@ -0,0 +1,42 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"crude/x/crude/types"
+
+	"cosmossdk.io/collections"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) ResourcesByTag(ctx context.Context, req *types.QueryResourcesByTagRequest) (*types.QueryResourcesByTagResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if err := types.ValidateTag(req.Tag); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	resources, pageRes, err := query.CollectionPaginate(
+		ctx,
+		k.resourcesByTag,
+		req.Pagination,
+		func(key collections.Pair[string, uint64], _ collections.NoValue) (types.Resource, error) {
+			resource, found := k.GetResource(ctx, key.K2())
+			if !found {
+				return resource, fmt.Errorf("resource %d indexed for tag %s not found", key.K2(), req.Tag)
+			}
+			return resource, nil
+		},
+		query.WithCollectionPaginationPairPrefix[string, uint64](req.Tag),
+	)
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryResourcesByTagResponse{Resource: resources, Pagination: pageRes}, nil
+}
@@ -0,0 +1,26 @@
+package keeper
+
+import (
+	"context"
+
+	"crude/x/crude/types"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) ResourcesInNamespace(ctx context.Context, req *types.QueryResourcesInNamespaceRequest) (*types.QueryResourcesInNamespaceResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if err := types.ValidateNamespaceName(req.Namespace); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	resources, pageRes, err := k.filterIndexedResources(ctx, "namespace", k.resourcesByNamespace, req.Namespace, req.Pagination, nil)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryResourcesInNamespaceResponse{Resource: resources, Pagination: pageRes}, nil
+}
@@ -2,111 +2,255 @@ package keeper
 
 import (
 	"context"
-	"encoding/binary"
+	"errors"
 
 	"crude/x/crude/types"
 
-	"cosmossdk.io/store/prefix"
-	storetypes "cosmossdk.io/store/types"
-	"github.com/cosmos/cosmos-sdk/runtime"
+	"cosmossdk.io/collections"
 )
 
 // GetResourceCount get the total number of resource
 func (k Keeper) GetResourceCount(ctx context.Context) uint64 {
-	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
-	store := prefix.NewStore(storeAdapter, []byte{})
-	byteKey := types.KeyPrefix(types.ResourceCountKey)
-	bz := store.Get(byteKey)
-
-	// Count doesn't exist: no element
-	if bz == nil {
-		return 0
+	count, err := k.resourceCount.Peek(ctx)
+	if err != nil {
+		panic(err)
 	}
 
-	// Parse bytes
-	return binary.BigEndian.Uint64(bz)
+	return count
 }
 
 // SetResourceCount set the total number of resource
-func (k Keeper) SetResourceCount(ctx context.Context, count uint64) {
-	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
-	store := prefix.NewStore(storeAdapter, []byte{})
-	byteKey := types.KeyPrefix(types.ResourceCountKey)
-	bz := make([]byte, 8)
-	binary.BigEndian.PutUint64(bz, count)
-	store.Set(byteKey, bz)
+func (k Keeper) SetResourceCount(ctx context.Context, count uint64) error {
+	return k.resourceCount.Set(ctx, count)
 }
 
 // AppendResource appends a resource in the store with a new id and update the count
 func (k Keeper) AppendResource(
 	ctx context.Context,
 	resource types.Resource,
-) uint64 {
-	// Create the resource
-	count := k.GetResourceCount(ctx)
+) (uint64, error) {
+	// Create the resource and update the resource count
+	id, err := k.resourceCount.Next(ctx)
+	if err != nil {
+		return 0, err
+	}
 
 	// Set the ID of the appended value
-	resource.Id = count
-
-	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
-	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.ResourceKey))
-	appendedValue := k.cdc.MustMarshal(&resource)
-	store.Set(GetResourceIDBytes(resource.Id), appendedValue)
+	resource.Id = id
 
-	// Update resource count
-	k.SetResourceCount(ctx, count+1)
+	if err := k.SetResource(ctx, resource); err != nil {
+		return 0, err
+	}
 
-	return count
+	return id, nil
 }
 
 // SetResource set a specific resource in the store
-func (k Keeper) SetResource(ctx context.Context, resource types.Resource) {
-	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
-	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.ResourceKey))
-	b := k.cdc.MustMarshal(&resource)
-	store.Set(GetResourceIDBytes(resource.Id), b)
+func (k Keeper) SetResource(ctx context.Context, resource types.Resource) error {
+	var previous *types.Resource
+	// Drop the index entries that no longer match the resource
+	if old, found := k.GetResource(ctx, resource.Id); found {
+		previous = &old
+		if old.Creator != resource.Creator {
+			if err := k.resourcesByCreator.Remove(ctx, collections.Join(old.Creator, old.Id)); err != nil {
+				return err
+			}
+		}
+		if old.Owner != resource.Owner {
+			if err := k.resourcesByOwner.Remove(ctx, collections.Join(old.Owner, old.Id)); err != nil {
+				return err
+			}
+		}
+		if old.Name != resource.Name {
+			if err := k.resourcesByName.Remove(ctx, collections.Join(old.Name, old.Id)); err != nil {
+				return err
+			}
+		}
+		if old.Namespace != resource.Namespace {
+			if err := k.removeFromNamespaceIndex(ctx, old); err != nil {
+				return err
+			}
+		}
+		if err := k.removeFromExpiryQueues(ctx, old); err != nil {
+			return err
+		}
+		if err := k.removeFromAttributeIndex(ctx, old); err != nil {
+			return err
+		}
+		if err := k.removeFromTagIndex(ctx, old); err != nil {
+			return err
+		}
+	}
+
+	if err := k.updateResourceStats(ctx, previous, &resource); err != nil {
+		return err
+	}
+
+	if err := k.resources.Set(ctx, resource.Id, resource); err != nil {
+		return err
+	}
+
+	return k.insertIntoIndexes(ctx, resource)
+}
+
+// insertIntoIndexes adds the entries of a resource to the secondary indexes
+func (k Keeper) insertIntoIndexes(ctx context.Context, resource types.Resource) error {
+	if err := k.insertIntoAttributeIndex(ctx, resource); err != nil {
+		return err
+	}
+	if err := k.insertIntoTagIndex(ctx, resource); err != nil {
+		return err
+	}
+	if err := k.insertIntoNamespaceIndex(ctx, resource); err != nil {
+		return err
+	}
+	if err := k.resourcesByName.Set(ctx, collections.Join(resource.Name, resource.Id)); err != nil {
+		return err
+	}
+	if err := k.resourcesByCreator.Set(ctx, collections.Join(resource.Creator, resource.Id)); err != nil {
+		return err
+	}
+	if err := k.insertIntoExpiryQueues(ctx, resource); err != nil {
+		return err
+	}
+
+	return k.resourcesByOwner.Set(ctx, collections.Join(resource.Owner, resource.Id))
+}
+
+// RebuildResourceIndexes clears the secondary indexes of the resources and
+// rebuilds them from the stored resources, for the store migrations, which
+// rewrite the resources without maintaining them.
+func (k Keeper) RebuildResourceIndexes(ctx context.Context) error {
+	if err := k.resourcesByCreator.Clear(ctx, nil); err != nil {
+		return err
+	}
+	if err := k.resourcesByOwner.Clear(ctx, nil); err != nil {
+		return err
+	}
+	if err := k.resourcesByName.Clear(ctx, nil); err != nil {
+		return err
+	}
+	if err := k.resourcesByNamespace.Clear(ctx, nil); err != nil {
+		return err
+	}
+	if err := k.resourcesByAttribute.Clear(ctx, nil); err != nil {
+		return err
+	}
+	if err := k.resourcesByTag.Clear(ctx, nil); err != nil {
+		return err
+	}
+	if err := k.expiryHeightQueue.Clear(ctx, nil); err != nil {
+		return err
+	}
+	if err := k.expiryTimeQueue.Clear(ctx, nil); err != nil {
+		return err
+	}
+
+	for _, resource := range k.GetAllResource(ctx) {
+		if err := k.insertIntoIndexes(ctx, resource); err != nil {
+			return err
+		}
+	}
+
+	return nil
 }
 
 // GetResource returns a resource from its id
 func (k Keeper) GetResource(ctx context.Context, id uint64) (val types.Resource, found bool) {
-	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
-	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.ResourceKey))
-	b := store.Get(GetResourceIDBytes(id))
-	if b == nil {
+	val, err := k.resources.Get(ctx, id)
+	if errors.Is(err, collections.ErrNotFound) {
 		return val, false
 	}
-	k.cdc.MustUnmarshal(b, &val)
+	if err != nil {
+		panic(err)
+	}
+
 	return val, true
 }
 
 // RemoveResource removes a resource from the store
-func (k Keeper) RemoveResource(ctx context.Context, id uint64) {
-	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
-	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.ResourceKey))
-	store.Delete(GetResourceIDBytes(id))
+func (k Keeper) RemoveResource(ctx context.Context, id uint64) error {
+	old, found := k.GetResource(ctx, id)
+	if !found {
+		return nil
+	}
+	if err := k.resourcesByCreator.Remove(ctx, collections.Join(old.Creator, id)); err != nil {
+		return err
+	}
+	if err := k.resourcesByOwner.Remove(ctx, collections.Join(old.Owner, id)); err != nil {
+		return err
+	}
+	if err := k.removeFromExpiryQueues(ctx, old); err != nil {
+		return err
+	}
+	if err := k.removeFromAttributeIndex(ctx, old); err != nil {
+		return err
+	}
+	if err := k.removeFromTagIndex(ctx, old); err != nil {
+		return err
+	}
+	if err := k.removeFromNamespaceIndex(ctx, old); err != nil {
+		return err
+	}
+	if err := k.resourcesByName.Remove(ctx, collections.Join(old.Name, id)); err != nil {
+		return err
+	}
+	if err := k.updateResourceStats(ctx, &old, nil); err != nil {
+		return err
+	}
+	// A pending transfer cannot outlive its resource
+	if err := k.transferOffers.Remove(ctx, id); err != nil {
+		return err
+	}
+	// Neither can its access control list
+	if err := k.RemoveResourceRoleGrants(ctx, id); err != nil {
+		return err
+	}
+	// Nor its replications, the deletion being forwarded beforehand
+	if err := k.RemoveResourceReplications(ctx, id); err != nil {
+		return err
+	}
+
+	return k.resources.Remove(ctx, id)
 }
 
 // GetAllResource returns all resource
 func (k Keeper) GetAllResource(ctx context.Context) (list []types.Resource) {
-	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
-	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.ResourceKey))
-	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+	err := k.resources.Walk(ctx, nil, func(_ uint64, val types.Resource) (bool, error) {
+		list = append(list, val)
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
 
-	defer iterator.Close()
+	return
+}
 
-	for ; iterator.Valid(); iterator.Next() {
-		var val types.Resource
-		k.cdc.MustUnmarshal(iterator.Value(), &val)
-		list = append(list, val)
+// GetResourceIDsByCreator returns the ids of all resource created by an address
+func (k Keeper) GetResourceIDsByCreator(ctx context.Context, creator string) (ids []uint64) {
+	rng := collections.NewPrefixedPairRange[string, uint64](creator)
+	err := k.resourcesByCreator.Walk(ctx, rng, func(key collections.Pair[string, uint64]) (bool, error) {
+		ids = append(ids, key.K2())
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
 	}
 
 	return
 }
 
-// GetResourceIDBytes returns the byte representation of the ID
-func GetResourceIDBytes(id uint64) []byte {
-	bz := types.KeyPrefix(types.ResourceKey)
-	bz = append(bz, []byte("/")...)
-	bz = binary.BigEndian.AppendUint64(bz, id)
-	return bz
+// GetResourceIDsByOwner returns the ids of all resource currently owned by an address
+func (k Keeper) GetResourceIDsByOwner(ctx context.Context, owner string) (ids []uint64) {
+	rng := collections.NewPrefixedPairRange[string, uint64](owner)
+	err := k.resourcesByOwner.Walk(ctx, rng, func(key collections.Pair[string, uint64]) (bool, error) {
+		ids = append(ids, key.K2())
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	return
 }
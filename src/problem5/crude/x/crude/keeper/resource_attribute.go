@@ -0,0 +1,46 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+
+	"crude/x/crude/types"
+)
+
+// GetResourceIDsByAttribute returns the ids of the resources carrying an
+// attribute key/value pair
+func (k Keeper) GetResourceIDsByAttribute(ctx context.Context, key, value string) (ids []uint64) {
+	rng := collections.NewSuperPrefixedTripleRange[string, string, uint64](key, value)
+	err := k.resourcesByAttribute.Walk(ctx, rng, func(key collections.Triple[string, string, uint64]) (bool, error) {
+		ids = append(ids, key.K3())
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	return
+}
+
+// insertIntoAttributeIndex indexes every attribute of the resource
+func (k Keeper) insertIntoAttributeIndex(ctx context.Context, resource types.Resource) error {
+	for _, attribute := range resource.Attributes {
+		if err := k.resourcesByAttribute.Set(ctx, collections.Join3(attribute.Key, attribute.Value, resource.Id)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// removeFromAttributeIndex drops every attribute of the resource from the index
+func (k Keeper) removeFromAttributeIndex(ctx context.Context, resource types.Resource) error {
+	for _, attribute := range resource.Attributes {
+		if err := k.resourcesByAttribute.Remove(ctx, collections.Join3(attribute.Key, attribute.Value, resource.Id)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,111 @@
+package keeper
+
+import (
+	"context"
+	"time"
+
+	"crude/x/crude/types"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// PruneExpiredResources removes the resources whose expiry height or time has
+// been reached, oldest first, up to the MaxPrunedPerBlock param. Deposits are
+// refunded to the owners and an event is emitted for each pruned resource.
+// Delete hooks are run but cannot veto the removal.
+func (k Keeper) PruneExpiredResources(ctx context.Context) error {
+	limit := k.GetParams(ctx).MaxPrunedPerBlock
+	if limit == 0 {
+		return nil
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	ids, err := k.GetExpiredResourceIDs(ctx, sdkCtx.BlockHeight(), sdkCtx.BlockTime(), limit)
+	if err != nil {
+		return err
+	}
+
+	for _, id := range ids {
+		resource, found := k.GetResource(ctx, id)
+		if !found {
+			continue
+		}
+		k.runPruneHook(ctx, resource, k.Hooks().BeforeResourceDeleted)
+		if err := k.RefundResourceDeposit(ctx, resource); err != nil {
+			return err
+		}
+		if err := k.RemoveResource(ctx, id); err != nil {
+			return err
+		}
+		k.runPruneHook(ctx, resource, k.Hooks().AfterResourceDeleted)
+
+		if err := sdkCtx.EventManager().EmitTypedEvent(&types.EventResourceExpired{
+			Id:    id,
+			Owner: resource.Owner,
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// GetExpiredResourceIDs returns up to limit ids of resources expired at the
+// given height or time. Only the due part of each queue is visited.
+func (k Keeper) GetExpiredResourceIDs(ctx context.Context, height int64, blockTime time.Time, limit uint64) ([]uint64, error) {
+	var ids []uint64
+	seen := make(map[uint64]bool)
+	collect := func(id uint64) bool {
+		if !seen[id] {
+			seen[id] = true
+			ids = append(ids, id)
+		}
+		return uint64(len(ids)) >= limit
+	}
+
+	err := k.expiryHeightQueue.Walk(ctx, nil, func(key collections.Pair[int64, uint64]) (bool, error) {
+		if key.K1() > height {
+			return true, nil
+		}
+		return collect(key.K2()), nil
+	})
+	if err != nil || uint64(len(ids)) >= limit {
+		return ids, err
+	}
+
+	err = k.expiryTimeQueue.Walk(ctx, nil, func(key collections.Pair[time.Time, uint64]) (bool, error) {
+		if key.K1().After(blockTime) {
+			return true, nil
+		}
+		return collect(key.K2()), nil
+	})
+
+	return ids, err
+}
+
+func (k Keeper) insertIntoExpiryQueues(ctx context.Context, resource types.Resource) error {
+	if resource.ExpiryHeight > 0 {
+		if err := k.expiryHeightQueue.Set(ctx, collections.Join(resource.ExpiryHeight, resource.Id)); err != nil {
+			return err
+		}
+	}
+	if resource.ExpiryTime != nil {
+		return k.expiryTimeQueue.Set(ctx, collections.Join(*resource.ExpiryTime, resource.Id))
+	}
+
+	return nil
+}
+
+func (k Keeper) removeFromExpiryQueues(ctx context.Context, resource types.Resource) error {
+	if resource.ExpiryHeight > 0 {
+		if err := k.expiryHeightQueue.Remove(ctx, collections.Join(resource.ExpiryHeight, resource.Id)); err != nil {
+			return err
+		}
+	}
+	if resource.ExpiryTime != nil {
+		return k.expiryTimeQueue.Remove(ctx, collections.Join(*resource.ExpiryTime, resource.Id))
+	}
+
+	return nil
+}
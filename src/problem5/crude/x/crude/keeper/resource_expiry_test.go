@@ -0,0 +1,130 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "crude/testutil/keeper"
+	"crude/testutil/sample"
+	"crude/x/crude/keeper"
+	"crude/x/crude/types"
+)
+
+func TestPruneExpiredResources(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	later := now.Add(time.Hour)
+
+	setup := func(t *testing.T, maxPruned uint64) (keeper.Keeper, sdk.Context) {
+		k, ctx := keepertest.CrudeKeeper(t)
+		params := types.DefaultParams()
+		params.MaxPrunedPerBlock = maxPruned
+		require.NoError(t, k.SetParams(ctx, params))
+		return k, ctx.WithBlockHeight(1).WithBlockTime(now)
+	}
+
+	t.Run("ByHeight", func(t *testing.T) {
+		k, ctx := setup(t, 10)
+		for _, height := range []int64{0, 5, 10} {
+			_, err := k.AppendResource(ctx, types.Resource{Owner: "A", ExpiryHeight: height})
+			require.NoError(t, err)
+		}
+
+		require.NoError(t, k.PruneExpiredResources(ctx.WithBlockHeight(5)))
+		_, found := k.GetResource(ctx, 1)
+		require.False(t, found)
+		require.Len(t, k.GetAllResource(ctx), 2)
+
+		require.NoError(t, k.PruneExpiredResources(ctx.WithBlockHeight(10)))
+		require.Len(t, k.GetAllResource(ctx), 1)
+		_, found = k.GetResource(ctx, 0)
+		require.True(t, found)
+	})
+	t.Run("ByTime", func(t *testing.T) {
+		k, ctx := setup(t, 10)
+		_, err := k.AppendResource(ctx, types.Resource{Owner: "A", ExpiryTime: &later})
+		require.NoError(t, err)
+
+		require.NoError(t, k.PruneExpiredResources(ctx))
+		require.Len(t, k.GetAllResource(ctx), 1)
+
+		require.NoError(t, k.PruneExpiredResources(ctx.WithBlockTime(later)))
+		require.Empty(t, k.GetAllResource(ctx))
+	})
+	t.Run("BothExpiries", func(t *testing.T) {
+		k, ctx := setup(t, 10)
+		_, err := k.AppendResource(ctx, types.Resource{Owner: "A", ExpiryHeight: 2, ExpiryTime: &now})
+		require.NoError(t, err)
+
+		ids, err := k.GetExpiredResourceIDs(ctx.WithBlockHeight(2), 2, now, 10)
+		require.NoError(t, err)
+		require.Equal(t, []uint64{0}, ids)
+		require.NoError(t, k.PruneExpiredResources(ctx.WithBlockHeight(2)))
+		require.Empty(t, k.GetAllResource(ctx))
+	})
+	t.Run("Capped", func(t *testing.T) {
+		k, ctx := setup(t, 2)
+		for i := 0; i < 5; i++ {
+			_, err := k.AppendResource(ctx, types.Resource{Owner: "A", ExpiryHeight: 2})
+			require.NoError(t, err)
+		}
+
+		ctx = ctx.WithBlockHeight(2)
+		require.NoError(t, k.PruneExpiredResources(ctx))
+		require.Len(t, k.GetAllResource(ctx), 3)
+		require.NoError(t, k.PruneExpiredResources(ctx))
+		require.NoError(t, k.PruneExpiredResources(ctx))
+		require.Empty(t, k.GetAllResource(ctx))
+	})
+	t.Run("Disabled", func(t *testing.T) {
+		k, ctx := setup(t, 0)
+		_, err := k.AppendResource(ctx, types.Resource{Owner: "A", ExpiryHeight: 1})
+		require.NoError(t, err)
+
+		require.NoError(t, k.PruneExpiredResources(ctx.WithBlockHeight(2)))
+		require.Len(t, k.GetAllResource(ctx), 1)
+	})
+	t.Run("Events", func(t *testing.T) {
+		k, ctx := setup(t, 10)
+		_, err := k.AppendResource(ctx, types.Resource{Owner: "A", ExpiryHeight: 2})
+		require.NoError(t, err)
+
+		ctx = ctx.WithBlockHeight(2).WithEventManager(sdk.NewEventManager())
+		require.NoError(t, k.PruneExpiredResources(ctx))
+		require.Len(t, ctx.EventManager().Events(), 1)
+		requireTypedEvent(t, ctx, &types.EventResourceExpired{Id: 0, Owner: "A"})
+	})
+	t.Run("DeletedResourceLeavesQueue", func(t *testing.T) {
+		k, ctx := setup(t, 10)
+		_, err := k.AppendResource(ctx, types.Resource{Owner: "A", ExpiryHeight: 2, ExpiryTime: &now})
+		require.NoError(t, err)
+		require.NoError(t, k.RemoveResource(ctx, 0))
+
+		ids, err := k.GetExpiredResourceIDs(ctx, 2, later, 10)
+		require.NoError(t, err)
+		require.Empty(t, ids)
+	})
+}
+
+func TestPruneExpiredResourcesRefundsDeposit(t *testing.T) {
+	owner := sample.AccAddress()
+	funds := sdk.NewCoins(sdk.NewInt64Coin("stake", 10_000))
+	bank := keepertest.NewBankKeeper()
+	bank.Fund(sdk.MustAccAddressFromBech32(owner), funds)
+	k, ctx := keepertest.CrudeKeeperWithBank(t, bank)
+	params := types.DefaultParams()
+	params.DepositPerByte = sdk.NewCoins(sdk.NewInt64Coin("stake", 1))
+	require.NoError(t, k.SetParams(ctx, params))
+	srv := keeper.NewMsgServerImpl(k)
+
+	ctx = ctx.WithBlockHeight(1)
+	_, err := srv.CreateResource(ctx, &types.MsgCreateResource{Creator: owner, Name: "name", ExpiryHeight: 2})
+	require.NoError(t, err)
+	require.False(t, bank.ModuleBalance(types.ModuleName).IsZero())
+
+	require.NoError(t, k.PruneExpiredResources(ctx.WithBlockHeight(2)))
+	require.True(t, bank.ModuleBalance(types.ModuleName).IsZero())
+	require.Equal(t, funds, bank.SpendableCoins(ctx, sdk.MustAccAddressFromBech32(owner)))
+}
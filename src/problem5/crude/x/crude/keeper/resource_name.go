@@ -0,0 +1,56 @@
+package keeper
+
+import (
+	"context"
+
+	"crude/x/crude/types"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+)
+
+// GetResourceIDsByName returns the ids of the resources named name, in id order
+func (k Keeper) GetResourceIDsByName(ctx context.Context, name string) (ids []uint64) {
+	rng := collections.NewPrefixedPairRange[string, uint64](name)
+	err := k.resourcesByName.Walk(ctx, rng, func(key collections.Pair[string, uint64]) (bool, error) {
+		ids = append(ids, key.K2())
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	return
+}
+
+// GetResourceByName returns the resource named name, the one with the lowest
+// id if several share the name
+func (k Keeper) GetResourceByName(ctx context.Context, name string) (val types.Resource, found bool) {
+	var id uint64
+	rng := collections.NewPrefixedPairRange[string, uint64](name)
+	err := k.resourcesByName.Walk(ctx, rng, func(key collections.Pair[string, uint64]) (bool, error) {
+		id, found = key.K2(), true
+		return true, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+	if !found {
+		return val, false
+	}
+
+	return k.GetResource(ctx, id)
+}
+
+// checkUniqueName checks that no resource is named name yet, when the
+// UniqueNames param is set
+func (k Keeper) checkUniqueName(ctx context.Context, params types.Params, name string) error {
+	if !params.UniqueNames {
+		return nil
+	}
+	if ids := k.GetResourceIDsByName(ctx, name); len(ids) > 0 {
+		return errorsmod.Wrapf(types.ErrDuplicateName, "resource %d is already named %q", ids[0], name)
+	}
+
+	return nil
+}
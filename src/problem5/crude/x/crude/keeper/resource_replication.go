@@ -0,0 +1,133 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"crude/x/crude/types"
+
+	"cosmossdk.io/collections"
+)
+
+// SetResourceReplication subscribes a resource to an IBC channel, replacing
+// any previous subscription to the same channel
+func (k Keeper) SetResourceReplication(ctx context.Context, replication types.ResourceReplication) error {
+	if err := k.replications.Set(ctx, collections.Join(replication.ResourceId, replication.ChannelId), replication); err != nil {
+		return err
+	}
+
+	return k.channelReplications.Set(ctx, collections.Join(replication.ChannelId, replication.ResourceId))
+}
+
+// GetResourceReplication returns the subscription of a resource to a channel
+func (k Keeper) GetResourceReplication(ctx context.Context, id uint64, channelID string) (val types.ResourceReplication, found bool) {
+	val, err := k.replications.Get(ctx, collections.Join(id, channelID))
+	if errors.Is(err, collections.ErrNotFound) {
+		return val, false
+	}
+	if err != nil {
+		panic(err)
+	}
+
+	return val, true
+}
+
+// RemoveResourceReplication drops the subscription of a resource to a channel
+func (k Keeper) RemoveResourceReplication(ctx context.Context, id uint64, channelID string) error {
+	if err := k.channelReplications.Remove(ctx, collections.Join(channelID, id)); err != nil {
+		return err
+	}
+
+	return k.replications.Remove(ctx, collections.Join(id, channelID))
+}
+
+// GetResourceReplications returns the subscriptions of a resource, in channel
+// order
+func (k Keeper) GetResourceReplications(ctx context.Context, id uint64) (list []types.ResourceReplication) {
+	rng := collections.NewPrefixedPairRange[uint64, string](id)
+	err := k.replications.Walk(ctx, rng, func(_ collections.Pair[uint64, string], val types.ResourceReplication) (bool, error) {
+		list = append(list, val)
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	return
+}
+
+// RemoveResourceReplications drops every subscription of a resource
+func (k Keeper) RemoveResourceReplications(ctx context.Context, id uint64) error {
+	for _, replication := range k.GetResourceReplications(ctx, id) {
+		if err := k.RemoveResourceReplication(ctx, id, replication.ChannelId); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// GetChannelReplicationIDs returns the ids of the resources subscribed to a
+// channel
+func (k Keeper) GetChannelReplicationIDs(ctx context.Context, channelID string) (ids []uint64) {
+	rng := collections.NewPrefixedPairRange[string, uint64](channelID)
+	err := k.channelReplications.Walk(ctx, rng, func(key collections.Pair[string, uint64]) (bool, error) {
+		ids = append(ids, key.K2())
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	return
+}
+
+// GetAllResourceReplication returns all resource replication subscriptions
+func (k Keeper) GetAllResourceReplication(ctx context.Context) (list []types.ResourceReplication) {
+	err := k.replications.Walk(ctx, nil, func(_ collections.Pair[uint64, string], val types.ResourceReplication) (bool, error) {
+		list = append(list, val)
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	return
+}
+
+// SetResourceReplica links a replica to the resource it mirrors
+func (k Keeper) SetResourceReplica(ctx context.Context, replica types.ResourceReplica) error {
+	return k.replicas.Set(ctx, collections.Join(replica.ChannelId, replica.SourceId), replica)
+}
+
+// GetResourceReplica returns the replica received over a channel of a
+// resource of the counterparty chain
+func (k Keeper) GetResourceReplica(ctx context.Context, channelID string, sourceID uint64) (val types.ResourceReplica, found bool) {
+	val, err := k.replicas.Get(ctx, collections.Join(channelID, sourceID))
+	if errors.Is(err, collections.ErrNotFound) {
+		return val, false
+	}
+	if err != nil {
+		panic(err)
+	}
+
+	return val, true
+}
+
+// RemoveResourceReplica removes the link of a replica
+func (k Keeper) RemoveResourceReplica(ctx context.Context, channelID string, sourceID uint64) error {
+	return k.replicas.Remove(ctx, collections.Join(channelID, sourceID))
+}
+
+// GetAllResourceReplica returns all resource replica links
+func (k Keeper) GetAllResourceReplica(ctx context.Context) (list []types.ResourceReplica) {
+	err := k.replicas.Walk(ctx, nil, func(_ collections.Pair[string, uint64], val types.ResourceReplica) (bool, error) {
+		list = append(list, val)
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	return
+}
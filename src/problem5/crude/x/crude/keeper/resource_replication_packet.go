@@ -0,0 +1,302 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"crude/x/crude/types"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+)
+
+// Replication sends a resource to the counterparty of an ordered channel,
+// which keeps a replica of it, then forwards its updates and its deletion.
+// Replicas are owned by the module account, so that only packets change them.
+//
+// Nothing is escrowed to replicate a resource, so a failed packet has nothing
+// to refund. What it rolls back is the subscription: an error acknowledgement
+// drops the subscription of the resource to the channel, leaving the replica,
+// if any, as it was. A timeout closes the ordered channel, which drops every
+// subscription to it. Replicas received over a closed channel are kept as they
+// were last written.
+
+// sendReplicationPacket sends a packet of a subscription, bounded by its
+// timeout period
+func (k Keeper) sendReplicationPacket(ctx sdk.Context, replication types.ResourceReplication, data types.CrudePacketData) (uint64, error) {
+	timeout := ctx.BlockTime().Add(replication.TimeoutOrDefault())
+	return k.transmitPacket(ctx, replication.ChannelId, data, uint64(timeout.UnixNano()))
+}
+
+// forwardResourcePacket sends a packet to every channel a resource is
+// subscribed to. A subscription to a channel that is no longer open is dropped
+// instead.
+func (k Keeper) forwardResourcePacket(ctx context.Context, id uint64, data types.CrudePacketData) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	for _, replication := range k.GetResourceReplications(ctx, id) {
+		if !k.isChannelOpen(sdkCtx, replication.ChannelId) {
+			if err := k.stopResourceReplication(sdkCtx, replication.ResourceId, replication.ChannelId, "channel closed"); err != nil {
+				return err
+			}
+			continue
+		}
+		if _, err := k.sendReplicationPacket(sdkCtx, replication, data); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// stopResourceReplication drops the subscription of a resource to a channel,
+// if still there
+func (k Keeper) stopResourceReplication(ctx sdk.Context, id uint64, channelID, reason string) error {
+	if _, found := k.GetResourceReplication(ctx, id, channelID); !found {
+		return nil
+	}
+	if err := k.RemoveResourceReplication(ctx, id, channelID); err != nil {
+		return err
+	}
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventResourceReplicationStopped{
+		Id:        id,
+		ChannelId: channelID,
+		Reason:    reason,
+	})
+}
+
+// StopChannelReplications drops every subscription to a channel, which
+// closed
+func (k Keeper) StopChannelReplications(ctx sdk.Context, channelID, reason string) error {
+	for _, id := range k.GetChannelReplicationIDs(ctx, channelID) {
+		if err := k.stopResourceReplication(ctx, id, channelID, reason); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// replicaOwner is the owner of every replica
+func replicaOwner() string {
+	return authtypes.NewModuleAddress(types.ModuleName).String()
+}
+
+// checkReplica checks that the state of a source resource is valid and may be
+// written to a replica under the params of this chain. The creation quota does
+// not apply, replicas being created on behalf of the counterparty.
+func (k Keeper) checkReplica(ctx context.Context, old *types.Resource, source types.Resource) error {
+	params := k.GetParams(ctx)
+	if err := checkResourceName(params, nil, source.Name); err != nil {
+		return err
+	}
+	if old == nil || old.Name != source.Name {
+		if err := k.checkUniqueName(ctx, params, source.Name); err != nil {
+			return err
+		}
+	}
+	for _, attribute := range source.Attributes {
+		if err := params.ValidateAttribute(attribute); err != nil {
+			return errorsmod.Wrap(types.ErrInvalidAttribute, err.Error())
+		}
+	}
+	if params.MaxAttributesPerResource > 0 && uint64(len(source.Attributes)) > params.MaxAttributesPerResource {
+		return errorsmod.Wrapf(types.ErrInvalidAttribute, "resource has more than %d attributes", params.MaxAttributesPerResource)
+	}
+	if err := source.Payload.Validate(); err != nil {
+		return errorsmod.Wrap(types.ErrInvalidPayload, err.Error())
+	}
+	if err := source.ValueBounds.Validate(); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	if err := source.ValueBounds.CheckPayload(source.Payload.OrZero()); err != nil {
+		return err
+	}
+	if err := types.ValidateTags(source.Tags); err != nil {
+		return errorsmod.Wrap(types.ErrInvalidTag, err.Error())
+	}
+
+	return nil
+}
+
+// emitReplicaWritten emits the event of a replica written on behalf of the
+// counterparty
+func emitReplicaWritten(ctx sdk.Context, channelID string, sourceID, replicaID uint64, action string) error {
+	return ctx.EventManager().EmitTypedEvent(&types.EventResourceReplicaWritten{
+		ChannelId: channelID,
+		SourceId:  sourceID,
+		ReplicaId: replicaID,
+		Action:    action,
+	})
+}
+
+// OnRecvResourceCreatePacket processes packet reception, creating the replica
+// of a resource of the counterparty chain
+func (k Keeper) OnRecvResourceCreatePacket(ctx sdk.Context, packet channeltypes.Packet, data types.ResourceCreatePacketData) (packetAck types.ResourceCreatePacketAck, err error) {
+	channelID := packet.GetDestChannel()
+	source := data.Resource
+	if _, found := k.GetResourceReplica(ctx, channelID, source.Id); found {
+		return packetAck, errorsmod.Wrapf(types.ErrAlreadyReplicated, "resource %d of channel %s", source.Id, channelID)
+	}
+	if err := k.checkReplica(ctx, nil, source); err != nil {
+		return packetAck, err
+	}
+
+	replica := types.Resource{
+		Owner:       replicaOwner(),
+		Creator:     source.Creator,
+		Name:        source.Name,
+		Payload:     source.Payload.OrZero(),
+		Version:     1,
+		ValueBounds: source.ValueBounds,
+		Attributes:  source.Attributes,
+		Tags:        types.SortTags(source.Tags),
+	}
+	id, err := k.AppendResource(ctx, replica)
+	if err != nil {
+		return packetAck, err
+	}
+	replica.Id = id
+	if _, err := k.AppendResourceRevision(ctx, replica, replica.Owner); err != nil {
+		return packetAck, err
+	}
+	if err := k.SetResourceReplica(ctx, types.ResourceReplica{
+		ChannelId:  channelID,
+		SourceId:   source.Id,
+		ResourceId: id,
+	}); err != nil {
+		return packetAck, err
+	}
+
+	packetAck.ReplicaId = id
+	return packetAck, emitReplicaWritten(ctx, channelID, source.Id, id, "create")
+}
+
+// OnRecvResourceUpdatePacket processes packet reception, writing the new state
+// of a resource of the counterparty chain to its replica
+func (k Keeper) OnRecvResourceUpdatePacket(ctx sdk.Context, packet channeltypes.Packet, data types.ResourceUpdatePacketData) (packetAck types.ResourceUpdatePacketAck, err error) {
+	channelID := packet.GetDestChannel()
+	source := data.Resource
+	replica, err := k.getReplicaResource(ctx, channelID, source.Id)
+	if err != nil {
+		return packetAck, err
+	}
+	if err := k.checkReplica(ctx, &replica, source); err != nil {
+		return packetAck, err
+	}
+
+	replica.Name = source.Name
+	replica.Payload = source.Payload.OrZero()
+	replica.ValueBounds = source.ValueBounds
+	replica.Attributes = source.Attributes
+	replica.Tags = types.SortTags(source.Tags)
+	replica.Version++
+	if err := k.SetResource(ctx, replica); err != nil {
+		return packetAck, err
+	}
+	if _, err := k.AppendResourceRevision(ctx, replica, replica.Owner); err != nil {
+		return packetAck, err
+	}
+
+	packetAck.ReplicaId = replica.Id
+	packetAck.Version = replica.Version
+	return packetAck, emitReplicaWritten(ctx, channelID, source.Id, replica.Id, "update")
+}
+
+// OnRecvResourceDeletePacket processes packet reception, deleting the replica
+// of a deleted resource of the counterparty chain
+func (k Keeper) OnRecvResourceDeletePacket(ctx sdk.Context, packet channeltypes.Packet, data types.ResourceDeletePacketData) (packetAck types.ResourceDeletePacketAck, err error) {
+	channelID := packet.GetDestChannel()
+	replica, err := k.getReplicaResource(ctx, channelID, data.ResourceId)
+	if err != nil {
+		return packetAck, err
+	}
+
+	if err := k.RemoveResource(ctx, replica.Id); err != nil {
+		return packetAck, err
+	}
+	if err := k.RemoveResourceReplica(ctx, channelID, data.ResourceId); err != nil {
+		return packetAck, err
+	}
+
+	packetAck.ReplicaId = replica.Id
+	return packetAck, emitReplicaWritten(ctx, channelID, data.ResourceId, replica.Id, "delete")
+}
+
+// getReplicaResource returns the replica of a resource of the counterparty
+func (k Keeper) getReplicaResource(ctx context.Context, channelID string, sourceID uint64) (types.Resource, error) {
+	link, found := k.GetResourceReplica(ctx, channelID, sourceID)
+	if !found {
+		return types.Resource{}, errorsmod.Wrapf(types.ErrUnknownReplica, "resource %d of channel %s", sourceID, channelID)
+	}
+	replica, found := k.GetResource(ctx, link.ResourceId)
+	if !found {
+		return types.Resource{}, fmt.Errorf("replica %d of resource %d of channel %s is missing", link.ResourceId, sourceID, channelID)
+	}
+
+	return replica, nil
+}
+
+// OnAcknowledgementResourceCreatePacket responds to the success or failure of
+// a packet acknowledgement written on the receiving chain. The id of the
+// replica is recorded on success, the subscription is dropped on failure.
+func (k Keeper) OnAcknowledgementResourceCreatePacket(ctx sdk.Context, packet channeltypes.Packet, data types.ResourceCreatePacketData, ack channeltypes.Acknowledgement) error {
+	switch dispatchedAck := ack.Response.(type) {
+	case *channeltypes.Acknowledgement_Error:
+		return k.stopResourceReplication(ctx, data.Resource.Id, packet.GetSourceChannel(), "replica creation failed: "+dispatchedAck.Error)
+	case *channeltypes.Acknowledgement_Result:
+		var packetAck types.ResourceCreatePacketAck
+		if err := types.ModuleCdc.UnmarshalJSON(dispatchedAck.Result, &packetAck); err != nil {
+			// The counterparty module acknowledgement could not be decoded
+			return errorsmod.Wrap(err, "cannot unmarshal acknowledgment")
+		}
+
+		replication, found := k.GetResourceReplication(ctx, data.Resource.Id, packet.GetSourceChannel())
+		if !found {
+			return nil
+		}
+		replication.ReplicaId = packetAck.ReplicaId
+		return k.SetResourceReplication(ctx, replication)
+	default:
+		// The counterparty module doesn't implement the correct acknowledgment format
+		return fmt.Errorf("invalid acknowledgment format: %T", dispatchedAck)
+	}
+}
+
+// OnAcknowledgementResourceUpdatePacket responds to the success or failure of
+// a packet acknowledgement written on the receiving chain. The replica no
+// longer follows the resource after a failure, so the subscription is dropped.
+func (k Keeper) OnAcknowledgementResourceUpdatePacket(ctx sdk.Context, packet channeltypes.Packet, data types.ResourceUpdatePacketData, ack channeltypes.Acknowledgement) error {
+	switch dispatchedAck := ack.Response.(type) {
+	case *channeltypes.Acknowledgement_Error:
+		return k.stopResourceReplication(ctx, data.Resource.Id, packet.GetSourceChannel(), "replica update failed: "+dispatchedAck.Error)
+	case *channeltypes.Acknowledgement_Result:
+		return nil
+	default:
+		// The counterparty module doesn't implement the correct acknowledgment format
+		return fmt.Errorf("invalid acknowledgment format: %T", dispatchedAck)
+	}
+}
+
+// OnAcknowledgementResourceDeletePacket responds to the success or failure of
+// a packet acknowledgement written on the receiving chain. The subscription
+// went away with the resource, so there is nothing left to do either way.
+func (k Keeper) OnAcknowledgementResourceDeletePacket(ctx sdk.Context, packet channeltypes.Packet, data types.ResourceDeletePacketData, ack channeltypes.Acknowledgement) error {
+	switch dispatchedAck := ack.Response.(type) {
+	case *channeltypes.Acknowledgement_Error, *channeltypes.Acknowledgement_Result:
+		return nil
+	default:
+		// The counterparty module doesn't implement the correct acknowledgment format
+		return fmt.Errorf("invalid acknowledgment format: %T", dispatchedAck)
+	}
+}
+
+// OnTimeoutResourcePacket responds to the case where a packet has not been
+// transmitted because of a timeout. The timeout closed the ordered channel,
+// which stops every replication on it.
+func (k Keeper) OnTimeoutResourcePacket(ctx sdk.Context, packet channeltypes.Packet) error {
+	return k.StopChannelReplications(ctx, packet.GetSourceChannel(), "packet timed out")
+}
@@ -0,0 +1,63 @@
+package keeper_test
+
+import (
+	"testing"
+
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "crude/testutil/keeper"
+	"crude/x/crude/types"
+)
+
+func TestOnRecvResourcePacketInvalidResource(t *testing.T) {
+	k, ctx := keepertest.CrudeKeeper(t)
+	packet := channeltypes.Packet{DestinationChannel: "channel-0"}
+	source := types.Resource{Id: 7, Creator: "A", Name: "replicated", Payload: types.NewUint64Payload(1)}
+
+	_, err := k.OnRecvResourceCreatePacket(ctx, packet, types.ResourceCreatePacketData{Resource: source})
+	require.NoError(t, err)
+	link, found := k.GetResourceReplica(ctx, "channel-0", source.Id)
+	require.True(t, found)
+
+	for _, tc := range []struct {
+		desc   string
+		update func(*types.Resource)
+		err    error
+	}{
+		{
+			desc:   "InvalidPayload",
+			update: func(r *types.Resource) { r.Payload = types.NewJSONPayload("{") },
+			err:    types.ErrInvalidPayload,
+		},
+		{
+			desc:   "PayloadOutOfBounds",
+			update: func(r *types.Resource) { r.ValueBounds = &types.ResourceValueBounds{Min: 5, Max: 10} },
+			err:    types.ErrValueOutOfBounds,
+		},
+		{
+			desc:   "InvalidTag",
+			update: func(r *types.Resource) { r.Tags = []string{"env:prod", "env:prod"} },
+			err:    types.ErrInvalidTag,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			invalid := source
+			invalid.Id = 8
+			tc.update(&invalid)
+			_, err := k.OnRecvResourceCreatePacket(ctx, packet, types.ResourceCreatePacketData{Resource: invalid})
+			require.ErrorIs(t, err, tc.err)
+			_, found := k.GetResourceReplica(ctx, "channel-0", invalid.Id)
+			require.False(t, found)
+
+			// The replica is left as it was
+			invalid.Id = source.Id
+			_, err = k.OnRecvResourceUpdatePacket(ctx, packet, types.ResourceUpdatePacketData{Resource: invalid})
+			require.ErrorIs(t, err, tc.err)
+			replica, found := k.GetResource(ctx, link.ResourceId)
+			require.True(t, found)
+			require.Equal(t, types.NewUint64Payload(1), replica.Payload)
+			require.Equal(t, uint64(1), replica.Version)
+		})
+	}
+}
@@ -0,0 +1,82 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"crude/x/crude/types"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// AppendResourceRevision records the current state of a resource as its next
+// revision, stamped with the block height, block time and signer
+func (k Keeper) AppendResourceRevision(ctx context.Context, resource types.Resource, signer string) (uint64, error) {
+	revision, err := k.nextResourceRevision(ctx, resource.Id)
+	if err != nil {
+		return 0, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	return revision, k.SetResourceRevision(ctx, types.ResourceRevision{
+		ResourceId:  resource.Id,
+		Revision:    revision,
+		Name:        resource.Name,
+		Payload:     resource.Payload,
+		Signer:      signer,
+		BlockHeight: sdkCtx.BlockHeight(),
+		BlockTime:   sdkCtx.BlockTime(),
+	})
+}
+
+// SetResourceRevision set a specific resource revision in the store
+func (k Keeper) SetResourceRevision(ctx context.Context, revision types.ResourceRevision) error {
+	return k.resourceRevisions.Set(ctx, collections.Join(revision.ResourceId, revision.Revision), revision)
+}
+
+// GetResourceRevision returns a resource revision from its resource id and revision number
+func (k Keeper) GetResourceRevision(ctx context.Context, id, revision uint64) (val types.ResourceRevision, found bool) {
+	val, err := k.resourceRevisions.Get(ctx, collections.Join(id, revision))
+	if errors.Is(err, collections.ErrNotFound) {
+		return val, false
+	}
+	if err != nil {
+		panic(err)
+	}
+
+	return val, true
+}
+
+// GetAllResourceRevision returns all resource revisions
+func (k Keeper) GetAllResourceRevision(ctx context.Context) (list []types.ResourceRevision) {
+	err := k.resourceRevisions.Walk(ctx, nil, func(_ collections.Pair[uint64, uint64], val types.ResourceRevision) (bool, error) {
+		list = append(list, val)
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	return
+}
+
+// nextResourceRevision returns the revision number following the latest recorded one
+func (k Keeper) nextResourceRevision(ctx context.Context, id uint64) (uint64, error) {
+	rng := collections.NewPrefixedPairRange[uint64, uint64](id).Descending()
+	iterator, err := k.resourceRevisions.Iterate(ctx, rng)
+	if err != nil {
+		return 0, err
+	}
+	defer iterator.Close()
+
+	if !iterator.Valid() {
+		return 0, nil
+	}
+	key, err := iterator.Key()
+	if err != nil {
+		return 0, err
+	}
+
+	return key.K2() + 1, nil
+}
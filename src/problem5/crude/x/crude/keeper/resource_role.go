@@ -0,0 +1,96 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"crude/x/crude/types"
+
+	"cosmossdk.io/collections"
+)
+
+// SetResourceRoleGrant set the role of an address on a resource, replacing any previous one
+func (k Keeper) SetResourceRoleGrant(ctx context.Context, grant types.ResourceRoleGrant) error {
+	if err := k.resourceRoles.Set(ctx, collections.Join(grant.ResourceId, grant.Address), grant); err != nil {
+		return err
+	}
+
+	return k.resourcesByGrantee.Set(ctx, collections.Join(grant.Address, grant.ResourceId))
+}
+
+// GetResourceRoleGrant returns the role granted to an address on a resource
+func (k Keeper) GetResourceRoleGrant(ctx context.Context, id uint64, address string) (val types.ResourceRoleGrant, found bool) {
+	val, err := k.resourceRoles.Get(ctx, collections.Join(id, address))
+	if errors.Is(err, collections.ErrNotFound) {
+		return val, false
+	}
+	if err != nil {
+		panic(err)
+	}
+
+	return val, true
+}
+
+// RemoveResourceRoleGrant removes the role granted to an address on a resource
+func (k Keeper) RemoveResourceRoleGrant(ctx context.Context, id uint64, address string) error {
+	if err := k.resourcesByGrantee.Remove(ctx, collections.Join(address, id)); err != nil {
+		return err
+	}
+
+	return k.resourceRoles.Remove(ctx, collections.Join(id, address))
+}
+
+// RemoveResourceRoleGrants clears the whole access control list of a resource
+func (k Keeper) RemoveResourceRoleGrants(ctx context.Context, id uint64) error {
+	var addresses []string
+	rng := collections.NewPrefixedPairRange[uint64, string](id)
+	err := k.resourceRoles.Walk(ctx, rng, func(key collections.Pair[uint64, string], _ types.ResourceRoleGrant) (bool, error) {
+		addresses = append(addresses, key.K2())
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, address := range addresses {
+		if err := k.RemoveResourceRoleGrant(ctx, id, address); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// GetAllResourceRoleGrant returns all resource role grants
+func (k Keeper) GetAllResourceRoleGrant(ctx context.Context) (list []types.ResourceRoleGrant) {
+	err := k.resourceRoles.Walk(ctx, nil, func(_ collections.Pair[uint64, string], val types.ResourceRoleGrant) (bool, error) {
+		list = append(list, val)
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	return
+}
+
+// GetResourceRole returns the effective role of an address on a resource,
+// the owner role taking precedence over any grant. The namespace of the
+// resource adds its admin and default roles, the strongest role applying.
+func (k Keeper) GetResourceRole(ctx context.Context, resource types.Resource, address string) types.ResourceRole {
+	if address == resource.Owner {
+		return types.ResourceRole_RESOURCE_ROLE_OWNER
+	}
+
+	role := types.ResourceRole_RESOURCE_ROLE_UNSPECIFIED
+	if grant, found := k.GetResourceRoleGrant(ctx, resource.Id, address); found {
+		role = grant.Role
+	}
+	if resource.Namespace != "" {
+		if namespace, found := k.GetNamespace(ctx, resource.Namespace); found {
+			role = types.StrongerRole(role, namespace.RoleOf(address))
+		}
+	}
+
+	return role
+}
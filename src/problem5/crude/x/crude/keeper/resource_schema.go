@@ -0,0 +1,59 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"crude/x/crude/types"
+
+	"cosmossdk.io/collections"
+)
+
+// SetResourceSchema set a specific version of a resource schema in the store
+func (k Keeper) SetResourceSchema(ctx context.Context, schema types.ResourceSchema) error {
+	return k.resourceSchemas.Set(ctx, collections.Join(schema.Id, schema.Version), schema)
+}
+
+// GetResourceSchema returns a version of a resource schema
+func (k Keeper) GetResourceSchema(ctx context.Context, id string, version uint64) (val types.ResourceSchema, found bool) {
+	val, err := k.resourceSchemas.Get(ctx, collections.Join(id, version))
+	if errors.Is(err, collections.ErrNotFound) {
+		return val, false
+	}
+	if err != nil {
+		panic(err)
+	}
+
+	return val, true
+}
+
+// GetLatestResourceSchema returns the highest version of a resource schema
+func (k Keeper) GetLatestResourceSchema(ctx context.Context, id string) (val types.ResourceSchema, found bool) {
+	iter, err := k.resourceSchemas.Iterate(ctx, collections.NewPrefixedPairRange[string, uint64](id).Descending())
+	if err != nil {
+		panic(err)
+	}
+	defer iter.Close()
+
+	if !iter.Valid() {
+		return val, false
+	}
+	if val, err = iter.Value(); err != nil {
+		panic(err)
+	}
+
+	return val, true
+}
+
+// GetAllResourceSchema returns every version of every resource schema
+func (k Keeper) GetAllResourceSchema(ctx context.Context) (list []types.ResourceSchema) {
+	err := k.resourceSchemas.Walk(ctx, nil, func(_ collections.Pair[string, uint64], val types.ResourceSchema) (bool, error) {
+		list = append(list, val)
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	return
+}
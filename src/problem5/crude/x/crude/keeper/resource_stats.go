@@ -0,0 +1,298 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+
+	"crude/x/crude/types"
+
+	"cosmossdk.io/collections"
+	sdkmath "cosmossdk.io/math"
+)
+
+// GetResourceTotals returns the running aggregates over the live resources
+func (k Keeper) GetResourceTotals(ctx context.Context) types.ResourceTotals {
+	totals, err := k.resourceTotals.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.ResourceTotals{ValueSum: sdkmath.ZeroInt()}
+	}
+	if err != nil {
+		panic(err)
+	}
+
+	return totals
+}
+
+// GetCreatorResourceCount returns the number of live resources created by an
+// address
+func (k Keeper) GetCreatorResourceCount(ctx context.Context, creator string) uint64 {
+	count, err := k.creatorCounts.Get(ctx, creator)
+	if errors.Is(err, collections.ErrNotFound) {
+		return 0
+	}
+	if err != nil {
+		panic(err)
+	}
+
+	return count
+}
+
+// GetNamespaceResourceCount returns the number of live resources of a
+// namespace
+func (k Keeper) GetNamespaceResourceCount(ctx context.Context, namespace string) uint64 {
+	count, err := k.namespaceCounts.Get(ctx, namespace)
+	if errors.Is(err, collections.ErrNotFound) {
+		return 0
+	}
+	if err != nil {
+		panic(err)
+	}
+
+	return count
+}
+
+// GetTopCreators returns at most n creators by decreasing number of live
+// resources, ties broken by address
+func (k Keeper) GetTopCreators(ctx context.Context, n int) (list []types.CreatorResourceCount) {
+	if n <= 0 {
+		return nil
+	}
+	err := k.creatorRanks.Walk(ctx, nil, func(key collections.Pair[uint64, string]) (bool, error) {
+		list = append(list, types.CreatorResourceCount{Creator: key.K2(), Count: rankCount(key.K1())})
+		return len(list) >= n, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	return
+}
+
+// GetResourceValueRange returns the lowest and highest uint64 values held by
+// live resources, found is false if none holds a uint64 payload
+func (k Keeper) GetResourceValueRange(ctx context.Context) (min, max uint64, found bool) {
+	err := k.valueCounts.Walk(ctx, nil, func(value, _ uint64) (bool, error) {
+		min, found = value, true
+		return true, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+	if !found {
+		return 0, 0, false
+	}
+	err = k.valueCounts.Walk(ctx, new(collections.Range[uint64]).Descending(), func(value, _ uint64) (bool, error) {
+		max = value
+		return true, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	return min, max, true
+}
+
+// RebuildResourceStats recomputes the aggregates from the stored resources.
+// The aggregates must be built, at genesis or by the store migrations, before
+// resources are written, which then maintain them.
+func (k Keeper) RebuildResourceStats(ctx context.Context) error {
+	if err := k.resourceTotals.Set(ctx, types.ResourceTotals{ValueSum: sdkmath.ZeroInt()}); err != nil {
+		return err
+	}
+	if err := k.creatorCounts.Clear(ctx, nil); err != nil {
+		return err
+	}
+	if err := k.creatorRanks.Clear(ctx, nil); err != nil {
+		return err
+	}
+	if err := k.valueCounts.Clear(ctx, nil); err != nil {
+		return err
+	}
+	if err := k.namespaceCounts.Clear(ctx, nil); err != nil {
+		return err
+	}
+
+	for _, resource := range k.GetAllResource(ctx) {
+		if err := k.updateResourceStats(ctx, nil, &resource); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// updateResourceStats moves the aggregates from the old to the new state of a
+// resource, a nil state standing for an absent resource. Only the fields the
+// aggregates depend on are compared, so that rewriting a resource unchanged
+// leaves them untouched.
+func (k Keeper) updateResourceStats(ctx context.Context, old, resource *types.Resource) error {
+	totals, err := k.resourceTotals.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		return fmt.Errorf("resource stats are not built, see RebuildResourceStats: %w", err)
+	}
+	if err != nil {
+		return err
+	}
+	changed := false
+
+	switch {
+	case old == nil && resource != nil:
+		totals.LiveCount++
+		changed = true
+	case old != nil && resource == nil:
+		totals.LiveCount--
+		changed = true
+	}
+
+	if old == nil || resource == nil || old.Creator != resource.Creator {
+		if old != nil {
+			count, err := k.adjustCreatorCount(ctx, old.Creator, false)
+			if err != nil {
+				return err
+			}
+			if count == 0 {
+				totals.CreatorCount--
+			}
+			changed = true
+		}
+		if resource != nil {
+			count, err := k.adjustCreatorCount(ctx, resource.Creator, true)
+			if err != nil {
+				return err
+			}
+			if count == 1 {
+				totals.CreatorCount++
+			}
+			changed = true
+		}
+	}
+
+	if old == nil || resource == nil || old.Namespace != resource.Namespace {
+		if old != nil {
+			if err := k.adjustNamespaceCount(ctx, old.Namespace, false); err != nil {
+				return err
+			}
+		}
+		if resource != nil {
+			if err := k.adjustNamespaceCount(ctx, resource.Namespace, true); err != nil {
+				return err
+			}
+		}
+	}
+
+	var oldValue, newValue uint64
+	oldOk, newOk := false, false
+	if old != nil {
+		oldValue, oldOk = old.Payload.Uint64()
+	}
+	if resource != nil {
+		newValue, newOk = resource.Payload.Uint64()
+	}
+	if oldOk != newOk || oldValue != newValue {
+		if oldOk {
+			if err := k.adjustValueCount(ctx, oldValue, false); err != nil {
+				return err
+			}
+			totals.ValueCount--
+			totals.ValueSum = totals.ValueSum.Sub(sdkmath.NewIntFromUint64(oldValue))
+		}
+		if newOk {
+			if err := k.adjustValueCount(ctx, newValue, true); err != nil {
+				return err
+			}
+			totals.ValueCount++
+			totals.ValueSum = totals.ValueSum.Add(sdkmath.NewIntFromUint64(newValue))
+		}
+		changed = true
+	}
+
+	if !changed {
+		return nil
+	}
+
+	return k.resourceTotals.Set(ctx, totals)
+}
+
+// adjustCreatorCount counts one more or one less resource for a creator,
+// moving it in the ranking, and returns its new count
+func (k Keeper) adjustCreatorCount(ctx context.Context, creator string, increment bool) (uint64, error) {
+	count := k.GetCreatorResourceCount(ctx, creator)
+	if !increment && count == 0 {
+		return 0, fmt.Errorf("no resource of %s left in the stats", creator)
+	}
+	if count > 0 {
+		if err := k.creatorRanks.Remove(ctx, collections.Join(rankKey(count), creator)); err != nil {
+			return 0, err
+		}
+	}
+
+	if increment {
+		count++
+	} else {
+		count--
+	}
+	if count == 0 {
+		return 0, k.creatorCounts.Remove(ctx, creator)
+	}
+	if err := k.creatorCounts.Set(ctx, creator, count); err != nil {
+		return 0, err
+	}
+
+	return count, k.creatorRanks.Set(ctx, collections.Join(rankKey(count), creator))
+}
+
+// adjustNamespaceCount counts one more or one less resource in a namespace,
+// resources outside any namespace being left uncounted
+func (k Keeper) adjustNamespaceCount(ctx context.Context, namespace string, increment bool) error {
+	if namespace == "" {
+		return nil
+	}
+	count := k.GetNamespaceResourceCount(ctx, namespace)
+
+	if increment {
+		count++
+	} else if count == 0 {
+		return fmt.Errorf("no resource of namespace %s left in the stats", namespace)
+	} else {
+		count--
+	}
+	if count == 0 {
+		return k.namespaceCounts.Remove(ctx, namespace)
+	}
+
+	return k.namespaceCounts.Set(ctx, namespace, count)
+}
+
+// adjustValueCount counts one more or one less resource holding a value
+func (k Keeper) adjustValueCount(ctx context.Context, value uint64, increment bool) error {
+	count, err := k.valueCounts.Get(ctx, value)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return err
+	}
+
+	if increment {
+		count++
+	} else if count == 0 {
+		return fmt.Errorf("no resource of value %d left in the stats", value)
+	} else {
+		count--
+	}
+	if count == 0 {
+		return k.valueCounts.Remove(ctx, value)
+	}
+
+	return k.valueCounts.Set(ctx, value, count)
+}
+
+// rankKey inverts a resource count so that the ranking walks the creators
+// with the most resources first, in address order on ties
+func rankKey(count uint64) uint64 {
+	return math.MaxUint64 - count
+}
+
+// rankCount is the resource count of a rank key
+func rankCount(key uint64) uint64 {
+	return math.MaxUint64 - key
+}
@@ -0,0 +1,45 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+
+	"crude/x/crude/types"
+)
+
+// GetResourceIDsByTag returns the ids of the resources carrying a tag
+func (k Keeper) GetResourceIDsByTag(ctx context.Context, tag string) (ids []uint64) {
+	rng := collections.NewPrefixedPairRange[string, uint64](tag)
+	err := k.resourcesByTag.Walk(ctx, rng, func(key collections.Pair[string, uint64]) (bool, error) {
+		ids = append(ids, key.K2())
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	return
+}
+
+// insertIntoTagIndex indexes every tag of the resource
+func (k Keeper) insertIntoTagIndex(ctx context.Context, resource types.Resource) error {
+	for _, tag := range resource.Tags {
+		if err := k.resourcesByTag.Set(ctx, collections.Join(tag, resource.Id)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// removeFromTagIndex drops every tag of the resource from the index
+func (k Keeper) removeFromTagIndex(ctx context.Context, resource types.Resource) error {
+	for _, tag := range resource.Tags {
+		if err := k.resourcesByTag.Remove(ctx, collections.Join(tag, resource.Id)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
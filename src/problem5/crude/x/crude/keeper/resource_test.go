@@ -15,7 +15,11 @@ import (
 func createNResource(keeper keeper.Keeper, ctx context.Context, n int) []types.Resource {
 	items := make([]types.Resource, n)
 	for i := range items {
-		items[i].Id = keeper.AppendResource(ctx, items[i])
+		id, err := keeper.AppendResource(ctx, items[i])
+		if err != nil {
+			panic(err)
+		}
+		items[i].Id = id
 	}
 	return items
 }
@@ -37,7 +41,7 @@ func TestResourceRemove(t *testing.T) {
 	keeper, ctx := keepertest.CrudeKeeper(t)
 	items := createNResource(keeper, ctx, 10)
 	for _, item := range items {
-		keeper.RemoveResource(ctx, item.Id)
+		require.NoError(t, keeper.RemoveResource(ctx, item.Id))
 		_, found := keeper.GetResource(ctx, item.Id)
 		require.False(t, found)
 	}
@@ -58,3 +62,54 @@ func TestResourceCount(t *testing.T) {
 	count := uint64(len(items))
 	require.Equal(t, count, keeper.GetResourceCount(ctx))
 }
+
+func TestResourceCreatorIndex(t *testing.T) {
+	keeper, ctx := keepertest.CrudeKeeper(t)
+	items := make([]types.Resource, 6)
+	for i := range items {
+		items[i].Creator = []string{"A", "B"}[i%2]
+		id, err := keeper.AppendResource(ctx, items[i])
+		require.NoError(t, err)
+		items[i].Id = id
+	}
+	require.Equal(t, []uint64{0, 2, 4}, keeper.GetResourceIDsByCreator(ctx, "A"))
+	require.Equal(t, []uint64{1, 3, 5}, keeper.GetResourceIDsByCreator(ctx, "B"))
+
+	// Changing the creator moves the index entry
+	items[0].Creator = "B"
+	require.NoError(t, keeper.SetResource(ctx, items[0]))
+	require.Equal(t, []uint64{2, 4}, keeper.GetResourceIDsByCreator(ctx, "A"))
+	require.Equal(t, []uint64{0, 1, 3, 5}, keeper.GetResourceIDsByCreator(ctx, "B"))
+
+	// Removing a resource drops it from the index
+	require.NoError(t, keeper.RemoveResource(ctx, items[3].Id))
+	require.Equal(t, []uint64{0, 1, 5}, keeper.GetResourceIDsByCreator(ctx, "B"))
+	require.Empty(t, keeper.GetResourceIDsByCreator(ctx, "C"))
+}
+
+func TestResourceOwnerIndex(t *testing.T) {
+	keeper, ctx := keepertest.CrudeKeeper(t)
+	items := make([]types.Resource, 4)
+	for i := range items {
+		items[i].Owner = []string{"A", "B"}[i%2]
+		items[i].Creator = items[i].Owner
+		id, err := keeper.AppendResource(ctx, items[i])
+		require.NoError(t, err)
+		items[i].Id = id
+	}
+	require.Equal(t, []uint64{0, 2}, keeper.GetResourceIDsByOwner(ctx, "A"))
+
+	// Changing the owner moves the owner index entry but keeps the creator one
+	items[0].Owner = "B"
+	require.NoError(t, keeper.SetResource(ctx, items[0]))
+	require.Equal(t, []uint64{2}, keeper.GetResourceIDsByOwner(ctx, "A"))
+	require.Equal(t, []uint64{0, 1, 3}, keeper.GetResourceIDsByOwner(ctx, "B"))
+	require.Equal(t, []uint64{0, 2}, keeper.GetResourceIDsByCreator(ctx, "A"))
+
+	// Removing a resource drops it from the index and cancels its pending offer
+	require.NoError(t, keeper.SetResourceTransferOffer(ctx, types.ResourceTransferOffer{ResourceId: 1, Owner: "B", Recipient: "A"}))
+	require.NoError(t, keeper.RemoveResource(ctx, items[1].Id))
+	require.Equal(t, []uint64{0, 3}, keeper.GetResourceIDsByOwner(ctx, "B"))
+	_, found := keeper.GetResourceTransferOffer(ctx, 1)
+	require.False(t, found)
+}
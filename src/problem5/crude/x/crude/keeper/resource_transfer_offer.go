@@ -0,0 +1,46 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"crude/x/crude/types"
+
+	"cosmossdk.io/collections"
+)
+
+// SetResourceTransferOffer set a pending transfer offer, replacing any previous one for the resource
+func (k Keeper) SetResourceTransferOffer(ctx context.Context, offer types.ResourceTransferOffer) error {
+	return k.transferOffers.Set(ctx, offer.ResourceId, offer)
+}
+
+// GetResourceTransferOffer returns the pending transfer offer of a resource
+func (k Keeper) GetResourceTransferOffer(ctx context.Context, id uint64) (val types.ResourceTransferOffer, found bool) {
+	val, err := k.transferOffers.Get(ctx, id)
+	if errors.Is(err, collections.ErrNotFound) {
+		return val, false
+	}
+	if err != nil {
+		panic(err)
+	}
+
+	return val, true
+}
+
+// RemoveResourceTransferOffer removes the pending transfer offer of a resource
+func (k Keeper) RemoveResourceTransferOffer(ctx context.Context, id uint64) error {
+	return k.transferOffers.Remove(ctx, id)
+}
+
+// GetAllResourceTransferOffer returns all pending transfer offers
+func (k Keeper) GetAllResourceTransferOffer(ctx context.Context) (list []types.ResourceTransferOffer) {
+	err := k.transferOffers.Walk(ctx, nil, func(_ uint64, val types.ResourceTransferOffer) (bool, error) {
+		list = append(list, val)
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	return
+}
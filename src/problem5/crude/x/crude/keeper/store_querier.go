@@ -0,0 +1,21 @@
+package keeper
+
+import (
+	storetypes "cosmossdk.io/store/types"
+)
+
+// storeQuerier holds the committed multistore answering proof queries
+type storeQuerier struct {
+	storetypes.Queryable
+}
+
+// SetStoreQuerier sets the committed multistore used to prove resources. The
+// copies of the keeper share it, so it can be set once the app is built.
+// Without it, proof queries are unavailable.
+func (k Keeper) SetStoreQuerier(q storetypes.Queryable) {
+	if k.storeQuerier.Queryable != nil {
+		panic("cannot set store querier twice")
+	}
+
+	k.storeQuerier.Queryable = q
+}
@@ -0,0 +1,60 @@
+package keeper
+
+import (
+	"context"
+
+	"crude/x/crude/types"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ExecuteTransferMemo runs the resource operation of an ICS-20 transfer memo
+// on behalf of the intermediary account of the sender, through the message
+// server so that the operation is checked and charged like a transaction of
+// that account. It returns the id of the created or updated resource.
+func (k Keeper) ExecuteTransferMemo(ctx context.Context, channelID, sender string, memo types.TransferMemo) (uint64, error) {
+	if err := memo.ValidateBasic(); err != nil {
+		return 0, err
+	}
+	intermediary := types.IntermediaryAccount(channelID, sender).String()
+	msgServer := NewMsgServerImpl(k)
+
+	var (
+		id     uint64
+		action string
+	)
+	switch op := memo.Operation.(type) {
+	case *types.TransferMemo_Create:
+		msg := *op.Create
+		msg.Creator = intermediary
+		if err := msg.ValidateBasic(); err != nil {
+			return 0, err
+		}
+		res, err := msgServer.CreateResource(ctx, &msg)
+		if err != nil {
+			return 0, err
+		}
+		id, action = res.Id, "create"
+	case *types.TransferMemo_Update:
+		msg := *op.Update
+		msg.Creator = intermediary
+		if err := msg.ValidateBasic(); err != nil {
+			return 0, err
+		}
+		if _, err := msgServer.UpdateResource(ctx, &msg); err != nil {
+			return 0, err
+		}
+		id, action = msg.Id, "update"
+	default:
+		return 0, errorsmod.Wrapf(types.ErrInvalidTransferMemo, "unrecognized operation %T", op)
+	}
+
+	return id, sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&types.EventTransferMemoExecuted{
+		ChannelId:    channelID,
+		Sender:       sender,
+		Intermediary: intermediary,
+		ResourceId:   id,
+		Action:       action,
+	})
+}
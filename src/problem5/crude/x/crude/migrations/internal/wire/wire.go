@@ -0,0 +1,179 @@
+// Package wire edits encoded protobuf messages field by field, so that store
+// migrations can rewrite the records of a past layout without decoding them
+// into the current types, which drop the fields they no longer declare.
+package wire
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+
+	storetypes "cosmossdk.io/store/types"
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+// Field is an encoded field of a message.
+type Field struct {
+	Num  protowire.Number
+	Type protowire.Type
+	// raw is the whole field, tag included
+	raw []byte
+	// value is the field without its tag, or the content of a length
+	// delimited field
+	value []byte
+}
+
+// Message is the list of fields of an encoded message, in encoding order.
+type Message []Field
+
+// Parse splits an encoded message into its fields.
+func Parse(bz []byte) (Message, error) {
+	var m Message
+	for len(bz) > 0 {
+		num, typ, n := protowire.ConsumeTag(bz)
+		if n < 0 {
+			return nil, protowire.ParseError(n)
+		}
+		size := protowire.ConsumeFieldValue(num, typ, bz[n:])
+		if size < 0 {
+			return nil, protowire.ParseError(size)
+		}
+
+		value := bz[n : n+size]
+		if typ == protowire.BytesType {
+			value, _ = protowire.ConsumeBytes(value)
+		}
+		m = append(m, Field{Num: num, Type: typ, raw: bz[:n+size], value: value})
+		bz = bz[n+size:]
+	}
+
+	return m, nil
+}
+
+// Has reports whether the message holds the field.
+func (m Message) Has(num protowire.Number) bool {
+	for _, field := range m {
+		if field.Num == num {
+			return true
+		}
+	}
+	return false
+}
+
+// Uint64 returns the last occurrence of a varint field, as protobuf decoders
+// do, zero if the field is absent.
+func (m Message) Uint64(num protowire.Number) (value uint64) {
+	for _, field := range m {
+		if field.Num == num && field.Type == protowire.VarintType {
+			value, _ = protowire.ConsumeVarint(field.value)
+		}
+	}
+	return value
+}
+
+// Bytes returns the last occurrence of a length delimited field, nil if the
+// field is absent.
+func (m Message) Bytes(num protowire.Number) (value []byte) {
+	for _, field := range m {
+		if field.Num == num && field.Type == protowire.BytesType {
+			value = field.value
+		}
+	}
+	return value
+}
+
+// Without returns the message without any occurrence of the field.
+func (m Message) Without(num protowire.Number) Message {
+	var out Message
+	for _, field := range m {
+		if field.Num != num {
+			out = append(out, field)
+		}
+	}
+	return out
+}
+
+// AppendUint64 returns the message with a varint field added.
+func (m Message) AppendUint64(num protowire.Number, value uint64) Message {
+	raw := protowire.AppendTag(nil, num, protowire.VarintType)
+	raw = protowire.AppendVarint(raw, value)
+	return append(m, Field{Num: num, Type: protowire.VarintType, raw: raw, value: raw[protowire.SizeTag(num):]})
+}
+
+// AppendBytes returns the message with a length delimited field added.
+func (m Message) AppendBytes(num protowire.Number, value []byte) Message {
+	raw := protowire.AppendTag(nil, num, protowire.BytesType)
+	raw = protowire.AppendBytes(raw, value)
+	return append(m, Field{Num: num, Type: protowire.BytesType, raw: raw, value: value})
+}
+
+// DefaultUint64 returns the message with a varint field set to value when
+// it is absent or zero.
+func (m Message) DefaultUint64(num protowire.Number, value uint64) Message {
+	if m.Uint64(num) != 0 {
+		return m
+	}
+	return m.Without(num).AppendUint64(num, value)
+}
+
+// DefaultBytes returns the message with a length delimited field set to value
+// when it is absent or empty.
+func (m Message) DefaultBytes(num protowire.Number, value []byte) Message {
+	if len(m.Bytes(num)) > 0 {
+		return m
+	}
+	return m.Without(num).AppendBytes(num, value)
+}
+
+// Marshal encodes the message with its fields in field number order, the
+// order the generated marshalers write them in, so that an edited record is
+// encoded as the codec of its layout would encode it.
+func (m Message) Marshal() []byte {
+	fields := make(Message, len(m))
+	copy(fields, m)
+	sort.SliceStable(fields, func(i, j int) bool { return fields[i].Num < fields[j].Num })
+
+	var bz []byte
+	for _, field := range fields {
+		bz = append(bz, field.raw...)
+	}
+	return bz
+}
+
+// Rewrite passes every record of the store to fn and writes back the records
+// it changed, once the iteration is over.
+func Rewrite(store storetypes.KVStore, fn func(m Message) Message) error {
+	var keys, values [][]byte
+
+	iterator := store.Iterator(nil, nil)
+	for ; iterator.Valid(); iterator.Next() {
+		m, err := Parse(iterator.Value())
+		if err != nil {
+			iterator.Close()
+			return fmt.Errorf("cannot decode record %X: %w", iterator.Key(), err)
+		}
+		if bz := fn(m).Marshal(); !bytes.Equal(bz, iterator.Value()) {
+			keys = append(keys, iterator.Key())
+			values = append(values, bz)
+		}
+	}
+	iterator.Close()
+
+	for i, key := range keys {
+		store.Set(key, values[i])
+	}
+
+	return nil
+}
+
+// Update passes the record stored under key to fn, an absent record being an
+// empty message, and stores the record it returns.
+func Update(store storetypes.KVStore, key []byte, fn func(m Message) Message) error {
+	m, err := Parse(store.Get(key))
+	if err != nil {
+		return fmt.Errorf("cannot decode record %X: %w", key, err)
+	}
+
+	store.Set(key, fn(m).Marshal())
+	return nil
+}
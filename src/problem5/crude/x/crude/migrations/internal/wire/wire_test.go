@@ -0,0 +1,35 @@
+package wire_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/stretchr/testify/require"
+
+	"crude/x/crude/migrations/internal/wire"
+	"crude/x/crude/types"
+)
+
+func TestMessage(t *testing.T) {
+	resource := types.Resource{Id: 3, Name: "a", Owner: "A", Version: 2, Tags: []string{"x", "y"}}
+	bz, err := proto.Marshal(&resource)
+	require.NoError(t, err)
+
+	m, err := wire.Parse(bz)
+	require.NoError(t, err)
+	require.Equal(t, bz, m.Marshal())
+	require.Equal(t, uint64(3), m.Uint64(1))
+	require.Equal(t, []byte("A"), m.Bytes(4))
+	require.False(t, m.Has(5))
+
+	// Edited fields are encoded in field number order
+	m = m.Without(9).AppendUint64(9, 1).AppendBytes(5, []byte("B"))
+	resource.Version = 1
+	resource.Creator = "B"
+	bz, err = proto.Marshal(&resource)
+	require.NoError(t, err)
+	require.Equal(t, bz, m.Marshal())
+
+	_, err = wire.Parse([]byte{0x0a, 0x05})
+	require.Error(t, err)
+}
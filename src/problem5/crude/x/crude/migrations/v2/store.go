@@ -0,0 +1,105 @@
+package v2
+
+import (
+	"context"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/store"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"google.golang.org/protobuf/encoding/protowire"
+
+	"crude/x/crude/migrations/internal/wire"
+	"crude/x/crude/types"
+)
+
+// Legacy (v1) key prefixes. In v1 every resource key repeated the value
+// prefix inside the already prefixed store: ResourceKey|ResourceKey|"/"|id.
+const (
+	ResourceKey        = "Resource/value/"
+	ResourceCountKey   = "Resource/count/"
+	ResourceCreatorKey = "Resource/creator/"
+)
+
+// Collections prefixes of the v2 layout.
+var (
+	ResourcePrefix      = collections.NewPrefix(1)
+	ResourceCountPrefix = collections.NewPrefix(2)
+)
+
+// ResourceIDField is the wire number of the resource id, the same in v1 and v2.
+const ResourceIDField protowire.Number = 1
+
+// MigrateStore performs in-place store migrations from v1 to v2. The
+// migration moves every resource and the resource count from the hand
+// rolled v1 keys to the collections layout and drops the v1 creator index.
+// Records are moved as they are encoded, the v2 resource encoding being the
+// v1 one, and the indexes are rebuilt by the last migration.
+func MigrateStore(ctx context.Context, storeService store.KVStoreService) error {
+	store := runtime.KVStoreAdapter(storeService.OpenKVStore(ctx))
+
+	if err := migrateResources(store); err != nil {
+		return err
+	}
+
+	// The collections sequence stores the count big endian, as v1 did
+	if bz := store.Get(types.KeyPrefix(ResourceCountKey)); bz != nil {
+		store.Set(ResourceCountPrefix, bz)
+		store.Delete(types.KeyPrefix(ResourceCountKey))
+	}
+
+	deletePrefix(store, types.KeyPrefix(ResourceCreatorKey))
+
+	return nil
+}
+
+// migrateResources moves every v1 resource entry under its collections key.
+func migrateResources(store storetypes.KVStore) error {
+	resourceStore := prefix.NewStore(store, types.KeyPrefix(ResourceKey))
+	iterator := storetypes.KVStorePrefixIterator(resourceStore, []byte{})
+
+	var keys, newKeys, values [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		m, err := wire.Parse(iterator.Value())
+		if err != nil {
+			iterator.Close()
+			return fmt.Errorf("cannot decode resource %X: %w", iterator.Key(), err)
+		}
+		key, err := collections.EncodeKeyWithPrefix(ResourcePrefix, collections.Uint64Key, m.Uint64(ResourceIDField))
+		if err != nil {
+			iterator.Close()
+			return err
+		}
+		keys = append(keys, iterator.Key())
+		newKeys = append(newKeys, key)
+		values = append(values, iterator.Value())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		resourceStore.Delete(key)
+	}
+	for i, key := range newKeys {
+		store.Set(key, values[i])
+	}
+
+	return nil
+}
+
+// deletePrefix removes every key under the given prefix.
+func deletePrefix(store storetypes.KVStore, p []byte) {
+	prefixStore := prefix.NewStore(store, p)
+	iterator := storetypes.KVStorePrefixIterator(prefixStore, []byte{})
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		prefixStore.Delete(key)
+	}
+}
@@ -0,0 +1,108 @@
+package v2_test
+
+import (
+	"encoding/binary"
+	"testing"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
+
+	"crude/x/crude/keeper"
+	v2 "crude/x/crude/migrations/v2"
+	"crude/x/crude/types"
+)
+
+// legacyResourceKey mirrors the v1 GetResourceIDBytes layout.
+func legacyResourceKey(id uint64) []byte {
+	bz := types.KeyPrefix(v2.ResourceKey)
+	bz = append(bz, types.KeyPrefix(v2.ResourceKey)...)
+	bz = append(bz, []byte("/")...)
+	return binary.BigEndian.AppendUint64(bz, id)
+}
+
+// legacyResource is the v1 encoding {1:id, 2:name, 3:value, 4:creator},
+// zero values omitted as proto3 does.
+func legacyResource(id uint64, name string, value uint64, creator string) []byte {
+	var bz []byte
+	if id != 0 {
+		bz = protowire.AppendTag(bz, 1, protowire.VarintType)
+		bz = protowire.AppendVarint(bz, id)
+	}
+	bz = protowire.AppendTag(bz, 2, protowire.BytesType)
+	bz = protowire.AppendString(bz, name)
+	if value != 0 {
+		bz = protowire.AppendTag(bz, 3, protowire.VarintType)
+		bz = protowire.AppendVarint(bz, value)
+	}
+	bz = protowire.AppendTag(bz, 4, protowire.BytesType)
+	return protowire.AppendString(bz, creator)
+}
+
+func TestMigrateStore(t *testing.T) {
+	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+	tKey := storetypes.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(storeKey, tKey)
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	storeService := runtime.NewKVStoreService(storeKey)
+	k := keeper.NewKeeper(cdc, storeService, log.NewNopLogger(), authtypes.NewModuleAddress(govtypes.ModuleName).String(), nil, nil, nil)
+
+	// Seed the store with the v1 layout
+	store := ctx.KVStore(storeKey)
+	resources := []struct {
+		id      uint64
+		name    string
+		value   uint64
+		creator string
+	}{
+		{id: 0, name: "a", value: 1, creator: "A"},
+		{id: 2, name: "b", value: 0, creator: "B"},
+		{id: 3, name: "c", value: 1 << 40, creator: "A"},
+	}
+	for _, resource := range resources {
+		store.Set(legacyResourceKey(resource.id), legacyResource(resource.id, resource.name, resource.value, resource.creator))
+		creatorKey := append(types.KeyPrefix(v2.ResourceCreatorKey), []byte(resource.creator+"/")...)
+		creatorKey = binary.BigEndian.AppendUint64(creatorKey, resource.id)
+		store.Set(creatorKey, binary.BigEndian.AppendUint64(nil, resource.id))
+	}
+	store.Set(types.KeyPrefix(v2.ResourceCountKey), binary.BigEndian.AppendUint64(nil, 4))
+
+	require.NoError(t, keeper.NewMigrator(k).Migrate1to2(ctx))
+
+	// The v1 keys are gone
+	for _, resource := range resources {
+		require.False(t, store.Has(legacyResourceKey(resource.id)))
+	}
+	require.False(t, store.Has(types.KeyPrefix(v2.ResourceCountKey)))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte("Resource/"))
+	require.False(t, iterator.Valid())
+	iterator.Close()
+
+	// The records moved unchanged, legacy value included
+	for _, resource := range resources {
+		key, err := collections.EncodeKeyWithPrefix(v2.ResourcePrefix, collections.Uint64Key, resource.id)
+		require.NoError(t, err)
+		require.Equal(t, legacyResource(resource.id, resource.name, resource.value, resource.creator), store.Get(key))
+
+		// and the keeper reads the fields it still declares
+		migrated, found := k.GetResource(ctx, resource.id)
+		require.True(t, found)
+		require.Equal(t, resource.name, migrated.Name)
+		require.Equal(t, resource.creator, migrated.Owner)
+	}
+	require.Equal(t, uint64(4), k.GetResourceCount(ctx))
+
+	// New resources follow, once the last migration built the aggregates
+	require.NoError(t, k.RebuildResourceStats(ctx))
+	id, err := k.AppendResource(ctx, types.Resource{Owner: "A"})
+	require.NoError(t, err)
+	require.Equal(t, uint64(4), id)
+}
@@ -0,0 +1,38 @@
+package v3
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/store"
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"google.golang.org/protobuf/encoding/protowire"
+
+	"crude/x/crude/migrations/internal/wire"
+)
+
+// ResourcePrefix is the collections prefix of the resources in v3.
+var ResourcePrefix = collections.NewPrefix(1)
+
+// Wire numbers of the v3 resource fields. The owner field is the v2 creator.
+const (
+	ResourceOwnerField   protowire.Number = 4
+	ResourceCreatorField protowire.Number = 5
+)
+
+// MigrateStore performs in-place store migrations from v2 to v3. The v2
+// creator field is now the owner field (same wire tag), so the migration
+// only backfills the new creator field with the original owner. The creator
+// and owner indexes are rebuilt by the last migration.
+func MigrateStore(ctx context.Context, storeService store.KVStoreService) error {
+	store := prefix.NewStore(runtime.KVStoreAdapter(storeService.OpenKVStore(ctx)), ResourcePrefix)
+
+	return wire.Rewrite(store, func(m wire.Message) wire.Message {
+		owner := m.Bytes(ResourceOwnerField)
+		if len(m.Bytes(ResourceCreatorField)) > 0 || len(owner) == 0 {
+			return m
+		}
+		return m.Without(ResourceCreatorField).AppendBytes(ResourceCreatorField, owner)
+	})
+}
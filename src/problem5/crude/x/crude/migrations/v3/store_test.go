@@ -0,0 +1,33 @@
+package v3_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	keepertest "crude/testutil/keeper"
+	"crude/x/crude/keeper"
+	"crude/x/crude/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	k, ctx := keepertest.CrudeKeeper(t)
+
+	// v2 resources only carry the owner (the former creator field)
+	for _, owner := range []string{"A", "B", "A", ""} {
+		_, err := k.AppendResource(ctx, types.Resource{Owner: owner, Version: 1})
+		require.NoError(t, err)
+	}
+	// unless they were already migrated
+	_, err := k.AppendResource(ctx, types.Resource{Owner: "B", Creator: "C"})
+	require.NoError(t, err)
+
+	require.NoError(t, keeper.NewMigrator(k).Migrate2to3(ctx))
+
+	resources := k.GetAllResource(ctx)
+	for _, resource := range resources[:4] {
+		require.Equal(t, resource.Owner, resource.Creator)
+		require.Equal(t, uint64(1), resource.Version)
+	}
+	require.Equal(t, "C", resources[4].Creator)
+}
@@ -0,0 +1,60 @@
+package v4
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/store"
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"google.golang.org/protobuf/encoding/protowire"
+
+	"crude/x/crude/migrations/internal/wire"
+)
+
+// Collections prefixes of the v4 layout.
+var (
+	ParamsPrefix   = collections.NewPrefix("p_crude")
+	ResourcePrefix = collections.NewPrefix(1)
+)
+
+// ResourceVersionField is the wire number of the v4 resource version.
+const ResourceVersionField protowire.Number = 9
+
+// Wire numbers of the params shipped with v4 whose defaults are not zero.
+const (
+	ParamsMaxNameLengthField     protowire.Number = 1
+	ParamsNameCharsetField       protowire.Number = 2
+	ParamsMaxPrunedPerBlockField protowire.Number = 6
+)
+
+// Defaults of the params shipped with v4.
+const (
+	DefaultMaxNameLength     uint64 = 64
+	DefaultNameCharset              = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789 -_."
+	DefaultMaxPrunedPerBlock uint64 = 100
+)
+
+// MigrateStore performs in-place store migrations from v3 to v4. Resources
+// now carry a version starting at 1, so the existing ones are backfilled with
+// the initial version, leaving zero to mean that no version is expected.
+//
+// The name limits and the expiry pruning params first shipped with v4. A zero
+// disables them, and is what an upgraded chain decodes, so they are set to
+// their defaults unless they were already set.
+func MigrateStore(ctx context.Context, storeService store.KVStoreService) error {
+	kvStore := runtime.KVStoreAdapter(storeService.OpenKVStore(ctx))
+
+	err := wire.Rewrite(prefix.NewStore(kvStore, ResourcePrefix), func(m wire.Message) wire.Message {
+		return m.DefaultUint64(ResourceVersionField, 1)
+	})
+	if err != nil {
+		return err
+	}
+
+	return wire.Update(kvStore, ParamsPrefix, func(m wire.Message) wire.Message {
+		return m.DefaultUint64(ParamsMaxNameLengthField, DefaultMaxNameLength).
+			DefaultBytes(ParamsNameCharsetField, []byte(DefaultNameCharset)).
+			DefaultUint64(ParamsMaxPrunedPerBlockField, DefaultMaxPrunedPerBlock)
+	})
+}
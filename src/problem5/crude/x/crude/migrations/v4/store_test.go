@@ -0,0 +1,41 @@
+package v4_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	keepertest "crude/testutil/keeper"
+	"crude/x/crude/keeper"
+	"crude/x/crude/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	k, ctx := keepertest.CrudeKeeper(t)
+
+	// v3 resources carry no version
+	for _, owner := range []string{"A", "B"} {
+		_, err := k.AppendResource(ctx, types.Resource{Owner: owner, Creator: owner, Tags: []string{"env:prod"}})
+		require.NoError(t, err)
+	}
+	_, err := k.AppendResource(ctx, types.Resource{Owner: "C", Creator: "C", Version: 3})
+	require.NoError(t, err)
+
+	// v3 params carry none of the limits, except one set by governance
+	require.NoError(t, k.SetParams(ctx, types.Params{MaxNameLength: 12}))
+
+	require.NoError(t, keeper.NewMigrator(k).Migrate3to4(ctx))
+
+	resources := k.GetAllResource(ctx)
+	for _, resource := range resources[:2] {
+		require.Equal(t, uint64(1), resource.Version)
+		require.Equal(t, []string{"env:prod"}, resource.Tags)
+	}
+	require.Equal(t, uint64(3), resources[2].Version)
+
+	params := k.GetParams(ctx)
+	require.Equal(t, uint64(12), params.MaxNameLength)
+	require.Equal(t, types.DefaultNameCharset, params.NameCharset)
+	require.Equal(t, types.DefaultMaxPrunedPerBlock, params.MaxPrunedPerBlock)
+	require.Zero(t, params.MaxAttributeKeyLength)
+}
@@ -0,0 +1,87 @@
+package v5
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/store"
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"google.golang.org/protobuf/encoding/protowire"
+
+	"crude/x/crude/migrations/internal/wire"
+)
+
+// Collections prefixes of the v5 layout.
+var (
+	ParamsPrefix           = collections.NewPrefix("p_crude")
+	ResourcePrefix         = collections.NewPrefix(1)
+	ResourceRevisionPrefix = collections.NewPrefix(4)
+)
+
+// Wire numbers of the v4 uint64 value fields, reserved since v5, and of the
+// v5 payload fields replacing them.
+const (
+	ResourceValueField           protowire.Number = 3
+	ResourcePayloadField         protowire.Number = 13
+	ResourceRevisionValueField   protowire.Number = 4
+	ResourceRevisionPayloadField protowire.Number = 8
+
+	// PayloadUint64Field is the uint64 arm of the payload
+	PayloadUint64Field protowire.Number = 1
+)
+
+// Wire numbers of the attribute params shipped with v5.
+const (
+	ParamsMaxAttributeKeyLengthField    protowire.Number = 7
+	ParamsMaxAttributeValueLengthField  protowire.Number = 8
+	ParamsMaxAttributesPerResourceField protowire.Number = 9
+)
+
+// Defaults of the attribute params shipped with v5.
+const (
+	DefaultMaxAttributeKeyLength    uint64 = 64
+	DefaultMaxAttributeValueLength  uint64 = 256
+	DefaultMaxAttributesPerResource uint64 = 16
+)
+
+// MigrateStore performs in-place store migrations from v4 to v5. The bare
+// uint64 value of resources and revisions became a typed payload, so the
+// value is read from the raw v4 encoding, which the current types no longer
+// decode, and moved to the uint64 arm of the payload.
+//
+// The attribute limits first shipped with v5 and are set to their defaults
+// unless they were already set, zero disabling them.
+func MigrateStore(ctx context.Context, storeService store.KVStoreService) error {
+	kvStore := runtime.KVStoreAdapter(storeService.OpenKVStore(ctx))
+
+	if err := wire.Rewrite(prefix.NewStore(kvStore, ResourcePrefix), movePayload(ResourceValueField, ResourcePayloadField)); err != nil {
+		return err
+	}
+	if err := wire.Rewrite(prefix.NewStore(kvStore, ResourceRevisionPrefix), movePayload(ResourceRevisionValueField, ResourceRevisionPayloadField)); err != nil {
+		return err
+	}
+
+	return wire.Update(kvStore, ParamsPrefix, func(m wire.Message) wire.Message {
+		return m.DefaultUint64(ParamsMaxAttributeKeyLengthField, DefaultMaxAttributeKeyLength).
+			DefaultUint64(ParamsMaxAttributeValueLengthField, DefaultMaxAttributeValueLength).
+			DefaultUint64(ParamsMaxAttributesPerResourceField, DefaultMaxAttributesPerResource)
+	})
+}
+
+// movePayload moves the legacy value field of a record, zero if absent, to the
+// uint64 arm of its payload field, unless the record already holds a payload.
+func movePayload(valueField, payloadField protowire.Number) func(m wire.Message) wire.Message {
+	return func(m wire.Message) wire.Message {
+		value := m.Uint64(valueField)
+		m = m.Without(valueField)
+		if m.Has(payloadField) {
+			return m
+		}
+
+		// A oneof arm is encoded even when it holds its zero value
+		payload := protowire.AppendTag(nil, PayloadUint64Field, protowire.VarintType)
+		payload = protowire.AppendVarint(payload, value)
+		return m.AppendBytes(payloadField, payload)
+	}
+}
@@ -0,0 +1,80 @@
+package v5_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
+
+	"crude/x/crude/keeper"
+	v5 "crude/x/crude/migrations/v5"
+	"crude/x/crude/types"
+)
+
+// legacyEncoding appends the v4 uint64 value field to an encoded record,
+// omitted like proto3 does when the value is zero.
+func legacyEncoding(bz []byte, field protowire.Number, value uint64) []byte {
+	if value == 0 {
+		return bz
+	}
+	bz = protowire.AppendTag(bz, field, protowire.VarintType)
+	return protowire.AppendVarint(bz, value)
+}
+
+func TestMigrateStore(t *testing.T) {
+	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContext(storeKey, storetypes.NewTransientStoreKey("transient_test"))
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	k := keeper.NewKeeper(cdc, runtime.NewKVStoreService(storeKey), log.NewNopLogger(), authtypes.NewModuleAddress(govtypes.ModuleName).String(), nil, nil, nil)
+
+	// Seed the store with the v4 encoding of resources and revisions
+	store := ctx.KVStore(storeKey)
+	values := []uint64{0, 7, 1 << 40}
+	for id, value := range values {
+		resource := types.Resource{Id: uint64(id), Name: "a", Owner: "A", Creator: "A", Tags: []string{"env:prod"}}
+		key, err := collections.EncodeKeyWithPrefix(types.ResourceKey, collections.Uint64Key, uint64(id))
+		require.NoError(t, err)
+		store.Set(key, legacyEncoding(cdc.MustMarshal(&resource), v5.ResourceValueField, value))
+
+		revision := types.ResourceRevision{ResourceId: uint64(id), Name: "a", Signer: "A"}
+		key, err = collections.EncodeKeyWithPrefix(types.ResourceRevisionKey, collections.PairKeyCodec(collections.Uint64Key, collections.Uint64Key), collections.Join(uint64(id), uint64(0)))
+		require.NoError(t, err)
+		store.Set(key, legacyEncoding(cdc.MustMarshal(&revision), v5.ResourceRevisionValueField, value))
+	}
+
+	require.NoError(t, keeper.NewMigrator(k).Migrate4to5(ctx))
+
+	for id, value := range values {
+		resource, found := k.GetResource(ctx, uint64(id))
+		require.True(t, found)
+		require.Equal(t, types.NewUint64Payload(value), resource.Payload)
+		require.Equal(t, "a", resource.Name)
+		require.Equal(t, []string{"env:prod"}, resource.Tags)
+
+		revision, found := k.GetResourceRevision(ctx, uint64(id), 0)
+		require.True(t, found)
+		require.Equal(t, types.NewUint64Payload(value), revision.Payload)
+	}
+
+	// The attribute limits shipped with v5 are set
+	params := k.GetParams(ctx)
+	require.Equal(t, types.DefaultMaxAttributeKeyLength, params.MaxAttributeKeyLength)
+	require.Equal(t, types.DefaultMaxAttributeValueLength, params.MaxAttributeValueLength)
+	require.Equal(t, types.DefaultMaxAttributesPerResource, params.MaxAttributesPerResource)
+
+	// Migrated records carry no trace of the legacy field
+	key, err := collections.EncodeKeyWithPrefix(types.ResourceKey, collections.Uint64Key, 1)
+	require.NoError(t, err)
+	var resource types.Resource
+	require.NoError(t, cdc.Unmarshal(store.Get(key), &resource))
+	require.Equal(t, cdc.MustMarshal(&resource), store.Get(key))
+}
@@ -0,0 +1,35 @@
+package v6
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/store"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"google.golang.org/protobuf/encoding/protowire"
+
+	"crude/x/crude/migrations/internal/wire"
+)
+
+// ParamsPrefix is the collections prefix of the params in v6.
+var ParamsPrefix = collections.NewPrefix("p_crude")
+
+// ParamsMaxSchemaLengthField is the wire number of the schema length param.
+const ParamsMaxSchemaLengthField protowire.Number = 10
+
+// DefaultMaxSchemaLength is the default of the schema length param.
+const DefaultMaxSchemaLength uint64 = 16 * 1024
+
+// MigrateStore performs in-place store migrations from v5 to v6. Resources
+// are now indexed by name. The records are unchanged and the name index is
+// built with the other indexes by the last migration.
+//
+// The schema params first shipped with v6, so the schema length limit is set
+// to its default unless it was already set, zero disabling it.
+func MigrateStore(ctx context.Context, storeService store.KVStoreService) error {
+	kvStore := runtime.KVStoreAdapter(storeService.OpenKVStore(ctx))
+
+	return wire.Update(kvStore, ParamsPrefix, func(m wire.Message) wire.Message {
+		return m.DefaultUint64(ParamsMaxSchemaLengthField, DefaultMaxSchemaLength)
+	})
+}
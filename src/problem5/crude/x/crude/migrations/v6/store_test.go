@@ -0,0 +1,27 @@
+package v6_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	keepertest "crude/testutil/keeper"
+	"crude/x/crude/keeper"
+	"crude/x/crude/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	k, ctx := keepertest.CrudeKeeper(t)
+
+	// v5 params carry no schema limit
+	params := types.DefaultParams()
+	params.MaxSchemaLength = 0
+	params.MaxPrunedPerBlock = 0
+	require.NoError(t, k.SetParams(ctx, params))
+
+	require.NoError(t, keeper.NewMigrator(k).Migrate5to6(ctx))
+
+	// Only the param shipped with v6 is set, pruning stays disabled
+	params.MaxSchemaLength = types.DefaultMaxSchemaLength
+	require.Equal(t, params, k.GetParams(ctx))
+}
@@ -0,0 +1,13 @@
+package v7
+
+import (
+	"context"
+)
+
+// MigrateStore performs in-place store migrations from v6 to v7. Aggregates
+// over the live resources are now maintained as resources are written. The
+// records are unchanged and the aggregates are computed by the last migration,
+// once the resources are in their final layout.
+func MigrateStore(ctx context.Context) error {
+	return nil
+}
@@ -0,0 +1,47 @@
+package v8
+
+import (
+	"context"
+
+	"crude/x/crude/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ResourceKeeper is the subset of the keeper used to bind the IBC port and to
+// rebuild the state derived from the resources.
+type ResourceKeeper interface {
+	GetPort(ctx context.Context) string
+	SetPort(ctx context.Context, portID string) error
+	ShouldBound(ctx sdk.Context, portID string) bool
+	BindPort(ctx sdk.Context, portID string) error
+	RebuildResourceIndexes(ctx context.Context) error
+	RebuildResourceStats(ctx context.Context) error
+}
+
+// MigrateStore performs in-place store migrations from v7 to v8. The module
+// now replicates resources over IBC, so it records the port it owns and binds
+// it, which genesis does on new chains.
+//
+// Earlier migrations only rewrite the records of their own layout. Being the
+// last migration, this one rebuilds the secondary indexes and the aggregates
+// from the migrated resources, whatever version the store started from.
+func MigrateStore(ctx sdk.Context, k ResourceKeeper) error {
+	portID := k.GetPort(ctx)
+	if portID == "" {
+		portID = types.PortID
+		if err := k.SetPort(ctx, portID); err != nil {
+			return err
+		}
+	}
+	if k.ShouldBound(ctx, portID) {
+		if err := k.BindPort(ctx, portID); err != nil {
+			return err
+		}
+	}
+
+	if err := k.RebuildResourceIndexes(ctx); err != nil {
+		return err
+	}
+	return k.RebuildResourceStats(ctx)
+}
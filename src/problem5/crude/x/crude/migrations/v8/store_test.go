@@ -0,0 +1,76 @@
+package v8_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/stretchr/testify/require"
+
+	"crude/x/crude/keeper"
+	"crude/x/crude/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContext(storeKey, storetypes.NewTransientStoreKey("transient_test"))
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	k := keeper.NewKeeper(cdc, runtime.NewKVStoreService(storeKey), log.NewNopLogger(), authtypes.NewModuleAddress(govtypes.ModuleName).String(), nil, nil, nil)
+
+	// v7 stores no port, and earlier migrations left the indexes and the
+	// aggregates behind the resources
+	store := ctx.KVStore(storeKey)
+	for id, creator := range []string{"A", "B", "A"} {
+		resource := types.Resource{
+			Id:      uint64(id),
+			Name:    []string{"a", "b", "a"}[id],
+			Owner:   creator,
+			Creator: creator,
+			Tags:    []string{"env:prod"},
+			Payload: types.NewUint64Payload(uint64(10 * (id + 1))),
+		}
+		key, err := collections.EncodeKeyWithPrefix(types.ResourceKey, collections.Uint64Key, uint64(id))
+		require.NoError(t, err)
+		store.Set(key, cdc.MustMarshal(&resource))
+	}
+	key, err := collections.EncodeKeyWithPrefix(types.ResourceCreatorKey, collections.PairKeyCodec(collections.StringKey, collections.Uint64Key), collections.Join("", uint64(0)))
+	require.NoError(t, err)
+	store.Set(key, []byte{})
+	require.Empty(t, k.GetPort(ctx))
+	require.Empty(t, k.GetResourceIDsByName(ctx, "a"))
+	require.Zero(t, k.GetResourceTotals(ctx).LiveCount)
+
+	require.NoError(t, keeper.NewMigrator(k).Migrate7to8(ctx))
+	require.Equal(t, types.PortID, k.GetPort(ctx))
+
+	// The indexes and the aggregates are rebuilt from the resources
+	require.Equal(t, []uint64{0, 2}, k.GetResourceIDsByName(ctx, "a"))
+	require.Equal(t, []uint64{1}, k.GetResourceIDsByName(ctx, "b"))
+	require.Equal(t, []uint64{0, 2}, k.GetResourceIDsByCreator(ctx, "A"))
+	require.Empty(t, k.GetResourceIDsByCreator(ctx, ""))
+	require.Len(t, k.GetResourceIDsByTag(ctx, "env:prod"), 3)
+	totals := k.GetResourceTotals(ctx)
+	require.Equal(t, uint64(3), totals.LiveCount)
+	require.Equal(t, uint64(2), totals.CreatorCount)
+	require.Equal(t, math.NewInt(60), totals.ValueSum)
+	require.Equal(t, []types.CreatorResourceCount{{Creator: "A", Count: 2}, {Creator: "B", Count: 1}}, k.GetTopCreators(ctx, 10))
+	_, broken := keeper.ResourceIndexesInvariant(k)(ctx)
+	require.False(t, broken)
+	_, broken = keeper.ResourceStatsInvariant(k)(ctx)
+	require.False(t, broken)
+
+	// Running it again keeps a port that was already set and does not count
+	// the resources twice
+	require.NoError(t, k.SetPort(ctx, "other"))
+	require.NoError(t, keeper.NewMigrator(k).Migrate7to8(ctx))
+	require.Equal(t, "other", k.GetPort(ctx))
+	require.Equal(t, totals, k.GetResourceTotals(ctx))
+}
@@ -21,6 +21,14 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					RpcMethod: "ResourceAll",
 					Use:       "list-resource",
 					Short:     "List all resource",
+					FlagOptions: map[string]*autocliv1.FlagOptions{
+						"namespace":  {Usage: "Only list the resources of this namespace"},
+						"creator":    {Usage: "Only list the resources created by this address"},
+						"namePrefix": {Usage: "Only list the resources whose name starts with this prefix, in name order"},
+						"valueRange": {Usage: `Only list the uint64 resources within these bounds, e.g. {"min":"1","max":"10"}`},
+						"reverse":    {Usage: "List the resources in reverse order"},
+						"countOnly":  {Usage: "Only count the matching resources"},
+					},
 				},
 				{
 					RpcMethod:      "Resource",
@@ -28,6 +36,131 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Short:          "Shows a resource by id",
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}},
 				},
+				{
+					RpcMethod:      "ResourcesByCreator",
+					Use:            "list-resource-by-creator [address]",
+					Short:          "List all resource created by an address",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "creator"}},
+				},
+				{
+					RpcMethod:      "ResourcesByOwner",
+					Use:            "list-resource-by-owner [address]",
+					Short:          "List all resource currently owned by an address",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "owner"}},
+				},
+				{
+					RpcMethod:      "ResourceHistory",
+					Use:            "list-resource-history [id]",
+					Short:          "List the revision history of a resource",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}},
+				},
+				{
+					RpcMethod:      "ResourceAtRevision",
+					Use:            "show-resource-revision [id] [revision]",
+					Short:          "Shows a resource as it was at a given revision",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}, {ProtoField: "revision"}},
+				},
+				{
+					RpcMethod: "ResourceTransferOfferAll",
+					Use:       "list-resource-transfer-offer",
+					Short:     "List all pending resource transfer offers",
+				},
+				{
+					RpcMethod:      "ResourceTransferOffer",
+					Use:            "show-resource-transfer-offer [id]",
+					Short:          "Shows the pending transfer offer of a resource",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "resourceId"}},
+				},
+				{
+					RpcMethod:      "ResourcesByAttribute",
+					Use:            "list-resource-by-attribute [key] [value]",
+					Short:          "List all resource carrying an attribute key/value pair",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "key"}, {ProtoField: "value"}},
+				},
+				{
+					RpcMethod:      "ResourcesByTag",
+					Use:            "list-resource-by-tag [tag]",
+					Short:          "List all resource carrying a tag",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "tag"}},
+				},
+				{
+					RpcMethod:      "ResourceRoles",
+					Use:            "list-resource-role [id]",
+					Short:          "List the roles granted on a resource",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}},
+				},
+				{
+					RpcMethod:      "EditableResources",
+					Use:            "list-editable-resource [address]",
+					Short:          "List the resource an address has been granted edit access to",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}},
+				},
+				{
+					RpcMethod: "ResourceSchemaAll",
+					Use:       "list-resource-schema",
+					Short:     "List every version of every resource schema",
+				},
+				{
+					RpcMethod:      "ResourceSchema",
+					Use:            "show-resource-schema [id] [version]",
+					Short:          "Shows a version of a resource schema, the latest one for version 0",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}, {ProtoField: "version"}},
+				},
+				{
+					RpcMethod:      "ResourceByName",
+					Use:            "show-resource-by-name [name]",
+					Short:          "Shows a resource by name, the one with the lowest id if several share the name",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "name"}},
+				},
+				{
+					RpcMethod:      "ResourcesByNamePrefix",
+					Use:            "list-resource-by-name-prefix [prefix]",
+					Short:          "List all resource whose name starts with a prefix, in name order",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "prefix"}},
+				},
+				{
+					RpcMethod: "NamespaceAll",
+					Use:       "list-namespace",
+					Short:     "List all namespace",
+				},
+				{
+					RpcMethod:      "Namespace",
+					Use:            "show-namespace [name]",
+					Short:          "Shows a namespace",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "name"}},
+				},
+				{
+					RpcMethod:      "ResourcesInNamespace",
+					Use:            "list-resource-in-namespace [namespace]",
+					Short:          "List all resource of a namespace",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "namespace"}},
+				},
+				{
+					RpcMethod:      "ResourceWithProof",
+					Use:            "show-resource-with-proof [id]",
+					Short:          "Shows a resource with a Merkle proof of its presence or absence, see verify-resource-proof",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}},
+				},
+				{
+					RpcMethod: "ResourceStats",
+					Use:       "resource-stats",
+					Short:     "Shows aggregate statistics over the live resources",
+					FlagOptions: map[string]*autocliv1.FlagOptions{
+						"top": {Usage: "Number of creators to rank by resource count (default 10, at most 100)"},
+					},
+				},
+				{
+					RpcMethod:      "ResourceReplications",
+					Use:            "list-resource-replication [id]",
+					Short:          "List the IBC channels a resource is replicated to",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}},
+				},
+				{
+					RpcMethod:      "ResourceReplica",
+					Use:            "show-resource-replica [channel-id] [source-id]",
+					Short:          "Shows the replica of a resource received over an IBC channel",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "channelId"}, {ProtoField: "sourceId"}},
+				},
 				// this line is used by ignite scaffolding # autocli/query
 			},
 		},
@@ -40,16 +173,12 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Skip:      true, // skipped because authority gated
 				},
 				{
-					RpcMethod:      "CreateResource",
-					Use:            "create-resource [name] [value]",
-					Short:          "Create resource",
-					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "name"}, {ProtoField: "value"}},
+					RpcMethod: "CreateResource",
+					Skip:      true, // skipped because the custom create-resource command parses the value according to --value-type
 				},
 				{
-					RpcMethod:      "UpdateResource",
-					Use:            "update-resource [id] [name] [value]",
-					Short:          "Update resource",
-					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}, {ProtoField: "name"}, {ProtoField: "value"}},
+					RpcMethod: "UpdateResource",
+					Skip:      true, // skipped because the custom update-resource command parses the value according to --value-type
 				},
 				{
 					RpcMethod:      "DeleteResource",
@@ -57,6 +186,106 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Short:          "Delete resource",
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}},
 				},
+				{
+					RpcMethod:      "RevertResource",
+					Use:            "revert-resource [id] [revision]",
+					Short:          "Revert resource to an earlier revision",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}, {ProtoField: "revision"}},
+				},
+				{
+					RpcMethod:      "OfferResourceTransfer",
+					Use:            "offer-resource-transfer [id] [recipient]",
+					Short:          "Offer the ownership of a resource to another account",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}, {ProtoField: "recipient"}},
+				},
+				{
+					RpcMethod:      "AcceptResourceTransfer",
+					Use:            "accept-resource-transfer [id]",
+					Short:          "Accept a pending resource transfer offer",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}},
+				},
+				{
+					RpcMethod:      "CancelResourceTransfer",
+					Use:            "cancel-resource-transfer [id]",
+					Short:          "Cancel a pending resource transfer offer",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}},
+				},
+				{
+					RpcMethod:      "GrantResourceRole",
+					Use:            "grant-resource-role [id] [address] [editor|admin]",
+					Short:          "Grant a role on a resource to an account",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}, {ProtoField: "address"}, {ProtoField: "role"}},
+				},
+				{
+					RpcMethod:      "RevokeResourceRole",
+					Use:            "revoke-resource-role [id] [address]",
+					Short:          "Revoke the role of an account on a resource",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}, {ProtoField: "address"}},
+				},
+				{
+					RpcMethod:      "RenewResource",
+					Use:            "renew-resource [id]",
+					Short:          "Extend the expiry height or time of a resource",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}},
+				},
+				{
+					RpcMethod:      "AdjustResourceValue",
+					Use:            "adjust-resource-value [id] [delta]",
+					Short:          "Atomically add a signed delta to the value of a resource",
+					Example:        "cruded tx crude adjust-resource-value 3 -- -5",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}, {ProtoField: "delta"}},
+				},
+				{
+					RpcMethod:      "SetResourceAttribute",
+					Use:            "set-resource-attribute [id] [key] [value]",
+					Short:          "Add an attribute to a resource or replace its value",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}, {ProtoField: "key"}, {ProtoField: "value"}},
+				},
+				{
+					RpcMethod:      "RemoveResourceAttribute",
+					Use:            "remove-resource-attribute [id] [key]",
+					Short:          "Remove an attribute from a resource",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}, {ProtoField: "key"}},
+				},
+				{
+					RpcMethod: "BatchResourceOps",
+					Skip:      true, // skipped because the custom batch-resource-ops command reads the operations from a file
+				},
+				{
+					RpcMethod:      "RegisterResourceSchema",
+					Use:            "register-resource-schema [id] [document]",
+					Short:          "Register a new version of a JSON Schema resource names can be validated against",
+					Example:        `cruded tx crude register-resource-schema product '{"type":"object","required":["sku"]}'`,
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}, {ProtoField: "document"}},
+				},
+				{
+					RpcMethod: "ApproveResourceSchema",
+					Skip:      true, // skipped because authority gated
+				},
+				{
+					RpcMethod:      "CreateNamespace",
+					Use:            "create-namespace [name]",
+					Short:          "Create a namespace administered by the sender",
+					Example:        `cruded tx crude create-namespace acme --max-resources 100 --default-roles '{"address":"cosmos1...","role":"RESOURCE_ROLE_EDITOR"}'`,
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "name"}},
+				},
+				{
+					RpcMethod:      "UpdateNamespace",
+					Use:            "update-namespace [name]",
+					Short:          "Replace the quota and the default roles of a namespace, as its admin",
+					Example:        `cruded tx crude update-namespace acme --max-resources 200 --admin cosmos1...`,
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "name"}},
+				},
+				{
+					RpcMethod:      "ReplicateResource",
+					Use:            "replicate-resource [id] [channel-id]",
+					Short:          "Replicate a resource to the counterparty of an ordered crude IBC channel",
+					Example:        "cruded tx crude replicate-resource 3 channel-0 --timeout-period 30m",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}, {ProtoField: "channelId"}},
+					FlagOptions: map[string]*autocliv1.FlagOptions{
+						"timeoutPeriod": {Usage: "Time the packets of the replication may take to be relayed, 10m when unset"},
+					},
+				},
 				// this line is used by ignite scaffolding # autocli/tx
 			},
 		},
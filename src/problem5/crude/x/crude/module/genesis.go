@@ -9,13 +9,85 @@ import (
 
 // InitGenesis initializes the module's state from a provided genesis state.
 func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState) {
-	// Set all the resource
+	// Seed the aggregates over the resources, maintained from then on
+	if err := k.RebuildResourceStats(ctx); err != nil {
+		panic(err)
+	}
+
+	// Set all the resource, rebuilding the creator index along the way
 	for _, elem := range genState.ResourceList {
-		k.SetResource(ctx, elem)
+		if err := k.SetResource(ctx, elem); err != nil {
+			panic(err)
+		}
 	}
 
 	// Set resource count
-	k.SetResourceCount(ctx, genState.ResourceCount)
+	if err := k.SetResourceCount(ctx, genState.ResourceCount); err != nil {
+		panic(err)
+	}
+
+	// Set all the resource revisions
+	for _, elem := range genState.ResourceRevisionList {
+		if err := k.SetResourceRevision(ctx, elem); err != nil {
+			panic(err)
+		}
+	}
+
+	// Set all the pending resource transfer offers
+	for _, elem := range genState.ResourceTransferOfferList {
+		if err := k.SetResourceTransferOffer(ctx, elem); err != nil {
+			panic(err)
+		}
+	}
+
+	// Set all the resource role grants
+	for _, elem := range genState.ResourceRoleGrantList {
+		if err := k.SetResourceRoleGrant(ctx, elem); err != nil {
+			panic(err)
+		}
+	}
+
+	// Set all the resource schemas
+	for _, elem := range genState.ResourceSchemaList {
+		if err := k.SetResourceSchema(ctx, elem); err != nil {
+			panic(err)
+		}
+	}
+
+	// Set all the namespaces
+	for _, elem := range genState.NamespaceList {
+		if err := k.SetNamespace(ctx, elem); err != nil {
+			panic(err)
+		}
+	}
+
+	// Set all the resource replication subscriptions
+	for _, elem := range genState.ResourceReplicationList {
+		if err := k.SetResourceReplication(ctx, elem); err != nil {
+			panic(err)
+		}
+	}
+
+	// Set all the resource replica links
+	for _, elem := range genState.ResourceReplicaList {
+		if err := k.SetResourceReplica(ctx, elem); err != nil {
+			panic(err)
+		}
+	}
+
+	portID := genState.GetPortIdOrDefault()
+	if err := k.SetPort(ctx, portID); err != nil {
+		panic(err)
+	}
+	// Only try to bind to port if it is not already bound, since we may already own
+	// port capability from capability InitGenesis
+	if k.ShouldBound(ctx, portID) {
+		// module binds to the port on InitChain
+		// and claims the returned capability
+		if err := k.BindPort(ctx, portID); err != nil {
+			panic("could not claim port capability: " + err.Error())
+		}
+	}
 	// this line is used by starport scaffolding # genesis/module/init
 	if err := k.SetParams(ctx, genState.Params); err != nil {
 		panic(err)
@@ -29,6 +101,14 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 
 	genesis.ResourceList = k.GetAllResource(ctx)
 	genesis.ResourceCount = k.GetResourceCount(ctx)
+	genesis.ResourceRevisionList = k.GetAllResourceRevision(ctx)
+	genesis.ResourceTransferOfferList = k.GetAllResourceTransferOffer(ctx)
+	genesis.ResourceRoleGrantList = k.GetAllResourceRoleGrant(ctx)
+	genesis.ResourceSchemaList = k.GetAllResourceSchema(ctx)
+	genesis.NamespaceList = k.GetAllNamespace(ctx)
+	genesis.PortId = k.GetPort(ctx)
+	genesis.ResourceReplicationList = k.GetAllResourceReplication(ctx)
+	genesis.ResourceReplicaList = k.GetAllResourceReplica(ctx)
 	// this line is used by starport scaffolding # genesis/module/export
 
 	return genesis
@@ -2,6 +2,7 @@ package crude_test
 
 import (
 	"testing"
+	"time"
 
 	keepertest "crude/testutil/keeper"
 	"crude/testutil/nullify"
@@ -17,13 +18,80 @@ func TestGenesis(t *testing.T) {
 
 		ResourceList: []types.Resource{
 			{
-				Id: 0,
+				Id:      0,
+				Owner:   "A",
+				Creator: "A",
 			},
 			{
-				Id: 1,
+				Id:        1,
+				Owner:     "B",
+				Creator:   "B",
+				Namespace: "acme",
 			},
 		},
 		ResourceCount: 2,
+		ResourceRevisionList: []types.ResourceRevision{
+			{
+				ResourceId: 0,
+				Revision:   0,
+			},
+			{
+				ResourceId: 1,
+				Revision:   0,
+			},
+		},
+		ResourceTransferOfferList: []types.ResourceTransferOffer{
+			{
+				ResourceId: 1,
+				Owner:      "B",
+				Recipient:  "A",
+			},
+		},
+		ResourceRoleGrantList: []types.ResourceRoleGrant{
+			{
+				ResourceId: 0,
+				Address:    "B",
+				Role:       types.ResourceRole_RESOURCE_ROLE_EDITOR,
+			},
+		},
+		ResourceSchemaList: []types.ResourceSchema{
+			{
+				Id:       "product",
+				Version:  1,
+				Owner:    "A",
+				Document: `{"type":"object"}`,
+			},
+			{
+				Id:       "product",
+				Version:  2,
+				Owner:    "A",
+				Document: `{"type":"object","required":["sku"]}`,
+				Approved: true,
+			},
+		},
+		NamespaceList: []types.Namespace{
+			{
+				Name:         "acme",
+				Admin:        "B",
+				MaxResources: 10,
+				DefaultRoles: []types.NamespaceRoleGrant{{Address: "A", Role: types.ResourceRole_RESOURCE_ROLE_EDITOR}},
+			},
+		},
+		ResourceReplicationList: []types.ResourceReplication{
+			{
+				ResourceId:    0,
+				ChannelId:     "channel-0",
+				TimeoutPeriod: time.Minute,
+				ReplicaId:     4,
+			},
+		},
+		ResourceReplicaList: []types.ResourceReplica{
+			{
+				ChannelId:  "channel-0",
+				SourceId:   7,
+				ResourceId: 1,
+			},
+		},
 		// this line is used by starport scaffolding # genesis/test/state
 	}
 
@@ -37,5 +105,23 @@ func TestGenesis(t *testing.T) {
 
 	require.ElementsMatch(t, genesisState.ResourceList, got.ResourceList)
 	require.Equal(t, genesisState.ResourceCount, got.ResourceCount)
+	require.ElementsMatch(t, genesisState.ResourceRevisionList, got.ResourceRevisionList)
+	require.Equal(t, []uint64{0}, k.GetResourceIDsByCreator(ctx, "A"))
+	require.Equal(t, []uint64{1}, k.GetResourceIDsByCreator(ctx, "B"))
+	require.Equal(t, []uint64{1}, k.GetResourceIDsByOwner(ctx, "B"))
+	require.ElementsMatch(t, genesisState.ResourceTransferOfferList, got.ResourceTransferOfferList)
+	require.ElementsMatch(t, genesisState.ResourceRoleGrantList, got.ResourceRoleGrantList)
+	require.ElementsMatch(t, genesisState.ResourceSchemaList, got.ResourceSchemaList)
+	latest, found := k.GetLatestResourceSchema(ctx, "product")
+	require.True(t, found)
+	require.Equal(t, uint64(2), latest.Version)
+	require.ElementsMatch(t, genesisState.NamespaceList, got.NamespaceList)
+	require.Equal(t, []uint64{1}, k.GetResourceIDsByNamespace(ctx, "acme"))
+	require.Equal(t, uint64(2), k.GetResourceTotals(ctx).LiveCount)
+	require.Equal(t, uint64(2), k.GetResourceTotals(ctx).CreatorCount)
+	require.Equal(t, types.PortID, got.PortId)
+	require.ElementsMatch(t, genesisState.ResourceReplicationList, got.ResourceReplicationList)
+	require.Equal(t, []uint64{0}, k.GetChannelReplicationIDs(ctx, "channel-0"))
+	require.ElementsMatch(t, genesisState.ResourceReplicaList, got.ResourceReplicaList)
 	// this line is used by starport scaffolding # genesis/test/assert
 }
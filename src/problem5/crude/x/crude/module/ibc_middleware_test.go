@@ -0,0 +1,136 @@
+package crude_test
+
+import (
+	"strconv"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+	"github.com/stretchr/testify/require"
+
+	"crude/x/crude/types"
+)
+
+// newTransferPath opens a transfer channel between two chains
+func newTransferPath(t *testing.T) *ibctesting.Path {
+	coord := ibctesting.NewCoordinator(t, 2)
+	path := ibctesting.NewTransferPath(coord.GetChain(ibctesting.GetChainID(1)), coord.GetChain(ibctesting.GetChainID(2)))
+	coord.Setup(path)
+
+	return path
+}
+
+// transferWithMemo sends tokens from the chain of endpoint B to that of
+// endpoint A, then relays the packet and returns its acknowledgement
+func transferWithMemo(t *testing.T, path *ibctesting.Path, amount int64, memo string) channeltypes.Acknowledgement {
+	t.Helper()
+	chainB := path.EndpointB.Chain
+	msg := transfertypes.NewMsgTransfer(
+		path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID,
+		sdk.NewInt64Coin(sdk.DefaultBondDenom, amount),
+		chainB.SenderAccount.GetAddress().String(), path.EndpointA.Chain.SenderAccount.GetAddress().String(),
+		path.EndpointA.Chain.GetTimeoutHeight(), 0, memo,
+	)
+	res, err := chainB.SendMsgs(msg)
+	require.NoError(t, err)
+	packet, err := ibctesting.ParsePacketFromEvents(res.Events)
+	require.NoError(t, err)
+	_, ackBytes, err := path.RelayPacketWithResults(packet)
+	require.NoError(t, err)
+
+	var ack channeltypes.Acknowledgement
+	require.NoError(t, transfertypes.ModuleCdc.UnmarshalJSON(ackBytes, &ack))
+	return ack
+}
+
+func TestTransferMemo(t *testing.T) {
+	path := newTransferPath(t)
+	chainA, chainB := path.EndpointA.Chain, path.EndpointB.Chain
+	crudeA := crudeApp(chainA)
+	sender := chainB.SenderAccount.GetAddress().String()
+	intermediary := types.IntermediaryAccount(path.EndpointA.ChannelID, sender)
+	voucher := transfertypes.ParseDenomTrace(transfertypes.GetPrefixedDenom(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, sdk.DefaultBondDenom)).IBCDenom()
+
+	ack := transferWithMemo(t, path, 100, `{"crude":{"create":{"name":"widget","payload":{"uint64Value":"5"},"tags":["ibc"]}}}`)
+	require.True(t, ack.Success(), ack.GetError())
+
+	// The intermediary account received the tokens and owns the resource
+	require.Equal(t, sdkmath.NewInt(100), crudeA.BankKeeper.GetBalance(chainA.GetContext(), intermediary, voucher).Amount)
+	ids := crudeA.CrudeKeeper.GetResourceIDsByOwner(chainA.GetContext(), intermediary.String())
+	require.Len(t, ids, 1)
+	resource, found := crudeA.CrudeKeeper.GetResource(chainA.GetContext(), ids[0])
+	require.True(t, found)
+	require.Equal(t, "widget", resource.Name)
+	require.Equal(t, intermediary.String(), resource.Creator)
+	require.Equal(t, types.NewUint64Payload(5), resource.Payload)
+	require.Equal(t, []string{"ibc"}, resource.Tags)
+
+	// A later transfer of the same sender updates it
+	ack = transferWithMemo(t, path, 1, `{"crude":{"update":{"id":"`+strconv.FormatUint(ids[0], 10)+`","name":"gadget"}}}`)
+	require.True(t, ack.Success(), ack.GetError())
+	resource, found = crudeA.CrudeKeeper.GetResource(chainA.GetContext(), ids[0])
+	require.True(t, found)
+	require.Equal(t, "gadget", resource.Name)
+	require.Equal(t, uint64(2), resource.Version)
+	require.Equal(t, sdkmath.NewInt(101), crudeA.BankKeeper.GetBalance(chainA.GetContext(), intermediary, voucher).Amount)
+}
+
+func TestTransferMemoErrorAck(t *testing.T) {
+	path := newTransferPath(t)
+	chainA, chainB := path.EndpointA.Chain, path.EndpointB.Chain
+	crudeA, crudeB := crudeApp(chainA), crudeApp(chainB)
+	sender := chainB.SenderAccount.GetAddress()
+	intermediary := types.IntermediaryAccount(path.EndpointA.ChannelID, sender.String())
+	voucher := transfertypes.ParseDenomTrace(transfertypes.GetPrefixedDenom(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, sdk.DefaultBondDenom)).IBCDenom()
+	balance := crudeB.BankKeeper.GetBalance(chainB.GetContext(), sender, sdk.DefaultBondDenom)
+
+	for _, tc := range []struct {
+		desc string
+		memo string
+	}{
+		{desc: "InvalidMemo", memo: `{"crude":{"delete":{"id":"0"}}}`},
+		{desc: "InvalidOperation", memo: `{"crude":{"create":{"name":"widget","tags":["a","a"]}}}`},
+		{desc: "UnknownResource", memo: `{"crude":{"update":{"id":"42","name":"gadget"}}}`},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			ack := transferWithMemo(t, path, 100, tc.memo)
+			require.False(t, ack.Success())
+
+			// Nothing was received on this chain and the sender was refunded
+			require.True(t, crudeA.BankKeeper.GetBalance(chainA.GetContext(), intermediary, voucher).IsZero())
+			require.True(t, crudeA.BankKeeper.GetBalance(chainA.GetContext(), chainA.SenderAccount.GetAddress(), voucher).IsZero())
+			require.Empty(t, crudeA.CrudeKeeper.GetResourceIDsByOwner(chainA.GetContext(), intermediary.String()))
+			require.Equal(t, balance, crudeB.BankKeeper.GetBalance(chainB.GetContext(), sender, sdk.DefaultBondDenom))
+		})
+	}
+
+	// The resources of a sender cannot be updated by another one
+	ack := transferWithMemo(t, path, 1, `{"crude":{"create":{"name":"widget"}}}`)
+	require.True(t, ack.Success(), ack.GetError())
+	id := crudeA.CrudeKeeper.GetResourceIDsByOwner(chainA.GetContext(), intermediary.String())[0]
+	chainB.SenderAccount = chainB.SenderAccounts[1].SenderAccount
+	chainB.SenderPrivKey = chainB.SenderAccounts[1].SenderPrivKey
+	ack = transferWithMemo(t, path, 1, `{"crude":{"update":{"id":"`+strconv.FormatUint(id, 10)+`","name":"stolen"}}}`)
+	require.False(t, ack.Success())
+	resource, found := crudeA.CrudeKeeper.GetResource(chainA.GetContext(), id)
+	require.True(t, found)
+	require.Equal(t, "widget", resource.Name)
+}
+
+func TestTransferMemoPassthrough(t *testing.T) {
+	path := newTransferPath(t)
+	chainA := path.EndpointA.Chain
+	crudeA := crudeApp(chainA)
+	voucher := transfertypes.ParseDenomTrace(transfertypes.GetPrefixedDenom(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, sdk.DefaultBondDenom)).IBCDenom()
+
+	// Transfers without a crude field reach their receiver as usual
+	for _, memo := range []string{"", "thanks", `{"forward":{}}`} {
+		ack := transferWithMemo(t, path, 10, memo)
+		require.True(t, ack.Success(), ack.GetError())
+	}
+	require.Equal(t, sdkmath.NewInt(30), crudeA.BankKeeper.GetBalance(chainA.GetContext(), chainA.SenderAccount.GetAddress(), voucher).Amount)
+	require.Empty(t, crudeA.CrudeKeeper.GetAllResource(chainA.GetContext()))
+}
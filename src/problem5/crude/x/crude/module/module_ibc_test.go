@@ -0,0 +1,205 @@
+package crude_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"cosmossdk.io/log"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	capabilitykeeper "github.com/cosmos/ibc-go/modules/capability/keeper"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+	ibctestingtypes "github.com/cosmos/ibc-go/v8/testing/types"
+	"github.com/stretchr/testify/require"
+
+	"crude/app"
+	"crude/x/crude/types"
+)
+
+// testingApp adds to the app the accessors the ibc-go testing package needs
+type testingApp struct {
+	*app.App
+}
+
+func (a testingApp) GetBaseApp() *baseapp.BaseApp                    { return a.App.BaseApp }
+func (a testingApp) GetStakingKeeper() ibctestingtypes.StakingKeeper { return a.StakingKeeper }
+func (a testingApp) GetScopedIBCKeeper() capabilitykeeper.ScopedKeeper {
+	return a.ScopedIBCKeeper
+}
+func (a testingApp) GetTxConfig() client.TxConfig { return a.TxConfig() }
+
+func setupTestingApp() (ibctesting.TestingApp, map[string]json.RawMessage) {
+	bApp, err := app.New(log.NewNopLogger(), dbm.NewMemDB(), nil, true, simtestutil.EmptyAppOptions{})
+	if err != nil {
+		panic(err)
+	}
+
+	return testingApp{bApp}, bApp.DefaultGenesis()
+}
+
+func init() {
+	ibctesting.DefaultTestingAppInit = setupTestingApp
+}
+
+// newReplicationPath opens an ordered crude channel between two chains
+func newReplicationPath(t *testing.T) (*ibctesting.Coordinator, *ibctesting.Path) {
+	coord := ibctesting.NewCoordinator(t, 2)
+	path := ibctesting.NewPath(coord.GetChain(ibctesting.GetChainID(1)), coord.GetChain(ibctesting.GetChainID(2)))
+	path.EndpointA.ChannelConfig.PortID = types.PortID
+	path.EndpointB.ChannelConfig.PortID = types.PortID
+	path.EndpointA.ChannelConfig.Version = types.Version
+	path.EndpointB.ChannelConfig.Version = types.Version
+	path.SetChannelOrdered()
+	coord.Setup(path)
+
+	return coord, path
+}
+
+func crudeApp(chain *ibctesting.TestChain) *app.App {
+	return chain.App.(testingApp).App
+}
+
+// createResource creates a resource owned by the sender of a chain
+func createResource(t *testing.T, chain *ibctesting.TestChain, name string) uint64 {
+	t.Helper()
+	creator := chain.SenderAccount.GetAddress().String()
+	_, err := chain.SendMsgs(types.NewMsgCreateResource(creator, name, types.NewUint64Payload(1)))
+	require.NoError(t, err)
+
+	ids := crudeApp(chain).CrudeKeeper.GetResourceIDsByCreator(chain.GetContext(), creator)
+	require.NotEmpty(t, ids)
+	return ids[len(ids)-1]
+}
+
+// sendAndRelay delivers messages on the chain of an endpoint, then relays the
+// packet they sent
+func sendAndRelay(t *testing.T, path *ibctesting.Path, msgs ...sdk.Msg) channeltypes.Packet {
+	t.Helper()
+	res, err := path.EndpointA.Chain.SendMsgs(msgs...)
+	require.NoError(t, err)
+	packet, err := ibctesting.ParsePacketFromEvents(res.Events)
+	require.NoError(t, err)
+	require.NoError(t, path.RelayPacket(packet))
+
+	return packet
+}
+
+func TestResourceReplication(t *testing.T) {
+	_, path := newReplicationPath(t)
+	chainA, chainB := path.EndpointA.Chain, path.EndpointB.Chain
+	keeperA, keeperB := crudeApp(chainA).CrudeKeeper, crudeApp(chainB).CrudeKeeper
+	creator := chainA.SenderAccount.GetAddress().String()
+	moduleAddr := authtypes.NewModuleAddress(types.ModuleName).String()
+
+	id := createResource(t, chainA, "replicated")
+	sendAndRelay(t, path, types.NewMsgReplicateResource(creator, id, path.EndpointA.ChannelID, time.Hour))
+
+	// The counterparty holds a replica owned by its module account
+	link, found := keeperB.GetResourceReplica(chainB.GetContext(), path.EndpointB.ChannelID, id)
+	require.True(t, found)
+	replica, found := keeperB.GetResource(chainB.GetContext(), link.ResourceId)
+	require.True(t, found)
+	require.Equal(t, "replicated", replica.Name)
+	require.Equal(t, creator, replica.Creator)
+	require.Equal(t, moduleAddr, replica.Owner)
+
+	// The acknowledgement recorded the id of the replica
+	replication, found := keeperA.GetResourceReplication(chainA.GetContext(), id, path.EndpointA.ChannelID)
+	require.True(t, found)
+	require.Equal(t, link.ResourceId, replication.ReplicaId)
+	require.Equal(t, time.Hour, replication.TimeoutPeriod)
+
+	// Updates are forwarded
+	sendAndRelay(t, path, types.NewMsgUpdateResource(creator, id, "renamed", types.NewUint64Payload(2)))
+	replica, found = keeperB.GetResource(chainB.GetContext(), link.ResourceId)
+	require.True(t, found)
+	require.Equal(t, "renamed", replica.Name)
+	require.Equal(t, types.NewUint64Payload(2), replica.Payload)
+	require.Equal(t, uint64(2), replica.Version)
+
+	// So is the deletion, which ends the replication
+	sendAndRelay(t, path, types.NewMsgDeleteResource(creator, id))
+	_, found = keeperB.GetResource(chainB.GetContext(), link.ResourceId)
+	require.False(t, found)
+	_, found = keeperB.GetResourceReplica(chainB.GetContext(), path.EndpointB.ChannelID, id)
+	require.False(t, found)
+	require.Empty(t, keeperA.GetAllResourceReplication(chainA.GetContext()))
+}
+
+func TestResourceReplicationErrorAck(t *testing.T) {
+	_, path := newReplicationPath(t)
+	chainA, chainB := path.EndpointA.Chain, path.EndpointB.Chain
+	keeperA, keeperB := crudeApp(chainA).CrudeKeeper, crudeApp(chainB).CrudeKeeper
+	creator := chainA.SenderAccount.GetAddress().String()
+
+	// The counterparty requires unique names and already uses this one
+	params := keeperB.GetParams(chainB.GetContext())
+	params.UniqueNames = true
+	require.NoError(t, keeperB.SetParams(chainB.GetContext(), params))
+	createResource(t, chainB, "taken")
+
+	id := createResource(t, chainA, "taken")
+	sendAndRelay(t, path, types.NewMsgReplicateResource(creator, id, path.EndpointA.ChannelID, 0))
+
+	// The error acknowledgement dropped the replication, not the resource
+	_, found := keeperA.GetResourceReplication(chainA.GetContext(), id, path.EndpointA.ChannelID)
+	require.False(t, found)
+	_, found = keeperA.GetResource(chainA.GetContext(), id)
+	require.True(t, found)
+	_, found = keeperB.GetResourceReplica(chainB.GetContext(), path.EndpointB.ChannelID, id)
+	require.False(t, found)
+
+	// The channel stays open for other resources
+	other := createResource(t, chainA, "other")
+	sendAndRelay(t, path, types.NewMsgReplicateResource(creator, other, path.EndpointA.ChannelID, 0))
+	_, found = keeperB.GetResourceReplica(chainB.GetContext(), path.EndpointB.ChannelID, other)
+	require.True(t, found)
+}
+
+func TestResourceReplicationTimeout(t *testing.T) {
+	coord, path := newReplicationPath(t)
+	chainA := path.EndpointA.Chain
+	keeperA := crudeApp(chainA).CrudeKeeper
+	creator := chainA.SenderAccount.GetAddress().String()
+
+	id := createResource(t, chainA, "replicated")
+	sendAndRelay(t, path, types.NewMsgReplicateResource(creator, id, path.EndpointA.ChannelID, time.Minute))
+
+	// The update is never relayed in time
+	res, err := chainA.SendMsgs(types.NewMsgUpdateResource(creator, id, "renamed", types.NewUint64Payload(2)))
+	require.NoError(t, err)
+	packet, err := ibctesting.ParsePacketFromEvents(res.Events)
+	require.NoError(t, err)
+
+	coord.IncrementTimeBy(2 * time.Minute)
+	require.NoError(t, path.EndpointB.UpdateClient())
+	require.NoError(t, path.EndpointA.UpdateClient())
+	require.NoError(t, path.EndpointA.TimeoutPacket(packet))
+
+	// The timeout closed the ordered channel and stopped its replications
+	require.Equal(t, channeltypes.CLOSED, path.EndpointA.GetChannel().State)
+	require.Empty(t, keeperA.GetAllResourceReplication(chainA.GetContext()))
+
+	// Nothing can be replicated on the closed channel anymore
+	_, err = chainA.SendMsgs(types.NewMsgReplicateResource(creator, id, path.EndpointA.ChannelID, 0))
+	require.Error(t, err)
+}
+
+func TestResourceReplicationChannelOrder(t *testing.T) {
+	coord := ibctesting.NewCoordinator(t, 2)
+	path := ibctesting.NewPath(coord.GetChain(ibctesting.GetChainID(1)), coord.GetChain(ibctesting.GetChainID(2)))
+	path.EndpointA.ChannelConfig.PortID = types.PortID
+	path.EndpointB.ChannelConfig.PortID = types.PortID
+	path.EndpointA.ChannelConfig.Version = types.Version
+	path.EndpointB.ChannelConfig.Version = types.Version
+	coord.SetupConnections(path)
+
+	// Replicas must see the changes in order
+	require.Error(t, path.EndpointA.ChanOpenInit())
+}
@@ -44,16 +44,20 @@ func (AppModule) GenerateGenesisState(simState *module.SimulationState) {
 	for i, acc := range simState.Accounts {
 		accs[i] = acc.Address.String()
 	}
+	owners := []string{sample.AccAddress(), sample.AccAddress()}
 	crudeGenesis := types.GenesisState{
 		Params: types.DefaultParams(),
+		PortId: types.PortID,
 		ResourceList: []types.Resource{
 			{
 				Id:      0,
-				Creator: sample.AccAddress(),
+				Owner:   owners[0],
+				Creator: owners[0],
 			},
 			{
 				Id:      1,
-				Creator: sample.AccAddress(),
+				Owner:   owners[1],
+				Creator: owners[1],
 			},
 		},
 		ResourceCount: 2,
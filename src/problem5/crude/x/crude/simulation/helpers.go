@@ -1,8 +1,14 @@
 package simulation
 
 import (
+	"fmt"
+	"math/rand"
+
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+
+	"crude/x/crude/types"
 )
 
 // FindAccount find a specific address from an account list
@@ -13,3 +19,38 @@ func FindAccount(accs []simtypes.Account, address string) (simtypes.Account, boo
 	}
 	return simtypes.FindAccount(accs, creator)
 }
+
+// simTags are the tags picked by RandomTags
+var simTags = []string{"env:prod", "env:test", "team:payments", "team:search"}
+
+// RandomTags returns a random subset of a few fixed tags so that resources
+// share them
+func RandomTags(r *rand.Rand) (tags []string) {
+	for _, tag := range simTags {
+		if r.Intn(3) == 0 {
+			tags = append(tags, tag)
+		}
+	}
+	return tags
+}
+
+// RandomPayload returns a payload of a random type, uint64 half of the time
+// so that value adjustments keep finding candidates
+func RandomPayload(r *rand.Rand) *types.ResourcePayload {
+	switch r.Intn(12) {
+	case 0:
+		return types.NewInt64Payload(r.Int63() - r.Int63())
+	case 1:
+		return types.NewDecPayload(math.LegacyNewDecWithPrec(r.Int63n(1_000_000), 3))
+	case 2:
+		return types.NewStringPayload(simtypes.RandStringOfLength(r, 12))
+	case 3:
+		return types.NewBytesPayload([]byte(simtypes.RandStringOfLength(r, 8)))
+	case 4:
+		return types.NewCoinPayload(sdk.NewInt64Coin(sdk.DefaultBondDenom, r.Int63n(1_000)))
+	case 5:
+		return types.NewJSONPayload(fmt.Sprintf(`{"n":%d}`, r.Intn(100)))
+	default:
+		return types.NewUint64Payload(uint64(r.Intn(1_000)))
+	}
+}
@@ -24,6 +24,8 @@ func SimulateMsgCreateResource(
 
 		msg := &types.MsgCreateResource{
 			Creator: simAccount.Address.String(),
+			Tags:    RandomTags(r),
+			Payload: RandomPayload(r),
 		}
 
 		txCtx := simulation.OperationInput{
@@ -58,17 +60,19 @@ func SimulateMsgUpdateResource(
 			found       = false
 		)
 		for _, obj := range allResource {
-			simAccount, found = FindAccount(accs, obj.Creator)
+			simAccount, found = FindAccount(accs, obj.Owner)
 			if found {
 				resource = obj
 				break
 			}
 		}
 		if !found {
-			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "resource creator not found"), nil, nil
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "resource owner not found"), nil, nil
 		}
 		msg.Creator = simAccount.Address.String()
 		msg.Id = resource.Id
+		msg.Tags = RandomTags(r)
+		msg.Payload = RandomPayload(r)
 
 		txCtx := simulation.OperationInput{
 			R:               r,
@@ -102,14 +106,14 @@ func SimulateMsgDeleteResource(
 			found       = false
 		)
 		for _, obj := range allResource {
-			simAccount, found = FindAccount(accs, obj.Creator)
+			simAccount, found = FindAccount(accs, obj.Owner)
 			if found {
 				resource = obj
 				break
 			}
 		}
 		if !found {
-			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "resource creator not found"), nil, nil
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "resource owner not found"), nil, nil
 		}
 		msg.Creator = simAccount.Address.String()
 		msg.Id = resource.Id
@@ -1,6 +1,7 @@
 package types
 
 import (
+	"github.com/cosmos/cosmos-sdk/codec"
 	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/msgservice"
@@ -12,11 +13,30 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgCreateResource{},
 		&MsgUpdateResource{},
 		&MsgDeleteResource{},
+		&MsgRevertResource{},
+		&MsgOfferResourceTransfer{},
+		&MsgAcceptResourceTransfer{},
+		&MsgCancelResourceTransfer{},
+		&MsgGrantResourceRole{},
+		&MsgRevokeResourceRole{},
+		&MsgRenewResource{},
+		&MsgBatchResourceOps{},
+		&MsgAdjustResourceValue{},
+		&MsgSetResourceAttribute{},
+		&MsgRemoveResourceAttribute{},
+		&MsgRegisterResourceSchema{},
+		&MsgCreateNamespace{},
+		&MsgUpdateNamespace{},
+		&MsgReplicateResource{},
 	)
 	// this line is used by starport scaffolding # 3
 
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgUpdateParams{},
+		&MsgApproveResourceSchema{},
 	)
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
+
+// ModuleCdc encodes the packets and acknowledgements of the crude IBC channels
+var ModuleCdc = codec.NewProtoCodec(cdctypes.NewInterfaceRegistry())
@@ -8,6 +8,24 @@ import (
 
 // x/crude module sentinel errors
 var (
-	ErrInvalidSigner = sdkerrors.Register(ModuleName, 1100, "expected gov account as only signer for proposal message")
-	ErrSample        = sdkerrors.Register(ModuleName, 1101, "sample error")
+	ErrInvalidSigner        = sdkerrors.Register(ModuleName, 1100, "expected gov account as only signer for proposal message")
+	ErrSample               = sdkerrors.Register(ModuleName, 1101, "sample error")
+	ErrInvalidName          = sdkerrors.Register(ModuleName, 1102, "invalid resource name")
+	ErrQuotaExceeded        = sdkerrors.Register(ModuleName, 1103, "resource quota exceeded")
+	ErrVersionMismatch      = sdkerrors.Register(ModuleName, 1104, "resource version mismatch")
+	ErrValueOverflow        = sdkerrors.Register(ModuleName, 1105, "resource value overflow")
+	ErrValueUnderflow       = sdkerrors.Register(ModuleName, 1106, "resource value underflow")
+	ErrValueOutOfBounds     = sdkerrors.Register(ModuleName, 1107, "resource value out of bounds")
+	ErrInvalidAttribute     = sdkerrors.Register(ModuleName, 1108, "invalid resource attribute")
+	ErrInvalidTag           = sdkerrors.Register(ModuleName, 1109, "invalid resource tag")
+	ErrInvalidPayload       = sdkerrors.Register(ModuleName, 1110, "invalid resource payload")
+	ErrInvalidSchema        = sdkerrors.Register(ModuleName, 1111, "invalid resource schema")
+	ErrSchemaViolation      = sdkerrors.Register(ModuleName, 1112, "resource does not conform to its schema")
+	ErrInvalidNamespace     = sdkerrors.Register(ModuleName, 1113, "invalid namespace")
+	ErrDuplicateName        = sdkerrors.Register(ModuleName, 1114, "resource name already taken")
+	ErrInvalidPacketTimeout = sdkerrors.Register(ModuleName, 1115, "invalid packet timeout")
+	ErrInvalidVersion       = sdkerrors.Register(ModuleName, 1116, "invalid version")
+	ErrAlreadyReplicated    = sdkerrors.Register(ModuleName, 1117, "resource already replicated on the channel")
+	ErrUnknownReplica       = sdkerrors.Register(ModuleName, 1118, "unknown resource replica")
+	ErrInvalidTransferMemo  = sdkerrors.Register(ModuleName, 1119, "invalid transfer memo")
 )
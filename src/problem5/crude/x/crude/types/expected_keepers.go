@@ -15,6 +15,9 @@ type AccountKeeper interface {
 // BankKeeper defines the expected interface for the Bank module.
 type BankKeeper interface {
 	SpendableCoins(context.Context, sdk.AccAddress) sdk.Coins
+	GetAllBalances(ctx context.Context, addr sdk.AccAddress) sdk.Coins
+	SendCoinsFromAccountToModule(ctx context.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
+	SendCoinsFromModuleToAccount(ctx context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
 	// Methods imported from bank should be defined here
 }
 
@@ -23,3 +26,14 @@ type ParamSubspace interface {
 	Get(context.Context, []byte, interface{})
 	Set(context.Context, []byte, interface{})
 }
+
+// ResourceHooks event hooks for resource lifecycle changes. Returning an error
+// from a Before hook vetoes the operation.
+type ResourceHooks interface {
+	BeforeResourceCreated(ctx context.Context, resource Resource) error
+	AfterResourceCreated(ctx context.Context, resource Resource) error
+	BeforeResourceUpdated(ctx context.Context, old, updated Resource) error
+	AfterResourceUpdated(ctx context.Context, old, updated Resource) error
+	BeforeResourceDeleted(ctx context.Context, resource Resource) error
+	AfterResourceDeleted(ctx context.Context, resource Resource) error
+}
@@ -2,15 +2,33 @@ package types
 
 import (
 	"fmt"
+
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
 )
 
 // DefaultIndex is the default global index
 const DefaultIndex uint64 = 1
 
+// GetPortIdOrDefault returns the port to bind, the default one if unset
+func (gs GenesisState) GetPortIdOrDefault() string {
+	if gs.PortId == "" {
+		return PortID
+	}
+	return gs.PortId
+}
+
 // DefaultGenesis returns the default genesis state
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
-		ResourceList: []Resource{},
+		ResourceList:              []Resource{},
+		ResourceRevisionList:      []ResourceRevision{},
+		ResourceTransferOfferList: []ResourceTransferOffer{},
+		ResourceRoleGrantList:     []ResourceRoleGrant{},
+		ResourceSchemaList:        []ResourceSchema{},
+		NamespaceList:             []Namespace{},
+		PortId:                    PortID,
+		ResourceReplicationList:   []ResourceReplication{},
+		ResourceReplicaList:       []ResourceReplica{},
 		// this line is used by starport scaffolding # genesis/types/default
 		Params: DefaultParams(),
 	}
@@ -29,8 +47,164 @@ func (gs GenesisState) Validate() error {
 		if elem.Id >= resourceCount {
 			return fmt.Errorf("resource id should be lower or equal than the last id")
 		}
+		if err := elem.Deposit.Validate(); err != nil {
+			return fmt.Errorf("invalid deposit for resource %d: %w", elem.Id, err)
+		}
+		if elem.ExpiryHeight < 0 {
+			return fmt.Errorf("negative expiry height for resource %d", elem.Id)
+		}
+		if err := elem.ValueBounds.Validate(); err != nil {
+			return fmt.Errorf("invalid value bounds for resource %d: %w", elem.Id, err)
+		}
+		if err := elem.Payload.Validate(); err != nil {
+			return fmt.Errorf("invalid payload for resource %d: %w", elem.Id, err)
+		}
+		if err := elem.ValueBounds.CheckPayload(elem.Payload); err != nil {
+			return fmt.Errorf("payload of resource %d: %w", elem.Id, err)
+		}
+		if err := elem.ValidateAttributes(); err != nil {
+			return fmt.Errorf("invalid attributes for resource %d: %w", elem.Id, err)
+		}
+		if err := elem.ValidateTags(); err != nil {
+			return fmt.Errorf("invalid tags for resource %d: %w", elem.Id, err)
+		}
 		resourceIdMap[elem.Id] = true
 	}
+	// Check for duplicated revision in resource history
+	resourceRevisionMap := make(map[[2]uint64]bool)
+	for _, elem := range gs.ResourceRevisionList {
+		key := [2]uint64{elem.ResourceId, elem.Revision}
+		if _, ok := resourceRevisionMap[key]; ok {
+			return fmt.Errorf("duplicated revision for resource")
+		}
+		if elem.ResourceId >= resourceCount {
+			return fmt.Errorf("resource revision id should be lower or equal than the last id")
+		}
+		resourceRevisionMap[key] = true
+	}
+	// Check that every pending transfer offer targets a single existing resource
+	resourceTransferOfferMap := make(map[uint64]bool)
+	for _, elem := range gs.ResourceTransferOfferList {
+		if _, ok := resourceTransferOfferMap[elem.ResourceId]; ok {
+			return fmt.Errorf("duplicated transfer offer for resource")
+		}
+		if _, ok := resourceIdMap[elem.ResourceId]; !ok {
+			return fmt.Errorf("transfer offer for unknown resource %d", elem.ResourceId)
+		}
+		resourceTransferOfferMap[elem.ResourceId] = true
+	}
+	// Check that every role grant is a single, grantable role on an existing resource
+	resourceRoleGrantMap := make(map[string]bool)
+	for _, elem := range gs.ResourceRoleGrantList {
+		key := fmt.Sprintf("%d/%s", elem.ResourceId, elem.Address)
+		if _, ok := resourceRoleGrantMap[key]; ok {
+			return fmt.Errorf("duplicated role grant for resource")
+		}
+		if _, ok := resourceIdMap[elem.ResourceId]; !ok {
+			return fmt.Errorf("role grant for unknown resource %d", elem.ResourceId)
+		}
+		if !elem.Role.IsGrantable() {
+			return fmt.Errorf("role %s cannot be granted", elem.Role)
+		}
+		resourceRoleGrantMap[key] = true
+	}
+	// Check that every schema version is unique and compiles
+	resourceSchemaMap := make(map[string]ResourceSchema)
+	for _, elem := range gs.ResourceSchemaList {
+		key := fmt.Sprintf("%s/%d", elem.Id, elem.Version)
+		if _, ok := resourceSchemaMap[key]; ok {
+			return fmt.Errorf("duplicated version for resource schema")
+		}
+		if err := elem.Validate(); err != nil {
+			return fmt.Errorf("invalid resource schema %s version %d: %w", elem.Id, elem.Version, err)
+		}
+		resourceSchemaMap[key] = elem
+	}
+	// Check that the resources referencing a schema conform to it
+	for _, elem := range gs.ResourceList {
+		if elem.Schema == nil {
+			continue
+		}
+		schema, ok := resourceSchemaMap[fmt.Sprintf("%s/%d", elem.Schema.Id, elem.Schema.Version)]
+		if !ok {
+			return fmt.Errorf("resource %d references unknown schema %s version %d", elem.Id, elem.Schema.Id, elem.Schema.Version)
+		}
+		if err := schema.ValidateDocument(elem.Name); err != nil {
+			return fmt.Errorf("name of resource %d does not conform to its schema: %w", elem.Id, err)
+		}
+	}
+	// Check that every namespace is unique and valid
+	namespaceMap := make(map[string]struct{})
+	for _, elem := range gs.NamespaceList {
+		if _, ok := namespaceMap[elem.Name]; ok {
+			return fmt.Errorf("duplicated name for namespace")
+		}
+		if err := elem.Validate(); err != nil {
+			return fmt.Errorf("invalid namespace %s: %w", elem.Name, err)
+		}
+		namespaceMap[elem.Name] = struct{}{}
+	}
+	// Check that the resources of a namespace reference an existing one
+	for _, elem := range gs.ResourceList {
+		if elem.Namespace == "" {
+			continue
+		}
+		if _, ok := namespaceMap[elem.Namespace]; !ok {
+			return fmt.Errorf("resource %d references unknown namespace %s", elem.Id, elem.Namespace)
+		}
+	}
+	// Check that no two resources share a name if names must be unique
+	if gs.Params.UniqueNames {
+		resourceNameMap := make(map[string]struct{})
+		for _, elem := range gs.ResourceList {
+			if _, ok := resourceNameMap[elem.Name]; ok {
+				return fmt.Errorf("duplicated resource name %q while names must be unique", elem.Name)
+			}
+			resourceNameMap[elem.Name] = struct{}{}
+		}
+	}
+	// Check that every replication subscribes an existing resource once per channel
+	resourceReplicationMap := make(map[string]struct{})
+	for _, elem := range gs.ResourceReplicationList {
+		key := fmt.Sprintf("%d/%s", elem.ResourceId, elem.ChannelId)
+		if _, ok := resourceReplicationMap[key]; ok {
+			return fmt.Errorf("duplicated replication for resource")
+		}
+		if _, ok := resourceIdMap[elem.ResourceId]; !ok {
+			return fmt.Errorf("replication of unknown resource %d", elem.ResourceId)
+		}
+		if err := elem.Validate(); err != nil {
+			return fmt.Errorf("invalid replication of resource %d: %w", elem.ResourceId, err)
+		}
+		resourceReplicationMap[key] = struct{}{}
+	}
+	// Check that every replica links a single existing resource
+	resourceReplicaMap := make(map[string]struct{})
+	replicaIdMap := make(map[uint64]struct{})
+	for _, elem := range gs.ResourceReplicaList {
+		key := fmt.Sprintf("%s/%d", elem.ChannelId, elem.SourceId)
+		if _, ok := resourceReplicaMap[key]; ok {
+			return fmt.Errorf("duplicated replica for source resource")
+		}
+		if _, ok := replicaIdMap[elem.ResourceId]; ok {
+			return fmt.Errorf("resource %d replicates several source resources", elem.ResourceId)
+		}
+		if _, ok := resourceIdMap[elem.ResourceId]; !ok {
+			return fmt.Errorf("replica of unknown resource %d", elem.ResourceId)
+		}
+		if err := elem.Validate(); err != nil {
+			return fmt.Errorf("invalid replica %d: %w", elem.ResourceId, err)
+		}
+		resourceReplicaMap[key] = struct{}{}
+		replicaIdMap[elem.ResourceId] = struct{}{}
+	}
+	// An unset port, as in genesis files exported before the module spoke IBC,
+	// stands for the default one
+	if gs.PortId != "" {
+		if err := host.PortIdentifierValidator(gs.PortId); err != nil {
+			return err
+		}
+	}
 	// this line is used by starport scaffolding # genesis/types/validate
 
 	return gs.Params.Validate()
@@ -27,9 +27,17 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 // GenesisState defines the crude module's genesis state.
 type GenesisState struct {
 	// params defines all the parameters of the module.
-	Params        Params     `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
-	ResourceList  []Resource `protobuf:"bytes,2,rep,name=resourceList,proto3" json:"resourceList"`
-	ResourceCount uint64     `protobuf:"varint,3,opt,name=resourceCount,proto3" json:"resourceCount,omitempty"`
+	Params                    Params                  `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	ResourceList              []Resource              `protobuf:"bytes,2,rep,name=resourceList,proto3" json:"resourceList"`
+	ResourceCount             uint64                  `protobuf:"varint,3,opt,name=resourceCount,proto3" json:"resourceCount,omitempty"`
+	ResourceRevisionList      []ResourceRevision      `protobuf:"bytes,4,rep,name=resourceRevisionList,proto3" json:"resourceRevisionList"`
+	ResourceTransferOfferList []ResourceTransferOffer `protobuf:"bytes,5,rep,name=resourceTransferOfferList,proto3" json:"resourceTransferOfferList"`
+	ResourceRoleGrantList     []ResourceRoleGrant     `protobuf:"bytes,6,rep,name=resourceRoleGrantList,proto3" json:"resourceRoleGrantList"`
+	ResourceSchemaList        []ResourceSchema        `protobuf:"bytes,7,rep,name=resourceSchemaList,proto3" json:"resourceSchemaList"`
+	NamespaceList             []Namespace             `protobuf:"bytes,8,rep,name=namespaceList,proto3" json:"namespaceList"`
+	PortId                    string                  `protobuf:"bytes,9,opt,name=portId,proto3" json:"portId,omitempty"`
+	ResourceReplicationList   []ResourceReplication   `protobuf:"bytes,10,rep,name=resourceReplicationList,proto3" json:"resourceReplicationList"`
+	ResourceReplicaList       []ResourceReplica       `protobuf:"bytes,11,rep,name=resourceReplicaList,proto3" json:"resourceReplicaList"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -86,6 +94,62 @@ func (m *GenesisState) GetResourceCount() uint64 {
 	return 0
 }
 
+func (m *GenesisState) GetResourceRevisionList() []ResourceRevision {
+	if m != nil {
+		return m.ResourceRevisionList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetResourceTransferOfferList() []ResourceTransferOffer {
+	if m != nil {
+		return m.ResourceTransferOfferList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetResourceRoleGrantList() []ResourceRoleGrant {
+	if m != nil {
+		return m.ResourceRoleGrantList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetResourceSchemaList() []ResourceSchema {
+	if m != nil {
+		return m.ResourceSchemaList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNamespaceList() []Namespace {
+	if m != nil {
+		return m.NamespaceList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPortId() string {
+	if m != nil {
+		return m.PortId
+	}
+	return ""
+}
+
+func (m *GenesisState) GetResourceReplicationList() []ResourceReplication {
+	if m != nil {
+		return m.ResourceReplicationList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetResourceReplicaList() []ResourceReplica {
+	if m != nil {
+		return m.ResourceReplicaList
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "crude.crude.GenesisState")
 }
@@ -93,23 +157,35 @@ func init() {
 func init() { proto.RegisterFile("crude/crude/genesis.proto", fileDescriptor_ac80f88e9758aea9) }
 
 var fileDescriptor_ac80f88e9758aea9 = []byte{
-	// 246 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4c, 0x2e, 0x2a, 0x4d,
-	0x49, 0xd5, 0x87, 0x90, 0xe9, 0xa9, 0x79, 0xa9, 0xc5, 0x99, 0xc5, 0x7a, 0x05, 0x45, 0xf9, 0x25,
-	0xf9, 0x42, 0xdc, 0x60, 0x41, 0x3d, 0x30, 0x29, 0x25, 0x98, 0x98, 0x9b, 0x99, 0x97, 0xaf, 0x0f,
-	0x26, 0x21, 0xf2, 0x52, 0x22, 0xe9, 0xf9, 0xe9, 0xf9, 0x60, 0xa6, 0x3e, 0x88, 0x05, 0x15, 0x95,
-	0x40, 0x36, 0xb0, 0x20, 0xb1, 0x28, 0x31, 0x17, 0x6a, 0x9e, 0x94, 0x14, 0xb2, 0x4c, 0x51, 0x6a,
-	0x71, 0x7e, 0x69, 0x51, 0x72, 0x2a, 0x44, 0x4e, 0x69, 0x2d, 0x23, 0x17, 0x8f, 0x3b, 0xc4, 0xf6,
-	0xe0, 0x92, 0xc4, 0x92, 0x54, 0x21, 0x33, 0x2e, 0x36, 0x88, 0x66, 0x09, 0x46, 0x05, 0x46, 0x0d,
-	0x6e, 0x23, 0x61, 0x3d, 0x24, 0xd7, 0xe8, 0x05, 0x80, 0xa5, 0x9c, 0x38, 0x4f, 0xdc, 0x93, 0x67,
-	0x58, 0xf1, 0x7c, 0x83, 0x16, 0x63, 0x10, 0x54, 0xb5, 0x90, 0x3d, 0x17, 0x0f, 0xcc, 0x68, 0x9f,
-	0xcc, 0xe2, 0x12, 0x09, 0x26, 0x05, 0x66, 0x0d, 0x6e, 0x23, 0x51, 0x14, 0xdd, 0x41, 0x50, 0x05,
-	0x4e, 0x2c, 0x20, 0xfd, 0x41, 0x28, 0x1a, 0x84, 0x54, 0xb8, 0x78, 0x61, 0x7c, 0xe7, 0xfc, 0xd2,
-	0xbc, 0x12, 0x09, 0x66, 0x05, 0x46, 0x0d, 0x96, 0x20, 0x54, 0x41, 0x27, 0xdd, 0x13, 0x8f, 0xe4,
-	0x18, 0x2f, 0x3c, 0x92, 0x63, 0x7c, 0xf0, 0x48, 0x8e, 0x71, 0xc2, 0x63, 0x39, 0x86, 0x0b, 0x8f,
-	0xe5, 0x18, 0x6e, 0x3c, 0x96, 0x63, 0x88, 0x12, 0x86, 0xf8, 0xaf, 0x02, 0xea, 0xcf, 0x92, 0xca,
-	0x82, 0xd4, 0xe2, 0x24, 0x36, 0xb0, 0x2f, 0x8d, 0x01, 0x01, 0x00, 0x00, 0xff, 0xff, 0xc5, 0x34,
-	0xa3, 0x02, 0x6e, 0x01, 0x00, 0x00,
+	// 442 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x93, 0x4f, 0x8b, 0xd3, 0x40,
+	0x18, 0xc6, 0x33, 0x6e, 0x8d, 0x76, 0xb2, 0x7b, 0x70, 0xba, 0xbb, 0x66, 0xa3, 0xc6, 0xb0, 0x78,
+	0x08, 0x82, 0x29, 0xac, 0xe0, 0x55, 0x88, 0x87, 0x45, 0x10, 0xff, 0x64, 0x0b, 0x42, 0x4f, 0x8e,
+	0xe9, 0xa4, 0x06, 0x9a, 0x4c, 0x98, 0x99, 0x8a, 0x7e, 0x0b, 0x3f, 0x86, 0x47, 0xfd, 0x16, 0x3d,
+	0xf6, 0xe8, 0x49, 0xa4, 0x3d, 0xf8, 0x35, 0xa4, 0x6f, 0x66, 0x6a, 0x23, 0x53, 0x2f, 0xc3, 0xcc,
+	0xfb, 0x3e, 0xcf, 0xef, 0x49, 0x78, 0x79, 0xf1, 0x59, 0x2e, 0xe6, 0x13, 0x36, 0x6c, 0xcf, 0x29,
+	0xab, 0x99, 0x2c, 0x65, 0xd2, 0x08, 0xae, 0x38, 0xf1, 0xa0, 0x98, 0xc0, 0x19, 0xdc, 0xa2, 0x55,
+	0x59, 0xf3, 0x21, 0x9c, 0x6d, 0x3f, 0x38, 0x9e, 0xf2, 0x29, 0x87, 0xeb, 0x70, 0x73, 0xd3, 0x55,
+	0x7f, 0x17, 0xd8, 0x50, 0x41, 0x2b, 0xcd, 0x0b, 0x82, 0xdd, 0x8e, 0x60, 0x92, 0xcf, 0x45, 0xce,
+	0xda, 0xde, 0xf9, 0x77, 0x17, 0x1f, 0x5e, 0xb6, 0xe9, 0x57, 0x8a, 0x2a, 0x46, 0x9e, 0x60, 0xb7,
+	0x35, 0xfb, 0x28, 0x42, 0xb1, 0x77, 0x31, 0x48, 0x76, 0xbe, 0x26, 0x79, 0x0d, 0xad, 0xb4, 0xbf,
+	0xf8, 0x79, 0xdf, 0xf9, 0xfa, 0xfb, 0xdb, 0x43, 0x94, 0x69, 0x35, 0x79, 0x8a, 0x0f, 0x0d, 0xfa,
+	0x45, 0x29, 0x95, 0x7f, 0x2d, 0x3a, 0x88, 0xbd, 0x8b, 0x93, 0x8e, 0x3b, 0xd3, 0x82, 0xb4, 0xb7,
+	0xf1, 0x67, 0x1d, 0x03, 0x79, 0x80, 0x8f, 0xcc, 0xfb, 0x19, 0x9f, 0xd7, 0xca, 0x3f, 0x88, 0x50,
+	0xdc, 0xcb, 0xba, 0x45, 0xf2, 0x16, 0x1f, 0x9b, 0x42, 0xc6, 0x3e, 0x96, 0xb2, 0xe4, 0x35, 0xc4,
+	0xf5, 0x20, 0xee, 0x9e, 0x35, 0xce, 0x08, 0x75, 0xac, 0x15, 0x40, 0x0a, 0x7c, 0x66, 0xea, 0x23,
+	0x41, 0x6b, 0x59, 0x30, 0xf1, 0xaa, 0x28, 0x98, 0x00, 0xfa, 0x75, 0xa0, 0x9f, 0x5b, 0xe9, 0x1d,
+	0xb5, 0x8e, 0xd8, 0x8f, 0x22, 0x63, 0x7c, 0xb2, 0xcd, 0xe7, 0x33, 0x76, 0x29, 0x68, 0xad, 0x20,
+	0xc3, 0x85, 0x8c, 0xd0, 0xfe, 0x07, 0x46, 0xa9, 0xf9, 0x76, 0x04, 0x79, 0x83, 0x89, 0x69, 0x5c,
+	0xe5, 0x1f, 0x58, 0x45, 0x01, 0x7c, 0x03, 0xc0, 0x77, 0xac, 0xe0, 0x56, 0xa6, 0xa9, 0x16, 0x33,
+	0x49, 0xf1, 0x51, 0x4d, 0x2b, 0x26, 0x1b, 0xaa, 0xe7, 0x7a, 0x13, 0x68, 0xa7, 0x1d, 0xda, 0x4b,
+	0xa3, 0xd0, 0xa0, 0xae, 0x85, 0x9c, 0x62, 0xb7, 0xe1, 0x42, 0x3d, 0x9f, 0xf8, 0xfd, 0x08, 0xc5,
+	0xfd, 0x4c, 0xbf, 0xc8, 0x3b, 0x7c, 0xfb, 0xef, 0x28, 0x9a, 0x59, 0x99, 0x53, 0x65, 0xc6, 0x89,
+	0x21, 0x25, 0xda, 0x33, 0xce, 0xad, 0x56, 0xe7, 0xed, 0xc3, 0x90, 0x11, 0x1e, 0xfc, 0xd3, 0x02,
+	0xba, 0x07, 0xf4, 0xbb, 0xff, 0xa3, 0x6b, 0xb2, 0xcd, 0x9e, 0x3e, 0x5a, 0xac, 0x42, 0xb4, 0x5c,
+	0x85, 0xe8, 0xd7, 0x2a, 0x44, 0x5f, 0xd6, 0xa1, 0xb3, 0x5c, 0x87, 0xce, 0x8f, 0x75, 0xe8, 0x8c,
+	0x07, 0xed, 0x8e, 0x7d, 0xd2, 0xbb, 0xa6, 0x3e, 0x37, 0x4c, 0xbe, 0x77, 0x61, 0xd3, 0x1e, 0xff,
+	0x19, 0x00, 0x73, 0xa3, 0x49, 0xd2, 0xf2, 0x03, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -132,6 +208,111 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ResourceReplicaList) > 0 {
+		for iNdEx := len(m.ResourceReplicaList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ResourceReplicaList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.ResourceReplicationList) > 0 {
+		for iNdEx := len(m.ResourceReplicationList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ResourceReplicationList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.NamespaceList) > 0 {
+		for iNdEx := len(m.NamespaceList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.NamespaceList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.ResourceSchemaList) > 0 {
+		for iNdEx := len(m.ResourceSchemaList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ResourceSchemaList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.ResourceRoleGrantList) > 0 {
+		for iNdEx := len(m.ResourceRoleGrantList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ResourceRoleGrantList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.ResourceTransferOfferList) > 0 {
+		for iNdEx := len(m.ResourceTransferOfferList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ResourceTransferOfferList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.ResourceRevisionList) > 0 {
+		for iNdEx := len(m.ResourceRevisionList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ResourceRevisionList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if m.ResourceCount != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.ResourceCount))
 		i--
@@ -192,6 +373,52 @@ func (m *GenesisState) Size() (n int) {
 	if m.ResourceCount != 0 {
 		n += 1 + sovGenesis(uint64(m.ResourceCount))
 	}
+	if len(m.ResourceRevisionList) > 0 {
+		for _, e := range m.ResourceRevisionList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ResourceTransferOfferList) > 0 {
+		for _, e := range m.ResourceTransferOfferList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ResourceRoleGrantList) > 0 {
+		for _, e := range m.ResourceRoleGrantList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ResourceSchemaList) > 0 {
+		for _, e := range m.ResourceSchemaList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.NamespaceList) > 0 {
+		for _, e := range m.NamespaceList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.ResourceReplicationList) > 0 {
+		for _, e := range m.ResourceReplicationList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ResourceReplicaList) > 0 {
+		for _, e := range m.ResourceReplicaList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -316,6 +543,276 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceRevisionList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ResourceRevisionList = append(m.ResourceRevisionList, ResourceRevision{})
+			if err := m.ResourceRevisionList[len(m.ResourceRevisionList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceTransferOfferList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ResourceTransferOfferList = append(m.ResourceTransferOfferList, ResourceTransferOffer{})
+			if err := m.ResourceTransferOfferList[len(m.ResourceTransferOfferList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceRoleGrantList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ResourceRoleGrantList = append(m.ResourceRoleGrantList, ResourceRoleGrant{})
+			if err := m.ResourceRoleGrantList[len(m.ResourceRoleGrantList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceSchemaList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ResourceSchemaList = append(m.ResourceSchemaList, ResourceSchema{})
+			if err := m.ResourceSchemaList[len(m.ResourceSchemaList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NamespaceList = append(m.NamespaceList, Namespace{})
+			if err := m.NamespaceList[len(m.NamespaceList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceReplicationList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ResourceReplicationList = append(m.ResourceReplicationList, ResourceReplication{})
+			if err := m.ResourceReplicationList[len(m.ResourceReplicationList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceReplicaList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ResourceReplicaList = append(m.ResourceReplicaList, ResourceReplica{})
+			if err := m.ResourceReplicaList[len(m.ResourceReplicaList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
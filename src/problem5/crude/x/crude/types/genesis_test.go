@@ -3,6 +3,7 @@ package types_test
 import (
 	"testing"
 
+	"crude/testutil/sample"
 	"crude/x/crude/types"
 
 	"github.com/stretchr/testify/require"
@@ -32,6 +33,33 @@ func TestGenesisState_Validate(t *testing.T) {
 					},
 				},
 				ResourceCount: 2,
+				ResourceRevisionList: []types.ResourceRevision{
+					{
+						ResourceId: 0,
+						Revision:   0,
+					},
+					{
+						ResourceId: 0,
+						Revision:   1,
+					},
+				},
+				ResourceTransferOfferList: []types.ResourceTransferOffer{
+					{
+						ResourceId: 1,
+					},
+				},
+				ResourceRoleGrantList: []types.ResourceRoleGrant{
+					{
+						ResourceId: 0,
+						Address:    "A",
+						Role:       types.ResourceRole_RESOURCE_ROLE_EDITOR,
+					},
+					{
+						ResourceId: 0,
+						Address:    "B",
+						Role:       types.ResourceRole_RESOURCE_ROLE_ADMIN,
+					},
+				},
 				// this line is used by starport scaffolding # types/genesis/validField
 			},
 			valid: true,
@@ -62,6 +90,372 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			valid: false,
 		},
+		{
+			desc: "duplicated resource revision",
+			genState: &types.GenesisState{
+				ResourceList: []types.Resource{
+					{
+						Id: 0,
+					},
+				},
+				ResourceCount: 1,
+				ResourceRevisionList: []types.ResourceRevision{
+					{
+						ResourceId: 0,
+						Revision:   0,
+					},
+					{
+						ResourceId: 0,
+						Revision:   0,
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid resource revision id",
+			genState: &types.GenesisState{
+				ResourceCount: 1,
+				ResourceRevisionList: []types.ResourceRevision{
+					{
+						ResourceId: 1,
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated resource transfer offer",
+			genState: &types.GenesisState{
+				ResourceList: []types.Resource{
+					{
+						Id: 0,
+					},
+				},
+				ResourceCount: 1,
+				ResourceTransferOfferList: []types.ResourceTransferOffer{
+					{
+						ResourceId: 0,
+					},
+					{
+						ResourceId: 0,
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "transfer offer for unknown resource",
+			genState: &types.GenesisState{
+				ResourceCount: 1,
+				ResourceTransferOfferList: []types.ResourceTransferOffer{
+					{
+						ResourceId: 0,
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated resource role grant",
+			genState: &types.GenesisState{
+				ResourceList: []types.Resource{
+					{
+						Id: 0,
+					},
+				},
+				ResourceCount: 1,
+				ResourceRoleGrantList: []types.ResourceRoleGrant{
+					{
+						ResourceId: 0,
+						Address:    "A",
+						Role:       types.ResourceRole_RESOURCE_ROLE_EDITOR,
+					},
+					{
+						ResourceId: 0,
+						Address:    "A",
+						Role:       types.ResourceRole_RESOURCE_ROLE_ADMIN,
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "role grant for unknown resource",
+			genState: &types.GenesisState{
+				ResourceCount: 1,
+				ResourceRoleGrantList: []types.ResourceRoleGrant{
+					{
+						ResourceId: 0,
+						Address:    "A",
+						Role:       types.ResourceRole_RESOURCE_ROLE_EDITOR,
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "owner role grant",
+			genState: &types.GenesisState{
+				ResourceList: []types.Resource{
+					{
+						Id: 0,
+					},
+				},
+				ResourceCount: 1,
+				ResourceRoleGrantList: []types.ResourceRoleGrant{
+					{
+						ResourceId: 0,
+						Address:    "A",
+						Role:       types.ResourceRole_RESOURCE_ROLE_OWNER,
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "negative resource expiry height",
+			genState: &types.GenesisState{
+				ResourceList: []types.Resource{
+					{
+						Id:           0,
+						ExpiryHeight: -1,
+					},
+				},
+				ResourceCount: 1,
+			},
+			valid: false,
+		},
+		{
+			desc: "resource value out of bounds",
+			genState: &types.GenesisState{
+				ResourceList: []types.Resource{
+					{
+						Id:          0,
+						Payload:     types.NewUint64Payload(5),
+						ValueBounds: &types.ResourceValueBounds{Min: 0, Max: 4},
+					},
+				},
+				ResourceCount: 1,
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated resource attribute",
+			genState: &types.GenesisState{
+				ResourceList: []types.Resource{
+					{
+						Id:         0,
+						Attributes: []types.ResourceAttribute{{Key: "a"}, {Key: "a"}},
+					},
+				},
+				ResourceCount: 1,
+			},
+			valid: false,
+		},
+		{
+			desc: "unsorted resource tags",
+			genState: &types.GenesisState{
+				ResourceList: []types.Resource{
+					{
+						Id:   0,
+						Tags: []string{"team:payments", "env:prod"},
+					},
+				},
+				ResourceCount: 1,
+			},
+			valid: false,
+		},
+		{
+			desc: "resource conforming to its schema",
+			genState: &types.GenesisState{
+				ResourceList: []types.Resource{
+					{
+						Id:     0,
+						Name:   `{"sku":"a-1"}`,
+						Schema: &types.ResourceSchemaRef{Id: "product", Version: 1},
+					},
+				},
+				ResourceCount: 1,
+				ResourceSchemaList: []types.ResourceSchema{
+					{
+						Id:       "product",
+						Version:  1,
+						Owner:    sample.AccAddress(),
+						Document: `{"type":"object","required":["sku"]}`,
+					},
+				},
+			},
+			valid: true,
+		},
+		{
+			desc: "duplicated resource schema version",
+			genState: &types.GenesisState{
+				ResourceSchemaList: []types.ResourceSchema{
+					{Id: "product", Version: 1, Owner: sample.AccAddress(), Document: `{}`},
+					{Id: "product", Version: 1, Owner: sample.AccAddress(), Document: `{}`},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid resource schema document",
+			genState: &types.GenesisState{
+				ResourceSchemaList: []types.ResourceSchema{
+					{Id: "product", Version: 1, Owner: sample.AccAddress(), Document: `{"type":1}`},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "resource referencing an unknown schema",
+			genState: &types.GenesisState{
+				ResourceList: []types.Resource{
+					{
+						Id:     0,
+						Name:   `{}`,
+						Schema: &types.ResourceSchemaRef{Id: "product", Version: 2},
+					},
+				},
+				ResourceCount: 1,
+				ResourceSchemaList: []types.ResourceSchema{
+					{Id: "product", Version: 1, Owner: sample.AccAddress(), Document: `{}`},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "resource violating its schema",
+			genState: &types.GenesisState{
+				ResourceList: []types.Resource{
+					{
+						Id:     0,
+						Name:   `{"name":"a-1"}`,
+						Schema: &types.ResourceSchemaRef{Id: "product", Version: 1},
+					},
+				},
+				ResourceCount: 1,
+				ResourceSchemaList: []types.ResourceSchema{
+					{Id: "product", Version: 1, Owner: sample.AccAddress(), Document: `{"required":["sku"]}`},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "resource in a namespace",
+			genState: &types.GenesisState{
+				ResourceList: []types.Resource{
+					{Id: 0, Namespace: "acme"},
+				},
+				ResourceCount: 1,
+				NamespaceList: []types.Namespace{
+					{
+						Name:         "acme",
+						Admin:        sample.AccAddress(),
+						MaxResources: 10,
+						DefaultRoles: []types.NamespaceRoleGrant{{Address: sample.AccAddress(), Role: types.ResourceRole_RESOURCE_ROLE_EDITOR}},
+					},
+				},
+			},
+			valid: true,
+		},
+		{
+			desc: "duplicated namespace",
+			genState: &types.GenesisState{
+				NamespaceList: []types.Namespace{
+					{Name: "acme", Admin: sample.AccAddress()},
+					{Name: "acme", Admin: sample.AccAddress()},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid namespace admin",
+			genState: &types.GenesisState{
+				NamespaceList: []types.Namespace{
+					{Name: "acme", Admin: "invalid_address"},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "resource in an unknown namespace",
+			genState: &types.GenesisState{
+				ResourceList: []types.Resource{
+					{Id: 0, Namespace: "acme"},
+				},
+				ResourceCount: 1,
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated resource name with unique names",
+			genState: &types.GenesisState{
+				Params: types.Params{UniqueNames: true},
+				ResourceList: []types.Resource{
+					{Id: 0, Name: "a"},
+					{Id: 1, Name: "a"},
+				},
+				ResourceCount: 2,
+			},
+			valid: false,
+		},
+		{
+			desc: "resource replications and replicas",
+			genState: &types.GenesisState{
+				PortId:        types.PortID,
+				ResourceList:  []types.Resource{{Id: 0}, {Id: 1}},
+				ResourceCount: 2,
+				ResourceReplicationList: []types.ResourceReplication{
+					{ResourceId: 0, ChannelId: "channel-0", ReplicaId: 3},
+					{ResourceId: 0, ChannelId: "channel-1"},
+				},
+				ResourceReplicaList: []types.ResourceReplica{
+					{ChannelId: "channel-0", SourceId: 0, ResourceId: 1},
+				},
+			},
+			valid: true,
+		},
+		{
+			desc: "invalid port",
+			genState: &types.GenesisState{
+				PortId: "a",
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated resource replication",
+			genState: &types.GenesisState{
+				ResourceList:  []types.Resource{{Id: 0}},
+				ResourceCount: 1,
+				ResourceReplicationList: []types.ResourceReplication{
+					{ResourceId: 0, ChannelId: "channel-0"},
+					{ResourceId: 0, ChannelId: "channel-0"},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "replication of an unknown resource",
+			genState: &types.GenesisState{
+				ResourceReplicationList: []types.ResourceReplication{
+					{ResourceId: 0, ChannelId: "channel-0"},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "resource replicating two sources",
+			genState: &types.GenesisState{
+				ResourceList:  []types.Resource{{Id: 0}},
+				ResourceCount: 1,
+				ResourceReplicaList: []types.ResourceReplica{
+					{ChannelId: "channel-0", SourceId: 0, ResourceId: 0},
+					{ChannelId: "channel-1", SourceId: 0, ResourceId: 0},
+				},
+			},
+			valid: false,
+		},
 		// this line is used by starport scaffolding # types/genesis/testcase
 	}
 	for _, tc := range tests {
@@ -0,0 +1,69 @@
+package types
+
+import (
+	"context"
+	"errors"
+)
+
+// combine multiple resource hooks, all hook functions are run in array sequence
+var _ ResourceHooks = &MultiResourceHooks{}
+
+type MultiResourceHooks []ResourceHooks
+
+func NewMultiResourceHooks(hooks ...ResourceHooks) MultiResourceHooks {
+	return hooks
+}
+
+func (h MultiResourceHooks) BeforeResourceCreated(ctx context.Context, resource Resource) error {
+	var errs error
+	for i := range h {
+		errs = errors.Join(errs, h[i].BeforeResourceCreated(ctx, resource))
+	}
+	return errs
+}
+
+func (h MultiResourceHooks) AfterResourceCreated(ctx context.Context, resource Resource) error {
+	var errs error
+	for i := range h {
+		errs = errors.Join(errs, h[i].AfterResourceCreated(ctx, resource))
+	}
+	return errs
+}
+
+func (h MultiResourceHooks) BeforeResourceUpdated(ctx context.Context, old, updated Resource) error {
+	var errs error
+	for i := range h {
+		errs = errors.Join(errs, h[i].BeforeResourceUpdated(ctx, old, updated))
+	}
+	return errs
+}
+
+func (h MultiResourceHooks) AfterResourceUpdated(ctx context.Context, old, updated Resource) error {
+	var errs error
+	for i := range h {
+		errs = errors.Join(errs, h[i].AfterResourceUpdated(ctx, old, updated))
+	}
+	return errs
+}
+
+func (h MultiResourceHooks) BeforeResourceDeleted(ctx context.Context, resource Resource) error {
+	var errs error
+	for i := range h {
+		errs = errors.Join(errs, h[i].BeforeResourceDeleted(ctx, resource))
+	}
+	return errs
+}
+
+func (h MultiResourceHooks) AfterResourceDeleted(ctx context.Context, resource Resource) error {
+	var errs error
+	for i := range h {
+		errs = errors.Join(errs, h[i].AfterResourceDeleted(ctx, resource))
+	}
+	return errs
+}
+
+// ResourceHooksWrapper is a wrapper for modules to inject ResourceHooks using depinject.
+type ResourceHooksWrapper struct{ ResourceHooks }
+
+// IsOnePerModuleType implements the depinject.OnePerModuleType interface.
+func (ResourceHooksWrapper) IsOnePerModuleType() {}
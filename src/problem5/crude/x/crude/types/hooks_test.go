@@ -0,0 +1,72 @@
+package types_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"crude/x/crude/types"
+)
+
+type recordingHooks struct {
+	name  string
+	calls *[]string
+	err   error
+}
+
+func (h recordingHooks) record(call string) error {
+	*h.calls = append(*h.calls, h.name+"."+call)
+	return h.err
+}
+
+func (h recordingHooks) BeforeResourceCreated(context.Context, types.Resource) error {
+	return h.record("BeforeResourceCreated")
+}
+
+func (h recordingHooks) AfterResourceCreated(context.Context, types.Resource) error {
+	return h.record("AfterResourceCreated")
+}
+
+func (h recordingHooks) BeforeResourceUpdated(context.Context, types.Resource, types.Resource) error {
+	return h.record("BeforeResourceUpdated")
+}
+
+func (h recordingHooks) AfterResourceUpdated(context.Context, types.Resource, types.Resource) error {
+	return h.record("AfterResourceUpdated")
+}
+
+func (h recordingHooks) BeforeResourceDeleted(context.Context, types.Resource) error {
+	return h.record("BeforeResourceDeleted")
+}
+
+func (h recordingHooks) AfterResourceDeleted(context.Context, types.Resource) error {
+	return h.record("AfterResourceDeleted")
+}
+
+func TestMultiResourceHooks(t *testing.T) {
+	var calls []string
+	errVeto := errors.New("veto")
+	hooks := types.NewMultiResourceHooks(
+		recordingHooks{name: "a", calls: &calls},
+		recordingHooks{name: "b", calls: &calls, err: errVeto},
+		recordingHooks{name: "c", calls: &calls},
+	)
+	ctx := context.Background()
+
+	// Every hook runs, in order, and the errors are joined
+	require.ErrorIs(t, hooks.BeforeResourceCreated(ctx, types.Resource{}), errVeto)
+	require.Equal(t, []string{"a.BeforeResourceCreated", "b.BeforeResourceCreated", "c.BeforeResourceCreated"}, calls)
+
+	calls = nil
+	require.ErrorIs(t, hooks.AfterResourceUpdated(ctx, types.Resource{}, types.Resource{}), errVeto)
+	require.Equal(t, []string{"a.AfterResourceUpdated", "b.AfterResourceUpdated", "c.AfterResourceUpdated"}, calls)
+
+	calls = nil
+	require.NoError(t, types.NewMultiResourceHooks(recordingHooks{name: "a", calls: &calls}).BeforeResourceDeleted(ctx, types.Resource{}))
+	require.Equal(t, []string{"a.BeforeResourceDeleted"}, calls)
+
+	// An empty set of hooks is a no-op
+	require.NoError(t, types.MultiResourceHooks{}.AfterResourceDeleted(ctx, types.Resource{}))
+}
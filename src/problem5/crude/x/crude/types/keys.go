@@ -1,5 +1,7 @@
 package types
 
+import "cosmossdk.io/collections"
+
 const (
 	// ModuleName defines the module name
 	ModuleName = "crude"
@@ -9,17 +11,92 @@ const (
 
 	// MemStoreKey defines the in-memory store key
 	MemStoreKey = "mem_crude"
+
+	// Version defines the current version the IBC module supports
+	Version = "crude-1"
+
+	// PortID is the default port id that module binds to
+	PortID = "crude"
 )
 
 var (
-	ParamsKey = []byte("p_crude")
+	ParamsKey = collections.NewPrefix("p_crude")
 )
 
 func KeyPrefix(p string) []byte {
 	return []byte(p)
 }
 
-const (
-	ResourceKey      = "Resource/value/"
-	ResourceCountKey = "Resource/count/"
+var (
+	ResourceKey      = collections.NewPrefix(1)
+	ResourceCountKey = collections.NewPrefix(2)
+
+	// ResourceCreatorKey prefixes the creator->id secondary index
+	ResourceCreatorKey = collections.NewPrefix(3)
+
+	// ResourceRevisionKey prefixes the (id, revision)->snapshot history
+	ResourceRevisionKey = collections.NewPrefix(4)
+
+	// ResourceOwnerKey prefixes the owner->id secondary index
+	ResourceOwnerKey = collections.NewPrefix(5)
+
+	// ResourceTransferOfferKey prefixes the pending ownership transfers
+	ResourceTransferOfferKey = collections.NewPrefix(6)
+
+	// ResourceRoleKey prefixes the (id, address)->role access control lists
+	ResourceRoleKey = collections.NewPrefix(7)
+
+	// ResourceGranteeKey prefixes the address->id index of role grants
+	ResourceGranteeKey = collections.NewPrefix(8)
+
+	// ResourceExpiryHeightKey prefixes the (height, id) expiry queue
+	ResourceExpiryHeightKey = collections.NewPrefix(9)
+
+	// ResourceExpiryTimeKey prefixes the (time, id) expiry queue
+	ResourceExpiryTimeKey = collections.NewPrefix(10)
+
+	// ResourceAttributeKey prefixes the (key, value, id) attribute index
+	ResourceAttributeKey = collections.NewPrefix(11)
+
+	// ResourceTagKey prefixes the (tag, id) tag index
+	ResourceTagKey = collections.NewPrefix(12)
+
+	// ResourceSchemaKey prefixes the (id, version)->schema registry
+	ResourceSchemaKey = collections.NewPrefix(13)
+
+	// NamespaceKey prefixes the name->namespace map
+	NamespaceKey = collections.NewPrefix(14)
+
+	// ResourceNamespaceKey prefixes the (namespace, id) namespace index
+	ResourceNamespaceKey = collections.NewPrefix(15)
+
+	// ResourceNameKey prefixes the (name, id) name index
+	ResourceNameKey = collections.NewPrefix(16)
+
+	// ResourceTotalsKey prefixes the running aggregates over live resources
+	ResourceTotalsKey = collections.NewPrefix(17)
+
+	// CreatorResourceCountKey prefixes the creator->live resource count map
+	CreatorResourceCountKey = collections.NewPrefix(18)
+
+	// CreatorRankKey prefixes the (inverted count, creator) ranking of creators
+	CreatorRankKey = collections.NewPrefix(19)
+
+	// ResourceValueCountKey prefixes the uint64 value->number of resources map
+	ResourceValueCountKey = collections.NewPrefix(20)
+
+	// PortKey defines the key to store the port ID in store
+	PortKey = collections.NewPrefix(21)
+
+	// ResourceReplicationKey prefixes the (id, channel) replication subscriptions
+	ResourceReplicationKey = collections.NewPrefix(22)
+
+	// ReplicationChannelKey prefixes the (channel, id) index of subscriptions
+	ReplicationChannelKey = collections.NewPrefix(23)
+
+	// ResourceReplicaKey prefixes the (channel, source id)->replica links
+	ResourceReplicaKey = collections.NewPrefix(24)
+
+	// NamespaceResourceCountKey prefixes the namespace->live resource count map
+	NamespaceResourceCountKey = collections.NewPrefix(25)
 )
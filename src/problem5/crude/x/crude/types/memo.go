@@ -0,0 +1,57 @@
+package types
+
+import (
+	"encoding/json"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+// MemoKey is the field of an ICS-20 transfer memo holding a TransferMemo
+const MemoKey = "crude"
+
+// ParseTransferMemo extracts the TransferMemo from the memo of an ICS-20
+// transfer. A memo that is not a JSON object or has no crude field is meant for
+// someone else and reports false, a crude field that does not decode to a valid
+// TransferMemo is an error.
+func ParseTransferMemo(memo string) (m TransferMemo, ok bool, err error) {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(memo), &fields); err != nil {
+		return m, false, nil
+	}
+	raw, ok := fields[MemoKey]
+	if !ok {
+		return m, false, nil
+	}
+
+	if err := ModuleCdc.UnmarshalJSON(raw, &m); err != nil {
+		return m, true, errorsmod.Wrap(ErrInvalidTransferMemo, err.Error())
+	}
+	return m, true, m.ValidateBasic()
+}
+
+// ValidateBasic checks that exactly one operation is set, without a creator
+func (m TransferMemo) ValidateBasic() error {
+	var creator string
+	switch op := m.Operation.(type) {
+	case *TransferMemo_Create:
+		creator = op.Create.Creator
+	case *TransferMemo_Update:
+		creator = op.Update.Creator
+	default:
+		return errorsmod.Wrap(ErrInvalidTransferMemo, "no resource operation")
+	}
+	if creator != "" {
+		return errorsmod.Wrap(ErrInvalidTransferMemo, "the creator is the intermediary account of the sender")
+	}
+
+	return nil
+}
+
+// IntermediaryAccount is the account the resource operations of the transfers
+// of a sender over a channel run on behalf of. It is derived from the module
+// address, so that no key controls it and no other sender or channel shares it.
+func IntermediaryAccount(channelID, sender string) sdk.AccAddress {
+	return address.Module(ModuleName, []byte(channelID+"/"+sender))
+}
@@ -0,0 +1,468 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: crude/crude/memo.proto
+
+package types
+
+import (
+	fmt "fmt"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// TransferMemo is the value of the crude field of the memo of an ICS-20
+// transfer, the resource operation to run once the tokens are received.
+// Exactly one operation is set, its creator is left empty: the operation runs
+// on behalf of the intermediary account derived from the channel and the
+// sender, which receives the tokens.
+type TransferMemo struct {
+	// Types that are valid to be assigned to Operation:
+	//	*TransferMemo_Create
+	//	*TransferMemo_Update
+	Operation isTransferMemo_Operation `protobuf_oneof:"operation"`
+}
+
+func (m *TransferMemo) Reset()         { *m = TransferMemo{} }
+func (m *TransferMemo) String() string { return proto.CompactTextString(m) }
+func (*TransferMemo) ProtoMessage()    {}
+func (*TransferMemo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7d68b9cf09fc11e, []int{0}
+}
+func (m *TransferMemo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TransferMemo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TransferMemo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TransferMemo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransferMemo.Merge(m, src)
+}
+func (m *TransferMemo) XXX_Size() int {
+	return m.Size()
+}
+func (m *TransferMemo) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransferMemo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TransferMemo proto.InternalMessageInfo
+
+type isTransferMemo_Operation interface {
+	isTransferMemo_Operation()
+	MarshalTo([]byte) (int, error)
+	Size() int
+}
+
+type TransferMemo_Create struct {
+	Create *MsgCreateResource `protobuf:"bytes,1,opt,name=create,proto3,oneof" json:"create,omitempty"`
+}
+type TransferMemo_Update struct {
+	Update *MsgUpdateResource `protobuf:"bytes,2,opt,name=update,proto3,oneof" json:"update,omitempty"`
+}
+
+func (*TransferMemo_Create) isTransferMemo_Operation() {}
+func (*TransferMemo_Update) isTransferMemo_Operation() {}
+
+func (m *TransferMemo) GetOperation() isTransferMemo_Operation {
+	if m != nil {
+		return m.Operation
+	}
+	return nil
+}
+
+func (m *TransferMemo) GetCreate() *MsgCreateResource {
+	if x, ok := m.GetOperation().(*TransferMemo_Create); ok {
+		return x.Create
+	}
+	return nil
+}
+
+func (m *TransferMemo) GetUpdate() *MsgUpdateResource {
+	if x, ok := m.GetOperation().(*TransferMemo_Update); ok {
+		return x.Update
+	}
+	return nil
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*TransferMemo) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*TransferMemo_Create)(nil),
+		(*TransferMemo_Update)(nil),
+	}
+}
+
+func init() {
+	proto.RegisterType((*TransferMemo)(nil), "crude.crude.TransferMemo")
+}
+
+func init() { proto.RegisterFile("crude/crude/memo.proto", fileDescriptor_f7d68b9cf09fc11e) }
+
+var fileDescriptor_f7d68b9cf09fc11e = []byte{
+	// 188 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0x4b, 0x2e, 0x2a, 0x4d,
+	0x49, 0xd5, 0x87, 0x90, 0xb9, 0xa9, 0xb9, 0xf9, 0x7a, 0x05, 0x45, 0xf9, 0x25, 0xf9, 0x42, 0xdc,
+	0x60, 0x11, 0x3d, 0x30, 0x29, 0x25, 0x82, 0xac, 0xa8, 0xa4, 0x02, 0xa2, 0x44, 0xa9, 0x9f, 0x91,
+	0x8b, 0x27, 0xa4, 0x28, 0x31, 0xaf, 0x38, 0x2d, 0xb5, 0xc8, 0x37, 0x35, 0x37, 0x5f, 0xc8, 0x82,
+	0x8b, 0x2d, 0xb9, 0x28, 0x35, 0xb1, 0x24, 0x55, 0x82, 0x51, 0x81, 0x51, 0x83, 0xdb, 0x48, 0x4e,
+	0x0f, 0xc9, 0x10, 0x3d, 0xdf, 0xe2, 0x74, 0x67, 0xb0, 0x6c, 0x50, 0x6a, 0x71, 0x7e, 0x69, 0x51,
+	0x72, 0xaa, 0x07, 0x43, 0x10, 0x54, 0x3d, 0x48, 0x67, 0x69, 0x41, 0x0a, 0x48, 0x27, 0x13, 0x76,
+	0x9d, 0xa1, 0x05, 0x29, 0x68, 0x3a, 0x21, 0xea, 0x9d, 0xb8, 0xb9, 0x38, 0xf3, 0x0b, 0x52, 0x8b,
+	0x12, 0x4b, 0x32, 0xf3, 0xf3, 0x9c, 0x74, 0x4f, 0x3c, 0x92, 0x63, 0xbc, 0xf0, 0x48, 0x8e, 0xf1,
+	0xc1, 0x23, 0x39, 0xc6, 0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96, 0x63, 0xb8, 0xf1, 0x58, 0x8e,
+	0x21, 0x4a, 0x18, 0xe2, 0xf6, 0x0a, 0x98, 0x1f, 0x2a, 0x0b, 0x52, 0x8b, 0x93, 0xd8, 0xc0, 0xfe,
+	0x30, 0x06, 0x0c, 0x00, 0x7b, 0x57, 0x0c, 0x74, 0x04, 0x01, 0x00, 0x00,
+}
+
+func (m *TransferMemo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TransferMemo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TransferMemo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Operation != nil {
+		{
+			size := m.Operation.Size()
+			i -= size
+			if _, err := m.Operation.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TransferMemo_Create) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TransferMemo_Create) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Create != nil {
+		{
+			size, err := m.Create.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMemo(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+func (m *TransferMemo_Update) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TransferMemo_Update) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Update != nil {
+		{
+			size, err := m.Update.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMemo(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	return len(dAtA) - i, nil
+}
+func encodeVarintMemo(dAtA []byte, offset int, v uint64) int {
+	offset -= sovMemo(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *TransferMemo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Operation != nil {
+		n += m.Operation.Size()
+	}
+	return n
+}
+
+func (m *TransferMemo_Create) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Create != nil {
+		l = m.Create.Size()
+		n += 1 + l + sovMemo(uint64(l))
+	}
+	return n
+}
+func (m *TransferMemo_Update) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Update != nil {
+		l = m.Update.Size()
+		n += 1 + l + sovMemo(uint64(l))
+	}
+	return n
+}
+
+func sovMemo(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozMemo(x uint64) (n int) {
+	return sovMemo(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *TransferMemo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMemo
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TransferMemo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TransferMemo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Create", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMemo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMemo
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMemo
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &MsgCreateResource{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Operation = &TransferMemo_Create{v}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Update", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMemo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMemo
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMemo
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &MsgUpdateResource{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Operation = &TransferMemo_Update{v}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMemo(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMemo
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipMemo(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowMemo
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowMemo
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowMemo
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthMemo
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupMemo
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthMemo
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthMemo        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowMemo          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupMemo = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,60 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseTransferMemo(t *testing.T) {
+	for _, tc := range []struct {
+		desc string
+		memo string
+		ours bool
+		err  error
+		op   isTransferMemo_Operation
+	}{
+		{desc: "Empty"},
+		{desc: "PlainText", memo: "thanks"},
+		{desc: "OtherField", memo: `{"forward":{"receiver":"cosmos1..."}}`},
+		{
+			desc: "Create",
+			memo: `{"crude":{"create":{"name":"widget","payload":{"uint64Value":"5"},"tags":["a"]}}}`,
+			ours: true,
+			op:   &TransferMemo_Create{Create: &MsgCreateResource{Name: "widget", Payload: NewUint64Payload(5), Tags: []string{"a"}}},
+		},
+		{
+			desc: "Update",
+			memo: `{"crude":{"update":{"id":"3","name":"gadget"}},"forward":{}}`,
+			ours: true,
+			op:   &TransferMemo_Update{Update: &MsgUpdateResource{Id: 3, Name: "gadget"}},
+		},
+		{desc: "NoOperation", memo: `{"crude":{}}`, ours: true, err: ErrInvalidTransferMemo},
+		{desc: "NotAnObject", memo: `{"crude":"create"}`, ours: true, err: ErrInvalidTransferMemo},
+		{desc: "UnknownField", memo: `{"crude":{"delete":{"id":"3"}}}`, ours: true, err: ErrInvalidTransferMemo},
+		{
+			desc: "Creator",
+			memo: `{"crude":{"create":{"creator":"cosmos1...","name":"widget"}}}`,
+			ours: true,
+			err:  ErrInvalidTransferMemo,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			memo, ours, err := ParseTransferMemo(tc.memo)
+			require.Equal(t, tc.ours, ours)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.op, memo.Operation)
+		})
+	}
+}
+
+func TestIntermediaryAccount(t *testing.T) {
+	account := IntermediaryAccount("channel-0", "cosmos1sender")
+	require.Equal(t, account, IntermediaryAccount("channel-0", "cosmos1sender"))
+	require.NotEqual(t, account, IntermediaryAccount("channel-1", "cosmos1sender"))
+	require.NotEqual(t, account, IntermediaryAccount("channel-0", "cosmos1other"))
+}
@@ -0,0 +1,63 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgCreateNamespace{}
+
+func NewMsgCreateNamespace(creator string, name string, maxResources uint64, defaultRoles []NamespaceRoleGrant) *MsgCreateNamespace {
+	return &MsgCreateNamespace{
+		Creator:      creator,
+		Name:         name,
+		MaxResources: maxResources,
+		DefaultRoles: defaultRoles,
+	}
+}
+
+func (msg *MsgCreateNamespace) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if err := ValidateNamespaceName(msg.Name); err != nil {
+		return errorsmod.Wrap(ErrInvalidNamespace, err.Error())
+	}
+	if err := ValidateNamespaceRoles(msg.DefaultRoles); err != nil {
+		return errorsmod.Wrap(ErrInvalidNamespace, err.Error())
+	}
+	return nil
+}
+
+var _ sdk.Msg = &MsgUpdateNamespace{}
+
+func NewMsgUpdateNamespace(creator string, name string, admin string, maxResources uint64, defaultRoles []NamespaceRoleGrant) *MsgUpdateNamespace {
+	return &MsgUpdateNamespace{
+		Creator:      creator,
+		Name:         name,
+		Admin:        admin,
+		MaxResources: maxResources,
+		DefaultRoles: defaultRoles,
+	}
+}
+
+func (msg *MsgUpdateNamespace) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if msg.Admin != "" {
+		if _, err := sdk.AccAddressFromBech32(msg.Admin); err != nil {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid admin address (%s)", err)
+		}
+	}
+	if err := ValidateNamespaceName(msg.Name); err != nil {
+		return errorsmod.Wrap(ErrInvalidNamespace, err.Error())
+	}
+	if err := ValidateNamespaceRoles(msg.DefaultRoles); err != nil {
+		return errorsmod.Wrap(ErrInvalidNamespace, err.Error())
+	}
+	return nil
+}
@@ -0,0 +1,138 @@
+package types
+
+import (
+	"testing"
+
+	"crude/testutil/sample"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgCreateNamespace_ValidateBasic(t *testing.T) {
+	editor := sample.AccAddress()
+	tests := []struct {
+		name string
+		msg  MsgCreateNamespace
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgCreateNamespace{
+				Creator: "invalid_address",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "invalid name",
+			msg: MsgCreateNamespace{
+				Creator: sample.AccAddress(),
+				Name:    "acme/payments",
+			},
+			err: ErrInvalidNamespace,
+		}, {
+			name: "invalid default role address",
+			msg: MsgCreateNamespace{
+				Creator:      sample.AccAddress(),
+				Name:         "acme",
+				DefaultRoles: []NamespaceRoleGrant{{Address: "invalid_address", Role: ResourceRole_RESOURCE_ROLE_EDITOR}},
+			},
+			err: ErrInvalidNamespace,
+		}, {
+			name: "owner default role",
+			msg: MsgCreateNamespace{
+				Creator:      sample.AccAddress(),
+				Name:         "acme",
+				DefaultRoles: []NamespaceRoleGrant{{Address: editor, Role: ResourceRole_RESOURCE_ROLE_OWNER}},
+			},
+			err: ErrInvalidNamespace,
+		}, {
+			name: "duplicated default role",
+			msg: MsgCreateNamespace{
+				Creator: sample.AccAddress(),
+				Name:    "acme",
+				DefaultRoles: []NamespaceRoleGrant{
+					{Address: editor, Role: ResourceRole_RESOURCE_ROLE_EDITOR},
+					{Address: editor, Role: ResourceRole_RESOURCE_ROLE_ADMIN},
+				},
+			},
+			err: ErrInvalidNamespace,
+		}, {
+			name: "valid address",
+			msg: MsgCreateNamespace{
+				Creator:      sample.AccAddress(),
+				Name:         "acme",
+				MaxResources: 10,
+				DefaultRoles: []NamespaceRoleGrant{{Address: editor, Role: ResourceRole_RESOURCE_ROLE_EDITOR}},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgUpdateNamespace_ValidateBasic(t *testing.T) {
+	editor := sample.AccAddress()
+	tests := []struct {
+		name string
+		msg  MsgUpdateNamespace
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgUpdateNamespace{
+				Creator: "invalid_address",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "invalid admin address",
+			msg: MsgUpdateNamespace{
+				Creator: sample.AccAddress(),
+				Name:    "acme",
+				Admin:   "invalid_address",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "invalid name",
+			msg: MsgUpdateNamespace{
+				Creator: sample.AccAddress(),
+				Name:    "acme/payments",
+			},
+			err: ErrInvalidNamespace,
+		}, {
+			name: "owner default role",
+			msg: MsgUpdateNamespace{
+				Creator:      sample.AccAddress(),
+				Name:         "acme",
+				DefaultRoles: []NamespaceRoleGrant{{Address: editor, Role: ResourceRole_RESOURCE_ROLE_OWNER}},
+			},
+			err: ErrInvalidNamespace,
+		}, {
+			name: "valid address",
+			msg: MsgUpdateNamespace{
+				Creator:      sample.AccAddress(),
+				Name:         "acme",
+				Admin:        sample.AccAddress(),
+				MaxResources: 10,
+				DefaultRoles: []NamespaceRoleGrant{{Address: editor, Role: ResourceRole_RESOURCE_ROLE_EDITOR}},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"time"
+
 	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -8,11 +10,11 @@ import (
 
 var _ sdk.Msg = &MsgCreateResource{}
 
-func NewMsgCreateResource(creator string, name string, value uint64) *MsgCreateResource {
+func NewMsgCreateResource(creator string, name string, payload *ResourcePayload) *MsgCreateResource {
 	return &MsgCreateResource{
 		Creator: creator,
 		Name:    name,
-		Value:   value,
+		Payload: payload,
 	}
 }
 
@@ -21,17 +23,40 @@ func (msg *MsgCreateResource) ValidateBasic() error {
 	if err != nil {
 		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
 	}
+	if msg.ExpiryHeight < 0 {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "negative expiry height %d", msg.ExpiryHeight)
+	}
+	if err := msg.ValueBounds.Validate(); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	if err := msg.Payload.Validate(); err != nil {
+		return errorsmod.Wrap(ErrInvalidPayload, err.Error())
+	}
+	if err := msg.ValueBounds.CheckPayload(msg.Payload); err != nil {
+		return err
+	}
+	if err := ValidateTags(msg.Tags); err != nil {
+		return errorsmod.Wrap(ErrInvalidTag, err.Error())
+	}
+	if err := msg.Schema.Validate(); err != nil {
+		return errorsmod.Wrap(ErrInvalidSchema, err.Error())
+	}
+	if msg.Namespace != "" {
+		if err := ValidateNamespaceName(msg.Namespace); err != nil {
+			return errorsmod.Wrap(ErrInvalidNamespace, err.Error())
+		}
+	}
 	return nil
 }
 
 var _ sdk.Msg = &MsgUpdateResource{}
 
-func NewMsgUpdateResource(creator string, id uint64, name string, value uint64) *MsgUpdateResource {
+func NewMsgUpdateResource(creator string, id uint64, name string, payload *ResourcePayload) *MsgUpdateResource {
 	return &MsgUpdateResource{
 		Id:      id,
 		Creator: creator,
 		Name:    name,
-		Value:   value,
+		Payload: payload,
 	}
 }
 
@@ -40,6 +65,18 @@ func (msg *MsgUpdateResource) ValidateBasic() error {
 	if err != nil {
 		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
 	}
+	if err := msg.ValueBounds.Validate(); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	if err := ValidateTags(msg.Tags); err != nil {
+		return errorsmod.Wrap(ErrInvalidTag, err.Error())
+	}
+	if err := msg.Payload.Validate(); err != nil {
+		return errorsmod.Wrap(ErrInvalidPayload, err.Error())
+	}
+	if err := msg.Schema.Validate(); err != nil {
+		return errorsmod.Wrap(ErrInvalidSchema, err.Error())
+	}
 	return nil
 }
 
@@ -59,3 +96,67 @@ func (msg *MsgDeleteResource) ValidateBasic() error {
 	}
 	return nil
 }
+
+var _ sdk.Msg = &MsgRevertResource{}
+
+func NewMsgRevertResource(creator string, id uint64, revision uint64) *MsgRevertResource {
+	return &MsgRevertResource{
+		Id:       id,
+		Creator:  creator,
+		Revision: revision,
+	}
+}
+
+func (msg *MsgRevertResource) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	return nil
+}
+
+var _ sdk.Msg = &MsgRenewResource{}
+
+func NewMsgRenewResource(creator string, id uint64, expiryHeight int64, expiryTime *time.Time) *MsgRenewResource {
+	return &MsgRenewResource{
+		Creator:      creator,
+		Id:           id,
+		ExpiryHeight: expiryHeight,
+		ExpiryTime:   expiryTime,
+	}
+}
+
+func (msg *MsgRenewResource) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if msg.ExpiryHeight < 0 {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "negative expiry height %d", msg.ExpiryHeight)
+	}
+	if msg.ExpiryHeight == 0 && msg.ExpiryTime == nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "renewal sets no expiry")
+	}
+	return nil
+}
+
+var _ sdk.Msg = &MsgAdjustResourceValue{}
+
+func NewMsgAdjustResourceValue(creator string, id uint64, delta int64) *MsgAdjustResourceValue {
+	return &MsgAdjustResourceValue{
+		Creator: creator,
+		Id:      id,
+		Delta:   delta,
+	}
+}
+
+func (msg *MsgAdjustResourceValue) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if msg.Delta == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "zero delta")
+	}
+	return nil
+}
@@ -0,0 +1,50 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgSetResourceAttribute{}
+
+func NewMsgSetResourceAttribute(creator string, id uint64, key string, value string) *MsgSetResourceAttribute {
+	return &MsgSetResourceAttribute{
+		Creator: creator,
+		Id:      id,
+		Key:     key,
+		Value:   value,
+	}
+}
+
+func (msg *MsgSetResourceAttribute) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if err := (ResourceAttribute{Key: msg.Key, Value: msg.Value}).Validate(); err != nil {
+		return errorsmod.Wrap(ErrInvalidAttribute, err.Error())
+	}
+	return nil
+}
+
+var _ sdk.Msg = &MsgRemoveResourceAttribute{}
+
+func NewMsgRemoveResourceAttribute(creator string, id uint64, key string) *MsgRemoveResourceAttribute {
+	return &MsgRemoveResourceAttribute{
+		Creator: creator,
+		Id:      id,
+		Key:     key,
+	}
+}
+
+func (msg *MsgRemoveResourceAttribute) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if msg.Key == "" {
+		return errorsmod.Wrap(ErrInvalidAttribute, "empty attribute key")
+	}
+	return nil
+}
@@ -0,0 +1,98 @@
+package types
+
+import (
+	"testing"
+
+	"crude/testutil/sample"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgSetResourceAttribute_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgSetResourceAttribute
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgSetResourceAttribute{
+				Creator: "invalid_address",
+				Key:     "colour",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "empty key",
+			msg: MsgSetResourceAttribute{
+				Creator: sample.AccAddress(),
+				Value:   "red",
+			},
+			err: ErrInvalidAttribute,
+		}, {
+			name: "NUL value",
+			msg: MsgSetResourceAttribute{
+				Creator: sample.AccAddress(),
+				Key:     "colour",
+				Value:   "r\x00d",
+			},
+			err: ErrInvalidAttribute,
+		}, {
+			name: "valid address",
+			msg: MsgSetResourceAttribute{
+				Creator: sample.AccAddress(),
+				Key:     "colour",
+				Value:   "red",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgRemoveResourceAttribute_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgRemoveResourceAttribute
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgRemoveResourceAttribute{
+				Creator: "invalid_address",
+				Key:     "colour",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "empty key",
+			msg: MsgRemoveResourceAttribute{
+				Creator: sample.AccAddress(),
+			},
+			err: ErrInvalidAttribute,
+		}, {
+			name: "valid address",
+			msg: MsgRemoveResourceAttribute{
+				Creator: sample.AccAddress(),
+				Key:     "colour",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
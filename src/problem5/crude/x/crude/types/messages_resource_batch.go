@@ -0,0 +1,73 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgBatchResourceOps{}
+
+func NewMsgBatchResourceOps(creator string, ops []ResourceOp) *MsgBatchResourceOps {
+	return &MsgBatchResourceOps{
+		Creator: creator,
+		Ops:     ops,
+	}
+}
+
+func (msg *MsgBatchResourceOps) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if len(msg.Ops) == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "empty batch")
+	}
+	for i, op := range msg.Ops {
+		opMsg := op.Msg(msg.Creator)
+		if opMsg == nil {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "operation %d is empty", i)
+		}
+		if err := opMsg.(sdk.HasValidateBasic).ValidateBasic(); err != nil {
+			return errorsmod.Wrapf(err, "operation %d", i)
+		}
+	}
+	return nil
+}
+
+// Msg returns the single resource message equivalent to the operation when
+// sent by creator, or nil if the operation is empty.
+func (op ResourceOp) Msg(creator string) sdk.Msg {
+	switch op := op.Op.(type) {
+	case *ResourceOp_Create:
+		return &MsgCreateResource{
+			Creator:      creator,
+			Name:         op.Create.Name,
+			Payload:      op.Create.Payload,
+			ValueBounds:  op.Create.ValueBounds,
+			ExpiryHeight: op.Create.ExpiryHeight,
+			ExpiryTime:   op.Create.ExpiryTime,
+			Tags:         op.Create.Tags,
+			Schema:       op.Create.Schema,
+			Namespace:    op.Create.Namespace,
+		}
+	case *ResourceOp_Update:
+		return &MsgUpdateResource{
+			Creator:         creator,
+			Id:              op.Update.Id,
+			Name:            op.Update.Name,
+			Payload:         op.Update.Payload,
+			ExpectedVersion: op.Update.ExpectedVersion,
+			ValueBounds:     op.Update.ValueBounds,
+			Tags:            op.Update.Tags,
+			Schema:          op.Update.Schema,
+		}
+	case *ResourceOp_Delete:
+		return &MsgDeleteResource{
+			Creator:         creator,
+			Id:              op.Delete.Id,
+			ExpectedVersion: op.Delete.ExpectedVersion,
+		}
+	}
+	return nil
+}
@@ -0,0 +1,78 @@
+package types
+
+import (
+	"testing"
+
+	"crude/testutil/sample"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgBatchResourceOps_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgBatchResourceOps
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgBatchResourceOps{
+				Creator: "invalid_address",
+				Ops:     []ResourceOp{{Op: &ResourceOp_Delete{Delete: &DeleteResourceOp{}}}},
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "empty batch",
+			msg: MsgBatchResourceOps{
+				Creator: sample.AccAddress(),
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "empty operation",
+			msg: MsgBatchResourceOps{
+				Creator: sample.AccAddress(),
+				Ops:     []ResourceOp{{}},
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "negative expiry height",
+			msg: MsgBatchResourceOps{
+				Creator: sample.AccAddress(),
+				Ops:     []ResourceOp{{Op: &ResourceOp_Create{Create: &CreateResourceOp{Name: "a", ExpiryHeight: -1}}}},
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "payload out of bounds",
+			msg: MsgBatchResourceOps{
+				Creator: sample.AccAddress(),
+				Ops: []ResourceOp{{Op: &ResourceOp_Create{Create: &CreateResourceOp{
+					Name:        "a",
+					Payload:     NewUint64Payload(20),
+					ValueBounds: &ResourceValueBounds{Min: 1, Max: 10},
+				}}}},
+			},
+			err: ErrValueOutOfBounds,
+		}, {
+			name: "valid address",
+			msg: MsgBatchResourceOps{
+				Creator: sample.AccAddress(),
+				Ops: []ResourceOp{
+					{Op: &ResourceOp_Create{Create: &CreateResourceOp{Name: "a", Payload: NewUint64Payload(1)}}},
+					{Op: &ResourceOp_Update{Update: &UpdateResourceOp{Id: 0, Name: "b", Payload: NewUint64Payload(2)}}},
+					{Op: &ResourceOp_Delete{Delete: &DeleteResourceOp{Id: 0}}},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,35 @@
+package types
+
+import (
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+)
+
+var _ sdk.Msg = &MsgReplicateResource{}
+
+func NewMsgReplicateResource(creator string, id uint64, channelID string, timeoutPeriod time.Duration) *MsgReplicateResource {
+	return &MsgReplicateResource{
+		Creator:       creator,
+		Id:            id,
+		ChannelId:     channelID,
+		TimeoutPeriod: timeoutPeriod,
+	}
+}
+
+func (msg *MsgReplicateResource) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if err := host.ChannelIdentifierValidator(msg.ChannelId); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	if msg.TimeoutPeriod < 0 {
+		return errorsmod.Wrapf(ErrInvalidPacketTimeout, "negative timeout period %s", msg.TimeoutPeriod)
+	}
+	return nil
+}
@@ -0,0 +1,55 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgGrantResourceRole{}
+
+func NewMsgGrantResourceRole(creator string, id uint64, address string, role ResourceRole) *MsgGrantResourceRole {
+	return &MsgGrantResourceRole{
+		Creator: creator,
+		Id:      id,
+		Address: address,
+		Role:    role,
+	}
+}
+
+func (msg *MsgGrantResourceRole) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	_, err = sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid grantee address (%s)", err)
+	}
+	if !msg.Role.IsGrantable() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "role %s cannot be granted", msg.Role)
+	}
+	return nil
+}
+
+var _ sdk.Msg = &MsgRevokeResourceRole{}
+
+func NewMsgRevokeResourceRole(creator string, id uint64, address string) *MsgRevokeResourceRole {
+	return &MsgRevokeResourceRole{
+		Creator: creator,
+		Id:      id,
+		Address: address,
+	}
+}
+
+func (msg *MsgRevokeResourceRole) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	_, err = sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid grantee address (%s)", err)
+	}
+	return nil
+}
@@ -0,0 +1,108 @@
+package types
+
+import (
+	"testing"
+
+	"crude/testutil/sample"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgGrantResourceRole_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgGrantResourceRole
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgGrantResourceRole{
+				Creator: "invalid_address",
+				Address: sample.AccAddress(),
+				Role:    ResourceRole_RESOURCE_ROLE_EDITOR,
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "invalid grantee",
+			msg: MsgGrantResourceRole{
+				Creator: sample.AccAddress(),
+				Address: "invalid_address",
+				Role:    ResourceRole_RESOURCE_ROLE_EDITOR,
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "owner role",
+			msg: MsgGrantResourceRole{
+				Creator: sample.AccAddress(),
+				Address: sample.AccAddress(),
+				Role:    ResourceRole_RESOURCE_ROLE_OWNER,
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "unspecified role",
+			msg: MsgGrantResourceRole{
+				Creator: sample.AccAddress(),
+				Address: sample.AccAddress(),
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "valid address",
+			msg: MsgGrantResourceRole{
+				Creator: sample.AccAddress(),
+				Address: sample.AccAddress(),
+				Role:    ResourceRole_RESOURCE_ROLE_ADMIN,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgRevokeResourceRole_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgRevokeResourceRole
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgRevokeResourceRole{
+				Creator: "invalid_address",
+				Address: sample.AccAddress(),
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "invalid grantee",
+			msg: MsgRevokeResourceRole{
+				Creator: sample.AccAddress(),
+				Address: "invalid_address",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "valid address",
+			msg: MsgRevokeResourceRole{
+				Creator: sample.AccAddress(),
+				Address: sample.AccAddress(),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,49 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgRegisterResourceSchema{}
+
+func NewMsgRegisterResourceSchema(creator string, id string, document string) *MsgRegisterResourceSchema {
+	return &MsgRegisterResourceSchema{
+		Creator:  creator,
+		Id:       id,
+		Document: document,
+	}
+}
+
+func (msg *MsgRegisterResourceSchema) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if err := ValidateSchemaID(msg.Id); err != nil {
+		return errorsmod.Wrap(ErrInvalidSchema, err.Error())
+	}
+	if _, err := CompileSchema(msg.Document); err != nil {
+		return errorsmod.Wrap(ErrInvalidSchema, err.Error())
+	}
+	return nil
+}
+
+var _ sdk.Msg = &MsgApproveResourceSchema{}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgApproveResourceSchema) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	if err := ValidateSchemaID(m.Id); err != nil {
+		return errorsmod.Wrap(ErrInvalidSchema, err.Error())
+	}
+	if m.Version == 0 {
+		return errorsmod.Wrap(ErrInvalidSchema, "schema versions start at 1")
+	}
+
+	return nil
+}
@@ -21,10 +21,62 @@ func TestMsgCreateResource_ValidateBasic(t *testing.T) {
 				Creator: "invalid_address",
 			},
 			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "invalid bounds",
+			msg: MsgCreateResource{
+				Creator:     sample.AccAddress(),
+				ValueBounds: &ResourceValueBounds{Min: 2, Max: 1},
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "value out of bounds",
+			msg: MsgCreateResource{
+				Creator:     sample.AccAddress(),
+				Payload:     NewUint64Payload(5),
+				ValueBounds: &ResourceValueBounds{Min: 1, Max: 4},
+			},
+			err: ErrValueOutOfBounds,
+		}, {
+			name: "bounds on a typed value",
+			msg: MsgCreateResource{
+				Creator:     sample.AccAddress(),
+				Payload:     NewStringPayload("5"),
+				ValueBounds: &ResourceValueBounds{Min: 1, Max: 4},
+			},
+			err: ErrInvalidPayload,
+		}, {
+			name: "invalid json payload",
+			msg: MsgCreateResource{
+				Creator: sample.AccAddress(),
+				Payload: NewJSONPayload("{"),
+			},
+			err: ErrInvalidPayload,
+		}, {
+			name: "duplicated tag",
+			msg: MsgCreateResource{
+				Creator: sample.AccAddress(),
+				Tags:    []string{"env:prod", "env:prod"},
+			},
+			err: ErrInvalidTag,
+		}, {
+			name: "invalid schema id",
+			msg: MsgCreateResource{
+				Creator: sample.AccAddress(),
+				Schema:  &ResourceSchemaRef{},
+			},
+			err: ErrInvalidSchema,
+		}, {
+			name: "invalid namespace",
+			msg: MsgCreateResource{
+				Creator:   sample.AccAddress(),
+				Namespace: "acme/payments",
+			},
+			err: ErrInvalidNamespace,
 		}, {
 			name: "valid address",
 			msg: MsgCreateResource{
 				Creator: sample.AccAddress(),
+				Tags:    []string{"team:payments", "env:prod"},
 			},
 		},
 	}
@@ -52,6 +104,20 @@ func TestMsgUpdateResource_ValidateBasic(t *testing.T) {
 				Creator: "invalid_address",
 			},
 			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "invalid bounds",
+			msg: MsgUpdateResource{
+				Creator:     sample.AccAddress(),
+				ValueBounds: &ResourceValueBounds{Min: 2, Max: 1},
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "empty tag",
+			msg: MsgUpdateResource{
+				Creator: sample.AccAddress(),
+				Tags:    []string{""},
+			},
+			err: ErrInvalidTag,
 		}, {
 			name: "valid address",
 			msg: MsgUpdateResource{
@@ -101,3 +167,167 @@ func TestMsgDeleteResource_ValidateBasic(t *testing.T) {
 		})
 	}
 }
+
+func TestMsgRevertResource_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgRevertResource
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgRevertResource{
+				Creator: "invalid_address",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "valid address",
+			msg: MsgRevertResource{
+				Creator: sample.AccAddress(),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgRenewResource_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgRenewResource
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgRenewResource{
+				Creator: "invalid_address",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "negative expiry height",
+			msg: MsgRenewResource{
+				Creator:      sample.AccAddress(),
+				ExpiryHeight: -1,
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "no expiry",
+			msg: MsgRenewResource{
+				Creator: sample.AccAddress(),
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "valid address",
+			msg: MsgRenewResource{
+				Creator:      sample.AccAddress(),
+				ExpiryHeight: 1,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgAdjustResourceValue_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgAdjustResourceValue
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgAdjustResourceValue{
+				Creator: "invalid_address",
+				Delta:   1,
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "zero delta",
+			msg: MsgAdjustResourceValue{
+				Creator: sample.AccAddress(),
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "valid address",
+			msg: MsgAdjustResourceValue{
+				Creator: sample.AccAddress(),
+				Delta:   -1,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgRegisterResourceSchema_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgRegisterResourceSchema
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgRegisterResourceSchema{
+				Creator: "invalid_address",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "invalid id",
+			msg: MsgRegisterResourceSchema{
+				Creator:  sample.AccAddress(),
+				Id:       "acme/product",
+				Document: `{}`,
+			},
+			err: ErrInvalidSchema,
+		}, {
+			name: "invalid document",
+			msg: MsgRegisterResourceSchema{
+				Creator:  sample.AccAddress(),
+				Id:       "product",
+				Document: `{"type":1}`,
+			},
+			err: ErrInvalidSchema,
+		}, {
+			name: "valid address",
+			msg: MsgRegisterResourceSchema{
+				Creator:  sample.AccAddress(),
+				Id:       "product",
+				Document: `{"type":"object"}`,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
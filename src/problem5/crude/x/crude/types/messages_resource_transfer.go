@@ -0,0 +1,63 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgOfferResourceTransfer{}
+
+func NewMsgOfferResourceTransfer(creator string, id uint64, recipient string) *MsgOfferResourceTransfer {
+	return &MsgOfferResourceTransfer{
+		Creator:   creator,
+		Id:        id,
+		Recipient: recipient,
+	}
+}
+
+func (msg *MsgOfferResourceTransfer) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	_, err = sdk.AccAddressFromBech32(msg.Recipient)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid recipient address (%s)", err)
+	}
+	return nil
+}
+
+var _ sdk.Msg = &MsgAcceptResourceTransfer{}
+
+func NewMsgAcceptResourceTransfer(creator string, id uint64) *MsgAcceptResourceTransfer {
+	return &MsgAcceptResourceTransfer{
+		Creator: creator,
+		Id:      id,
+	}
+}
+
+func (msg *MsgAcceptResourceTransfer) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	return nil
+}
+
+var _ sdk.Msg = &MsgCancelResourceTransfer{}
+
+func NewMsgCancelResourceTransfer(creator string, id uint64) *MsgCancelResourceTransfer {
+	return &MsgCancelResourceTransfer{
+		Creator: creator,
+		Id:      id,
+	}
+}
+
+func (msg *MsgCancelResourceTransfer) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	return nil
+}
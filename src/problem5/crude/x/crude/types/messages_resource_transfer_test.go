@@ -0,0 +1,112 @@
+package types
+
+import (
+	"testing"
+
+	"crude/testutil/sample"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgOfferResourceTransfer_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgOfferResourceTransfer
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgOfferResourceTransfer{
+				Creator:   "invalid_address",
+				Recipient: sample.AccAddress(),
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "invalid recipient",
+			msg: MsgOfferResourceTransfer{
+				Creator:   sample.AccAddress(),
+				Recipient: "invalid_address",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "valid address",
+			msg: MsgOfferResourceTransfer{
+				Creator:   sample.AccAddress(),
+				Recipient: sample.AccAddress(),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgAcceptResourceTransfer_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgAcceptResourceTransfer
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgAcceptResourceTransfer{
+				Creator: "invalid_address",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "valid address",
+			msg: MsgAcceptResourceTransfer{
+				Creator: sample.AccAddress(),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgCancelResourceTransfer_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgCancelResourceTransfer
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgCancelResourceTransfer{
+				Creator: "invalid_address",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "valid address",
+			msg: MsgCancelResourceTransfer{
+				Creator: sample.AccAddress(),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,63 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MaxNamespaceNameLength is the maximum length in bytes of a namespace name
+const MaxNamespaceNameLength = 64
+
+// ValidateNamespaceName checks a namespace name, restricted to the same
+// characters as schema ids since it is part of store keys and query paths.
+func ValidateNamespaceName(name string) error {
+	return validateIdentifier("namespace name", name, MaxNamespaceNameLength)
+}
+
+// ValidateNamespaceRoles checks a default access control list: every entry
+// names a distinct valid address and a grantable role.
+func ValidateNamespaceRoles(grants []NamespaceRoleGrant) error {
+	seen := make(map[string]struct{}, len(grants))
+	for _, grant := range grants {
+		if _, err := sdk.AccAddressFromBech32(grant.Address); err != nil {
+			return fmt.Errorf("invalid default role address %s: %w", grant.Address, err)
+		}
+		if !grant.Role.IsGrantable() {
+			return fmt.Errorf("role %s cannot be granted", grant.Role)
+		}
+		if _, ok := seen[grant.Address]; ok {
+			return fmt.Errorf("duplicated default role for %s", grant.Address)
+		}
+		seen[grant.Address] = struct{}{}
+	}
+
+	return nil
+}
+
+// Validate checks a namespace as found in the genesis state
+func (n Namespace) Validate() error {
+	if err := ValidateNamespaceName(n.Name); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(n.Admin); err != nil {
+		return fmt.Errorf("invalid admin address: %w", err)
+	}
+
+	return ValidateNamespaceRoles(n.DefaultRoles)
+}
+
+// RoleOf returns the role the namespace gives an address on each of its
+// resources: admin for the namespace admin, else its default role if any.
+func (n Namespace) RoleOf(address string) ResourceRole {
+	if address == n.Admin {
+		return ResourceRole_RESOURCE_ROLE_ADMIN
+	}
+	for _, grant := range n.DefaultRoles {
+		if grant.Address == address {
+			return grant.Role
+		}
+	}
+
+	return ResourceRole_RESOURCE_ROLE_UNSPECIFIED
+}
@@ -0,0 +1,38 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNamespace_RoleOf(t *testing.T) {
+	namespace := Namespace{
+		Name:  "acme",
+		Admin: "A",
+		DefaultRoles: []NamespaceRoleGrant{
+			{Address: "B", Role: ResourceRole_RESOURCE_ROLE_EDITOR},
+			{Address: "C", Role: ResourceRole_RESOURCE_ROLE_ADMIN},
+		},
+	}
+
+	require.Equal(t, ResourceRole_RESOURCE_ROLE_ADMIN, namespace.RoleOf("A"))
+	require.Equal(t, ResourceRole_RESOURCE_ROLE_EDITOR, namespace.RoleOf("B"))
+	require.Equal(t, ResourceRole_RESOURCE_ROLE_ADMIN, namespace.RoleOf("C"))
+	require.Equal(t, ResourceRole_RESOURCE_ROLE_UNSPECIFIED, namespace.RoleOf("D"))
+}
+
+func TestStrongerRole(t *testing.T) {
+	var (
+		none   = ResourceRole_RESOURCE_ROLE_UNSPECIFIED
+		editor = ResourceRole_RESOURCE_ROLE_EDITOR
+		admin  = ResourceRole_RESOURCE_ROLE_ADMIN
+		owner  = ResourceRole_RESOURCE_ROLE_OWNER
+	)
+
+	require.Equal(t, editor, StrongerRole(none, editor))
+	require.Equal(t, admin, StrongerRole(admin, editor))
+	require.Equal(t, admin, StrongerRole(editor, admin))
+	require.Equal(t, owner, StrongerRole(owner, admin))
+	require.Equal(t, none, StrongerRole(none, none))
+}
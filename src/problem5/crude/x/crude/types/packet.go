@@ -0,0 +1,77 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+)
+
+// DefaultReplicationTimeout bounds the relaying of replication packets whose
+// subscription sets no timeout period
+const DefaultReplicationTimeout = 10 * time.Minute
+
+// ValidateBasic is used for validating the packet
+func (p CrudePacketData) ValidateBasic() error {
+	switch packet := p.Packet.(type) {
+	case *CrudePacketData_ResourceCreatePacket:
+		return packet.ResourceCreatePacket.Resource.validateReplicated()
+	case *CrudePacketData_ResourceUpdatePacket:
+		return packet.ResourceUpdatePacket.Resource.validateReplicated()
+	case *CrudePacketData_ResourceDeletePacket:
+		return nil
+	default:
+		return fmt.Errorf("unrecognized %s packet type: %T", ModuleName, packet)
+	}
+}
+
+// GetBytes is a helper for serialising
+func (p CrudePacketData) GetBytes() ([]byte, error) {
+	bz, err := ModuleCdc.MarshalJSON(&p)
+	if err != nil {
+		return nil, err
+	}
+	return sdk.MustSortJSON(bz), nil
+}
+
+// validateReplicated checks the parts of a resource a replica is made of
+func (r Resource) validateReplicated() error {
+	if err := r.Payload.Validate(); err != nil {
+		return fmt.Errorf("invalid payload: %w", err)
+	}
+	if err := r.ValueBounds.Validate(); err != nil {
+		return fmt.Errorf("invalid value bounds: %w", err)
+	}
+	if err := r.ValueBounds.CheckPayload(r.Payload); err != nil {
+		return err
+	}
+	if err := r.ValidateAttributes(); err != nil {
+		return fmt.Errorf("invalid attributes: %w", err)
+	}
+	return ValidateTags(r.Tags)
+}
+
+// TimeoutOrDefault is the timeout period of the replication packets
+func (r ResourceReplication) TimeoutOrDefault() time.Duration {
+	if r.TimeoutPeriod == 0 {
+		return DefaultReplicationTimeout
+	}
+	return r.TimeoutPeriod
+}
+
+// Validate checks that a replication subscription is well formed
+func (r ResourceReplication) Validate() error {
+	if err := host.ChannelIdentifierValidator(r.ChannelId); err != nil {
+		return err
+	}
+	if r.TimeoutPeriod < 0 {
+		return fmt.Errorf("negative timeout period %s", r.TimeoutPeriod)
+	}
+	return nil
+}
+
+// Validate checks that a replica link is well formed
+func (r ResourceReplica) Validate() error {
+	return host.ChannelIdentifierValidator(r.ChannelId)
+}
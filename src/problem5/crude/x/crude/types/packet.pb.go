@@ -0,0 +1,1762 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: crude/crude/packet.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// CrudePacketData is the data of the packets sent on crude channels, exactly
+// one arm is set.
+type CrudePacketData struct {
+	// Types that are valid to be assigned to Packet:
+	//	*CrudePacketData_NoData
+	//	*CrudePacketData_ResourceCreatePacket
+	//	*CrudePacketData_ResourceUpdatePacket
+	//	*CrudePacketData_ResourceDeletePacket
+	Packet isCrudePacketData_Packet `protobuf_oneof:"packet"`
+}
+
+func (m *CrudePacketData) Reset()         { *m = CrudePacketData{} }
+func (m *CrudePacketData) String() string { return proto.CompactTextString(m) }
+func (*CrudePacketData) ProtoMessage()    {}
+func (*CrudePacketData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5a9db3d9b2857161, []int{0}
+}
+func (m *CrudePacketData) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CrudePacketData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CrudePacketData.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CrudePacketData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CrudePacketData.Merge(m, src)
+}
+func (m *CrudePacketData) XXX_Size() int {
+	return m.Size()
+}
+func (m *CrudePacketData) XXX_DiscardUnknown() {
+	xxx_messageInfo_CrudePacketData.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CrudePacketData proto.InternalMessageInfo
+
+type isCrudePacketData_Packet interface {
+	isCrudePacketData_Packet()
+	MarshalTo([]byte) (int, error)
+	Size() int
+}
+
+type CrudePacketData_NoData struct {
+	NoData *NoData `protobuf:"bytes,1,opt,name=noData,proto3,oneof" json:"noData,omitempty"`
+}
+type CrudePacketData_ResourceCreatePacket struct {
+	ResourceCreatePacket *ResourceCreatePacketData `protobuf:"bytes,2,opt,name=resourceCreatePacket,proto3,oneof" json:"resourceCreatePacket,omitempty"`
+}
+type CrudePacketData_ResourceUpdatePacket struct {
+	ResourceUpdatePacket *ResourceUpdatePacketData `protobuf:"bytes,3,opt,name=resourceUpdatePacket,proto3,oneof" json:"resourceUpdatePacket,omitempty"`
+}
+type CrudePacketData_ResourceDeletePacket struct {
+	ResourceDeletePacket *ResourceDeletePacketData `protobuf:"bytes,4,opt,name=resourceDeletePacket,proto3,oneof" json:"resourceDeletePacket,omitempty"`
+}
+
+func (*CrudePacketData_NoData) isCrudePacketData_Packet()               {}
+func (*CrudePacketData_ResourceCreatePacket) isCrudePacketData_Packet() {}
+func (*CrudePacketData_ResourceUpdatePacket) isCrudePacketData_Packet() {}
+func (*CrudePacketData_ResourceDeletePacket) isCrudePacketData_Packet() {}
+
+func (m *CrudePacketData) GetPacket() isCrudePacketData_Packet {
+	if m != nil {
+		return m.Packet
+	}
+	return nil
+}
+
+func (m *CrudePacketData) GetNoData() *NoData {
+	if x, ok := m.GetPacket().(*CrudePacketData_NoData); ok {
+		return x.NoData
+	}
+	return nil
+}
+
+func (m *CrudePacketData) GetResourceCreatePacket() *ResourceCreatePacketData {
+	if x, ok := m.GetPacket().(*CrudePacketData_ResourceCreatePacket); ok {
+		return x.ResourceCreatePacket
+	}
+	return nil
+}
+
+func (m *CrudePacketData) GetResourceUpdatePacket() *ResourceUpdatePacketData {
+	if x, ok := m.GetPacket().(*CrudePacketData_ResourceUpdatePacket); ok {
+		return x.ResourceUpdatePacket
+	}
+	return nil
+}
+
+func (m *CrudePacketData) GetResourceDeletePacket() *ResourceDeletePacketData {
+	if x, ok := m.GetPacket().(*CrudePacketData_ResourceDeletePacket); ok {
+		return x.ResourceDeletePacket
+	}
+	return nil
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*CrudePacketData) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*CrudePacketData_NoData)(nil),
+		(*CrudePacketData_ResourceCreatePacket)(nil),
+		(*CrudePacketData_ResourceUpdatePacket)(nil),
+		(*CrudePacketData_ResourceDeletePacket)(nil),
+	}
+}
+
+type NoData struct {
+}
+
+func (m *NoData) Reset()         { *m = NoData{} }
+func (m *NoData) String() string { return proto.CompactTextString(m) }
+func (*NoData) ProtoMessage()    {}
+func (*NoData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5a9db3d9b2857161, []int{1}
+}
+func (m *NoData) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NoData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NoData.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *NoData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NoData.Merge(m, src)
+}
+func (m *NoData) XXX_Size() int {
+	return m.Size()
+}
+func (m *NoData) XXX_DiscardUnknown() {
+	xxx_messageInfo_NoData.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NoData proto.InternalMessageInfo
+
+// ResourceCreatePacketData asks the counterparty to create a replica of a
+// resource that was just subscribed to the channel.
+type ResourceCreatePacketData struct {
+	Resource Resource `protobuf:"bytes,1,opt,name=resource,proto3" json:"resource"`
+}
+
+func (m *ResourceCreatePacketData) Reset()         { *m = ResourceCreatePacketData{} }
+func (m *ResourceCreatePacketData) String() string { return proto.CompactTextString(m) }
+func (*ResourceCreatePacketData) ProtoMessage()    {}
+func (*ResourceCreatePacketData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5a9db3d9b2857161, []int{2}
+}
+func (m *ResourceCreatePacketData) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ResourceCreatePacketData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ResourceCreatePacketData.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ResourceCreatePacketData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResourceCreatePacketData.Merge(m, src)
+}
+func (m *ResourceCreatePacketData) XXX_Size() int {
+	return m.Size()
+}
+func (m *ResourceCreatePacketData) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResourceCreatePacketData.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResourceCreatePacketData proto.InternalMessageInfo
+
+func (m *ResourceCreatePacketData) GetResource() Resource {
+	if m != nil {
+		return m.Resource
+	}
+	return Resource{}
+}
+
+// ResourceCreatePacketAck is the result acknowledgement of a created replica.
+type ResourceCreatePacketAck struct {
+	ReplicaId uint64 `protobuf:"varint,1,opt,name=replicaId,proto3" json:"replicaId,omitempty"`
+}
+
+func (m *ResourceCreatePacketAck) Reset()         { *m = ResourceCreatePacketAck{} }
+func (m *ResourceCreatePacketAck) String() string { return proto.CompactTextString(m) }
+func (*ResourceCreatePacketAck) ProtoMessage()    {}
+func (*ResourceCreatePacketAck) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5a9db3d9b2857161, []int{3}
+}
+func (m *ResourceCreatePacketAck) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ResourceCreatePacketAck) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ResourceCreatePacketAck.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ResourceCreatePacketAck) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResourceCreatePacketAck.Merge(m, src)
+}
+func (m *ResourceCreatePacketAck) XXX_Size() int {
+	return m.Size()
+}
+func (m *ResourceCreatePacketAck) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResourceCreatePacketAck.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResourceCreatePacketAck proto.InternalMessageInfo
+
+func (m *ResourceCreatePacketAck) GetReplicaId() uint64 {
+	if m != nil {
+		return m.ReplicaId
+	}
+	return 0
+}
+
+// ResourceUpdatePacketData carries the new state of a replicated resource.
+type ResourceUpdatePacketData struct {
+	Resource Resource `protobuf:"bytes,1,opt,name=resource,proto3" json:"resource"`
+}
+
+func (m *ResourceUpdatePacketData) Reset()         { *m = ResourceUpdatePacketData{} }
+func (m *ResourceUpdatePacketData) String() string { return proto.CompactTextString(m) }
+func (*ResourceUpdatePacketData) ProtoMessage()    {}
+func (*ResourceUpdatePacketData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5a9db3d9b2857161, []int{4}
+}
+func (m *ResourceUpdatePacketData) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ResourceUpdatePacketData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ResourceUpdatePacketData.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ResourceUpdatePacketData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResourceUpdatePacketData.Merge(m, src)
+}
+func (m *ResourceUpdatePacketData) XXX_Size() int {
+	return m.Size()
+}
+func (m *ResourceUpdatePacketData) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResourceUpdatePacketData.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResourceUpdatePacketData proto.InternalMessageInfo
+
+func (m *ResourceUpdatePacketData) GetResource() Resource {
+	if m != nil {
+		return m.Resource
+	}
+	return Resource{}
+}
+
+// ResourceUpdatePacketAck is the result acknowledgement of an updated replica.
+type ResourceUpdatePacketAck struct {
+	ReplicaId uint64 `protobuf:"varint,1,opt,name=replicaId,proto3" json:"replicaId,omitempty"`
+	// version is the version of the replica after the update, which counts the
+	// updates it received rather than those of the source resource.
+	Version uint64 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+}
+
+func (m *ResourceUpdatePacketAck) Reset()         { *m = ResourceUpdatePacketAck{} }
+func (m *ResourceUpdatePacketAck) String() string { return proto.CompactTextString(m) }
+func (*ResourceUpdatePacketAck) ProtoMessage()    {}
+func (*ResourceUpdatePacketAck) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5a9db3d9b2857161, []int{5}
+}
+func (m *ResourceUpdatePacketAck) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ResourceUpdatePacketAck) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ResourceUpdatePacketAck.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ResourceUpdatePacketAck) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResourceUpdatePacketAck.Merge(m, src)
+}
+func (m *ResourceUpdatePacketAck) XXX_Size() int {
+	return m.Size()
+}
+func (m *ResourceUpdatePacketAck) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResourceUpdatePacketAck.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResourceUpdatePacketAck proto.InternalMessageInfo
+
+func (m *ResourceUpdatePacketAck) GetReplicaId() uint64 {
+	if m != nil {
+		return m.ReplicaId
+	}
+	return 0
+}
+
+func (m *ResourceUpdatePacketAck) GetVersion() uint64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+// ResourceDeletePacketData asks the counterparty to delete the replica of a
+// deleted resource.
+type ResourceDeletePacketData struct {
+	ResourceId uint64 `protobuf:"varint,1,opt,name=resourceId,proto3" json:"resourceId,omitempty"`
+}
+
+func (m *ResourceDeletePacketData) Reset()         { *m = ResourceDeletePacketData{} }
+func (m *ResourceDeletePacketData) String() string { return proto.CompactTextString(m) }
+func (*ResourceDeletePacketData) ProtoMessage()    {}
+func (*ResourceDeletePacketData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5a9db3d9b2857161, []int{6}
+}
+func (m *ResourceDeletePacketData) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ResourceDeletePacketData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ResourceDeletePacketData.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ResourceDeletePacketData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResourceDeletePacketData.Merge(m, src)
+}
+func (m *ResourceDeletePacketData) XXX_Size() int {
+	return m.Size()
+}
+func (m *ResourceDeletePacketData) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResourceDeletePacketData.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResourceDeletePacketData proto.InternalMessageInfo
+
+func (m *ResourceDeletePacketData) GetResourceId() uint64 {
+	if m != nil {
+		return m.ResourceId
+	}
+	return 0
+}
+
+// ResourceDeletePacketAck is the result acknowledgement of a deleted replica.
+type ResourceDeletePacketAck struct {
+	ReplicaId uint64 `protobuf:"varint,1,opt,name=replicaId,proto3" json:"replicaId,omitempty"`
+}
+
+func (m *ResourceDeletePacketAck) Reset()         { *m = ResourceDeletePacketAck{} }
+func (m *ResourceDeletePacketAck) String() string { return proto.CompactTextString(m) }
+func (*ResourceDeletePacketAck) ProtoMessage()    {}
+func (*ResourceDeletePacketAck) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5a9db3d9b2857161, []int{7}
+}
+func (m *ResourceDeletePacketAck) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ResourceDeletePacketAck) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ResourceDeletePacketAck.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ResourceDeletePacketAck) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResourceDeletePacketAck.Merge(m, src)
+}
+func (m *ResourceDeletePacketAck) XXX_Size() int {
+	return m.Size()
+}
+func (m *ResourceDeletePacketAck) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResourceDeletePacketAck.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResourceDeletePacketAck proto.InternalMessageInfo
+
+func (m *ResourceDeletePacketAck) GetReplicaId() uint64 {
+	if m != nil {
+		return m.ReplicaId
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*CrudePacketData)(nil), "crude.crude.CrudePacketData")
+	proto.RegisterType((*NoData)(nil), "crude.crude.NoData")
+	proto.RegisterType((*ResourceCreatePacketData)(nil), "crude.crude.ResourceCreatePacketData")
+	proto.RegisterType((*ResourceCreatePacketAck)(nil), "crude.crude.ResourceCreatePacketAck")
+	proto.RegisterType((*ResourceUpdatePacketData)(nil), "crude.crude.ResourceUpdatePacketData")
+	proto.RegisterType((*ResourceUpdatePacketAck)(nil), "crude.crude.ResourceUpdatePacketAck")
+	proto.RegisterType((*ResourceDeletePacketData)(nil), "crude.crude.ResourceDeletePacketData")
+	proto.RegisterType((*ResourceDeletePacketAck)(nil), "crude.crude.ResourceDeletePacketAck")
+}
+
+func init() { proto.RegisterFile("crude/crude/packet.proto", fileDescriptor_5a9db3d9b2857161) }
+
+var fileDescriptor_5a9db3d9b2857161 = []byte{
+	// 354 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x93, 0xcf, 0x4a, 0xc3, 0x40,
+	0x10, 0xc6, 0x93, 0x1a, 0x62, 0x9d, 0x1e, 0x84, 0x6d, 0xa5, 0xa1, 0xc8, 0x2a, 0x01, 0xc1, 0x4b,
+	0x53, 0xd0, 0x43, 0xc1, 0x9b, 0x6d, 0x0f, 0x7a, 0x11, 0x8d, 0x78, 0xd1, 0x53, 0x4c, 0x97, 0x52,
+	0x5a, 0xba, 0x61, 0x9b, 0x8a, 0xbe, 0x85, 0x8f, 0xd5, 0x63, 0x8f, 0x9e, 0x44, 0x9b, 0x17, 0x91,
+	0xec, 0xe6, 0xcf, 0x18, 0x62, 0x0b, 0x5e, 0x96, 0xed, 0xcc, 0x37, 0xbf, 0xfd, 0xf8, 0x9a, 0x01,
+	0xcb, 0x17, 0x8b, 0x21, 0xeb, 0xa8, 0x33, 0xf0, 0xfc, 0x09, 0x0b, 0x9d, 0x40, 0xf0, 0x90, 0x93,
+	0x9a, 0xac, 0x39, 0xf2, 0x6c, 0x35, 0x46, 0x7c, 0xc4, 0x65, 0xbd, 0x13, 0xdf, 0x94, 0xa4, 0xd5,
+	0xc2, 0xc3, 0x82, 0xcd, 0xf9, 0x42, 0xf8, 0x4c, 0xf5, 0xec, 0xef, 0x0a, 0xec, 0xf7, 0xe3, 0xc6,
+	0xad, 0x84, 0x0e, 0xbc, 0xd0, 0x23, 0x6d, 0x30, 0x67, 0x3c, 0xbe, 0x59, 0xfa, 0xb1, 0x7e, 0x5a,
+	0x3b, 0xab, 0x3b, 0xe8, 0x0d, 0xe7, 0x46, 0xb6, 0xae, 0x34, 0x37, 0x11, 0x91, 0x27, 0x68, 0xa4,
+	0xd0, 0xbe, 0x60, 0x5e, 0x98, 0xa0, 0xac, 0x8a, 0x1c, 0x3e, 0xf9, 0x35, 0xec, 0x96, 0x08, 0x13,
+	0x5c, 0x29, 0x04, 0xc3, 0x1f, 0x82, 0x61, 0x0e, 0xdf, 0xd9, 0x00, 0xc7, 0xc2, 0x22, 0x1c, 0xf7,
+	0x30, 0x7c, 0xc0, 0xa6, 0x2c, 0x83, 0x1b, 0x1b, 0xe0, 0x58, 0x58, 0x84, 0xe3, 0x5e, 0xaf, 0x0a,
+	0xa6, 0xfa, 0xa3, 0xec, 0x2a, 0x98, 0x2a, 0x34, 0xfb, 0x1e, 0xac, 0xbf, 0x12, 0x20, 0x5d, 0xa8,
+	0xa6, 0x9c, 0x24, 0xf7, 0x83, 0x52, 0x03, 0x3d, 0x63, 0xf9, 0x79, 0xa4, 0xb9, 0x99, 0xd8, 0xee,
+	0x42, 0xb3, 0x0c, 0x7a, 0xe9, 0x4f, 0xc8, 0x21, 0xec, 0x09, 0x16, 0x4c, 0xc7, 0xbe, 0x77, 0x3d,
+	0x94, 0x50, 0xc3, 0xcd, 0x0b, 0xd8, 0x4d, 0x31, 0xb2, 0xff, 0xbb, 0xb9, 0x83, 0x66, 0x19, 0x74,
+	0xab, 0x1b, 0x62, 0xc1, 0xee, 0x0b, 0x13, 0xf3, 0x31, 0x9f, 0xc9, 0x2f, 0xc7, 0x70, 0xd3, 0x9f,
+	0xf6, 0x45, 0xee, 0xb3, 0x98, 0x3e, 0xa1, 0x00, 0xe9, 0xd3, 0x19, 0x14, 0x55, 0x70, 0x38, 0x78,
+	0x76, 0xab, 0x9d, 0x5e, 0x7b, 0xb9, 0xa6, 0xfa, 0x6a, 0x4d, 0xf5, 0xaf, 0x35, 0xd5, 0xdf, 0x23,
+	0xaa, 0xad, 0x22, 0xaa, 0x7d, 0x44, 0x54, 0x7b, 0xac, 0xab, 0x45, 0x7a, 0x4d, 0x16, 0x2a, 0x7c,
+	0x0b, 0xd8, 0xfc, 0xd9, 0x94, 0xeb, 0x74, 0xfe, 0x33, 0x00, 0x5c, 0xb1, 0x03, 0xa0, 0xa9, 0x03,
+	0x00, 0x00,
+}
+
+func (m *CrudePacketData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CrudePacketData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CrudePacketData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Packet != nil {
+		{
+			size := m.Packet.Size()
+			i -= size
+			if _, err := m.Packet.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CrudePacketData_NoData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CrudePacketData_NoData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.NoData != nil {
+		{
+			size, err := m.NoData.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPacket(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+func (m *CrudePacketData_ResourceCreatePacket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CrudePacketData_ResourceCreatePacket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.ResourceCreatePacket != nil {
+		{
+			size, err := m.ResourceCreatePacket.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPacket(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	return len(dAtA) - i, nil
+}
+func (m *CrudePacketData_ResourceUpdatePacket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CrudePacketData_ResourceUpdatePacket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.ResourceUpdatePacket != nil {
+		{
+			size, err := m.ResourceUpdatePacket.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPacket(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *CrudePacketData_ResourceDeletePacket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CrudePacketData_ResourceDeletePacket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.ResourceDeletePacket != nil {
+		{
+			size, err := m.ResourceDeletePacket.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPacket(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	return len(dAtA) - i, nil
+}
+func (m *NoData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NoData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NoData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *ResourceCreatePacketData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ResourceCreatePacketData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ResourceCreatePacketData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Resource.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintPacket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ResourceCreatePacketAck) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ResourceCreatePacketAck) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ResourceCreatePacketAck) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ReplicaId != 0 {
+		i = encodeVarintPacket(dAtA, i, uint64(m.ReplicaId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ResourceUpdatePacketData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ResourceUpdatePacketData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ResourceUpdatePacketData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Resource.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintPacket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ResourceUpdatePacketAck) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ResourceUpdatePacketAck) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ResourceUpdatePacketAck) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Version != 0 {
+		i = encodeVarintPacket(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ReplicaId != 0 {
+		i = encodeVarintPacket(dAtA, i, uint64(m.ReplicaId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ResourceDeletePacketData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ResourceDeletePacketData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ResourceDeletePacketData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ResourceId != 0 {
+		i = encodeVarintPacket(dAtA, i, uint64(m.ResourceId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ResourceDeletePacketAck) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ResourceDeletePacketAck) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ResourceDeletePacketAck) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ReplicaId != 0 {
+		i = encodeVarintPacket(dAtA, i, uint64(m.ReplicaId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintPacket(dAtA []byte, offset int, v uint64) int {
+	offset -= sovPacket(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *CrudePacketData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Packet != nil {
+		n += m.Packet.Size()
+	}
+	return n
+}
+
+func (m *CrudePacketData_NoData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.NoData != nil {
+		l = m.NoData.Size()
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	return n
+}
+func (m *CrudePacketData_ResourceCreatePacket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ResourceCreatePacket != nil {
+		l = m.ResourceCreatePacket.Size()
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	return n
+}
+func (m *CrudePacketData_ResourceUpdatePacket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ResourceUpdatePacket != nil {
+		l = m.ResourceUpdatePacket.Size()
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	return n
+}
+func (m *CrudePacketData_ResourceDeletePacket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ResourceDeletePacket != nil {
+		l = m.ResourceDeletePacket.Size()
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	return n
+}
+func (m *NoData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *ResourceCreatePacketData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Resource.Size()
+	n += 1 + l + sovPacket(uint64(l))
+	return n
+}
+
+func (m *ResourceCreatePacketAck) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ReplicaId != 0 {
+		n += 1 + sovPacket(uint64(m.ReplicaId))
+	}
+	return n
+}
+
+func (m *ResourceUpdatePacketData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Resource.Size()
+	n += 1 + l + sovPacket(uint64(l))
+	return n
+}
+
+func (m *ResourceUpdatePacketAck) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ReplicaId != 0 {
+		n += 1 + sovPacket(uint64(m.ReplicaId))
+	}
+	if m.Version != 0 {
+		n += 1 + sovPacket(uint64(m.Version))
+	}
+	return n
+}
+
+func (m *ResourceDeletePacketData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ResourceId != 0 {
+		n += 1 + sovPacket(uint64(m.ResourceId))
+	}
+	return n
+}
+
+func (m *ResourceDeletePacketAck) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ReplicaId != 0 {
+		n += 1 + sovPacket(uint64(m.ReplicaId))
+	}
+	return n
+}
+
+func sovPacket(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozPacket(x uint64) (n int) {
+	return sovPacket(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *CrudePacketData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CrudePacketData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CrudePacketData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NoData", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &NoData{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Packet = &CrudePacketData_NoData{v}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceCreatePacket", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &ResourceCreatePacketData{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Packet = &CrudePacketData_ResourceCreatePacket{v}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceUpdatePacket", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &ResourceUpdatePacketData{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Packet = &CrudePacketData_ResourceUpdatePacket{v}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceDeletePacket", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &ResourceDeletePacketData{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Packet = &CrudePacketData_ResourceDeletePacket{v}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NoData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NoData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NoData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResourceCreatePacketData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResourceCreatePacketData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResourceCreatePacketData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Resource", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Resource.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResourceCreatePacketAck) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResourceCreatePacketAck: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResourceCreatePacketAck: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReplicaId", wireType)
+			}
+			m.ReplicaId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReplicaId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResourceUpdatePacketData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResourceUpdatePacketData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResourceUpdatePacketData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Resource", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Resource.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResourceUpdatePacketAck) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResourceUpdatePacketAck: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResourceUpdatePacketAck: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReplicaId", wireType)
+			}
+			m.ReplicaId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReplicaId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResourceDeletePacketData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResourceDeletePacketData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResourceDeletePacketData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceId", wireType)
+			}
+			m.ResourceId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ResourceId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResourceDeletePacketAck) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResourceDeletePacketAck: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResourceDeletePacketAck: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReplicaId", wireType)
+			}
+			m.ReplicaId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReplicaId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipPacket(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthPacket
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupPacket
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthPacket
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthPacket        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowPacket          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupPacket = fmt.Errorf("proto: unexpected end of group")
+)
@@ -1,32 +1,409 @@
 package types
 
 import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
 
 var _ paramtypes.ParamSet = (*Params)(nil)
 
+var (
+	KeyMaxNameLength = []byte("MaxNameLength")
+	// DefaultMaxNameLength is the default maximum length of a resource name
+	DefaultMaxNameLength uint64 = 64
+)
+
+var (
+	KeyNameCharset = []byte("NameCharset")
+	// DefaultNameCharset allows ASCII letters, digits and a few separators
+	DefaultNameCharset = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789 -_."
+)
+
+var (
+	KeyMaxResourcesPerCreator = []byte("MaxResourcesPerCreator")
+	// DefaultMaxResourcesPerCreator leaves the per creator quota disabled
+	DefaultMaxResourcesPerCreator uint64 = 0
+)
+
+var (
+	KeyCreationFee = []byte("CreationFee")
+	// DefaultCreationFee makes resource creation free
+	DefaultCreationFee sdk.Coins
+)
+
+var (
+	KeyDepositPerByte = []byte("DepositPerByte")
+	// DefaultDepositPerByte leaves storage deposits disabled
+	DefaultDepositPerByte sdk.Coins
+)
+
+var (
+	KeyMaxPrunedPerBlock = []byte("MaxPrunedPerBlock")
+	// DefaultMaxPrunedPerBlock is the default number of expired resources pruned per block
+	DefaultMaxPrunedPerBlock uint64 = 100
+)
+
+var (
+	KeyMaxAttributeKeyLength = []byte("MaxAttributeKeyLength")
+	// DefaultMaxAttributeKeyLength is the default maximum length of an attribute key
+	DefaultMaxAttributeKeyLength uint64 = 64
+)
+
+var (
+	KeyMaxAttributeValueLength = []byte("MaxAttributeValueLength")
+	// DefaultMaxAttributeValueLength is the default maximum length of an attribute value
+	DefaultMaxAttributeValueLength uint64 = 256
+)
+
+var (
+	KeyMaxAttributesPerResource = []byte("MaxAttributesPerResource")
+	// DefaultMaxAttributesPerResource is the default maximum number of attributes of a resource
+	DefaultMaxAttributesPerResource uint64 = 16
+)
+
+var (
+	KeyMaxSchemaLength = []byte("MaxSchemaLength")
+	// DefaultMaxSchemaLength is the default maximum length of a resource schema document
+	DefaultMaxSchemaLength uint64 = 16 * 1024
+)
+
+var (
+	KeyOnlyApprovedSchemas = []byte("OnlyApprovedSchemas")
+	// DefaultOnlyApprovedSchemas lets resources reference any registered schema
+	DefaultOnlyApprovedSchemas = false
+)
+
+var (
+	KeyUniqueNames = []byte("UniqueNames")
+	// DefaultUniqueNames lets resources share their name
+	DefaultUniqueNames = false
+)
+
 // ParamKeyTable the param key table for launch module
 func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
 }
 
 // NewParams creates a new Params instance
-func NewParams() Params {
-	return Params{}
+func NewParams(
+	maxNameLength uint64,
+	nameCharset string,
+	maxResourcesPerCreator uint64,
+	creationFee sdk.Coins,
+	depositPerByte sdk.Coins,
+	maxPrunedPerBlock uint64,
+	maxAttributeKeyLength uint64,
+	maxAttributeValueLength uint64,
+	maxAttributesPerResource uint64,
+	maxSchemaLength uint64,
+	onlyApprovedSchemas bool,
+	uniqueNames bool,
+) Params {
+	return Params{
+		MaxNameLength:          maxNameLength,
+		NameCharset:            nameCharset,
+		MaxResourcesPerCreator: maxResourcesPerCreator,
+		CreationFee:            creationFee,
+		DepositPerByte:         depositPerByte,
+		MaxPrunedPerBlock:      maxPrunedPerBlock,
+
+		MaxAttributeKeyLength:    maxAttributeKeyLength,
+		MaxAttributeValueLength:  maxAttributeValueLength,
+		MaxAttributesPerResource: maxAttributesPerResource,
+
+		MaxSchemaLength:     maxSchemaLength,
+		OnlyApprovedSchemas: onlyApprovedSchemas,
+
+		UniqueNames: uniqueNames,
+	}
 }
 
 // DefaultParams returns a default set of parameters
 func DefaultParams() Params {
-	return NewParams()
+	return NewParams(
+		DefaultMaxNameLength,
+		DefaultNameCharset,
+		DefaultMaxResourcesPerCreator,
+		DefaultCreationFee,
+		DefaultDepositPerByte,
+		DefaultMaxPrunedPerBlock,
+		DefaultMaxAttributeKeyLength,
+		DefaultMaxAttributeValueLength,
+		DefaultMaxAttributesPerResource,
+		DefaultMaxSchemaLength,
+		DefaultOnlyApprovedSchemas,
+		DefaultUniqueNames,
+	)
 }
 
 // ParamSetPairs get the params.ParamSet
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
-	return paramtypes.ParamSetPairs{}
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyMaxNameLength, &p.MaxNameLength, validateMaxNameLength),
+		paramtypes.NewParamSetPair(KeyNameCharset, &p.NameCharset, validateNameCharset),
+		paramtypes.NewParamSetPair(KeyMaxResourcesPerCreator, &p.MaxResourcesPerCreator, validateMaxResourcesPerCreator),
+		paramtypes.NewParamSetPair(KeyCreationFee, &p.CreationFee, validateCreationFee),
+		paramtypes.NewParamSetPair(KeyDepositPerByte, &p.DepositPerByte, validateDepositPerByte),
+		paramtypes.NewParamSetPair(KeyMaxPrunedPerBlock, &p.MaxPrunedPerBlock, validateMaxPrunedPerBlock),
+		paramtypes.NewParamSetPair(KeyMaxAttributeKeyLength, &p.MaxAttributeKeyLength, validateMaxAttributeKeyLength),
+		paramtypes.NewParamSetPair(KeyMaxAttributeValueLength, &p.MaxAttributeValueLength, validateMaxAttributeValueLength),
+		paramtypes.NewParamSetPair(KeyMaxAttributesPerResource, &p.MaxAttributesPerResource, validateMaxAttributesPerResource),
+		paramtypes.NewParamSetPair(KeyMaxSchemaLength, &p.MaxSchemaLength, validateMaxSchemaLength),
+		paramtypes.NewParamSetPair(KeyOnlyApprovedSchemas, &p.OnlyApprovedSchemas, validateOnlyApprovedSchemas),
+		paramtypes.NewParamSetPair(KeyUniqueNames, &p.UniqueNames, validateUniqueNames),
+	}
 }
 
 // Validate validates the set of params
 func (p Params) Validate() error {
+	if err := validateMaxNameLength(p.MaxNameLength); err != nil {
+		return err
+	}
+	if err := validateNameCharset(p.NameCharset); err != nil {
+		return err
+	}
+	if err := validateMaxResourcesPerCreator(p.MaxResourcesPerCreator); err != nil {
+		return err
+	}
+	if err := validateCreationFee(p.CreationFee); err != nil {
+		return err
+	}
+	if err := validateDepositPerByte(p.DepositPerByte); err != nil {
+		return err
+	}
+	if err := validateMaxPrunedPerBlock(p.MaxPrunedPerBlock); err != nil {
+		return err
+	}
+	if err := validateMaxAttributeKeyLength(p.MaxAttributeKeyLength); err != nil {
+		return err
+	}
+	if err := validateMaxAttributeValueLength(p.MaxAttributeValueLength); err != nil {
+		return err
+	}
+	if err := validateMaxAttributesPerResource(p.MaxAttributesPerResource); err != nil {
+		return err
+	}
+	if err := validateMaxSchemaLength(p.MaxSchemaLength); err != nil {
+		return err
+	}
+	if err := validateOnlyApprovedSchemas(p.OnlyApprovedSchemas); err != nil {
+		return err
+	}
+	if err := validateUniqueNames(p.UniqueNames); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ValidateName checks a resource name against the name length and charset params
+func (p Params) ValidateName(name string) error {
+	if err := p.ValidateNameLength(name); err != nil {
+		return err
+	}
+	if p.NameCharset == "" {
+		return nil
+	}
+	for _, r := range name {
+		if !strings.ContainsRune(p.NameCharset, r) {
+			return fmt.Errorf("name contains disallowed character %q", r)
+		}
+	}
+
+	return nil
+}
+
+// ValidateNameLength checks a resource name against the name length param
+// only. Whatever the params, a name cannot hold a NUL byte, which delimits names
+// in the name index.
+func (p Params) ValidateNameLength(name string) error {
+	if p.MaxNameLength > 0 && uint64(len(name)) > p.MaxNameLength {
+		return fmt.Errorf("name is %d bytes long, max is %d", len(name), p.MaxNameLength)
+	}
+	if strings.IndexByte(name, 0) >= 0 {
+		return errors.New("name contains a NUL byte")
+	}
+
+	return nil
+}
+
+// ValidateNamePrefix checks a prefix the name index is searched with. Like a
+// name, it cannot hold a NUL byte, which would match past the end of names.
+func ValidateNamePrefix(prefix string) error {
+	if strings.IndexByte(prefix, 0) >= 0 {
+		return errors.New("name prefix contains a NUL byte")
+	}
+
+	return nil
+}
+
+// ValidateAttribute checks an attribute against the attribute params
+func (p Params) ValidateAttribute(attribute ResourceAttribute) error {
+	if p.MaxAttributeKeyLength > 0 && uint64(len(attribute.Key)) > p.MaxAttributeKeyLength {
+		return fmt.Errorf("attribute key is %d bytes long, max is %d", len(attribute.Key), p.MaxAttributeKeyLength)
+	}
+	if p.MaxAttributeValueLength > 0 && uint64(len(attribute.Value)) > p.MaxAttributeValueLength {
+		return fmt.Errorf("attribute value is %d bytes long, max is %d", len(attribute.Value), p.MaxAttributeValueLength)
+	}
+
+	return nil
+}
+
+// ValidateSchemaDocument checks the length of a schema document against the
+// MaxSchemaLength param
+func (p Params) ValidateSchemaDocument(document string) error {
+	if p.MaxSchemaLength > 0 && uint64(len(document)) > p.MaxSchemaLength {
+		return fmt.Errorf("schema document is %d bytes long, max is %d", len(document), p.MaxSchemaLength)
+	}
+
+	return nil
+}
+
+// DepositFor returns the deposit owed for a resource. The id, the version and
+// the deposit itself are left out of the size so that the amount only follows
+// the content.
+func (p Params) DepositFor(resource Resource) sdk.Coins {
+	resource.Id = 0
+	resource.Version = 0
+	resource.Deposit = nil
+
+	return p.DepositPerByte.MulInt(math.NewInt(int64(resource.Size())))
+}
+
+// validateMaxNameLength validates the MaxNameLength param
+func validateMaxNameLength(v interface{}) error {
+	_, ok := v.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	return nil
+}
+
+// validateNameCharset validates the NameCharset param
+func validateNameCharset(v interface{}) error {
+	nameCharset, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	if !utf8.ValidString(nameCharset) {
+		return fmt.Errorf("name charset is not valid UTF-8")
+	}
+
+	return nil
+}
+
+// validateMaxResourcesPerCreator validates the MaxResourcesPerCreator param
+func validateMaxResourcesPerCreator(v interface{}) error {
+	_, ok := v.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	return nil
+}
+
+// validateCreationFee validates the CreationFee param
+func validateCreationFee(v interface{}) error {
+	creationFee, ok := v.(sdk.Coins)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	if err := creationFee.Validate(); err != nil {
+		return fmt.Errorf("invalid creation fee: %w", err)
+	}
+
+	return nil
+}
+
+// validateDepositPerByte validates the DepositPerByte param
+func validateDepositPerByte(v interface{}) error {
+	depositPerByte, ok := v.(sdk.Coins)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	if err := depositPerByte.Validate(); err != nil {
+		return fmt.Errorf("invalid deposit per byte: %w", err)
+	}
+
+	return nil
+}
+
+// validateMaxPrunedPerBlock validates the MaxPrunedPerBlock param
+func validateMaxPrunedPerBlock(v interface{}) error {
+	_, ok := v.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	return nil
+}
+
+// validateMaxAttributeKeyLength validates the MaxAttributeKeyLength param
+func validateMaxAttributeKeyLength(v interface{}) error {
+	_, ok := v.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	return nil
+}
+
+// validateMaxAttributeValueLength validates the MaxAttributeValueLength param
+func validateMaxAttributeValueLength(v interface{}) error {
+	_, ok := v.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	return nil
+}
+
+// validateMaxAttributesPerResource validates the MaxAttributesPerResource param
+func validateMaxAttributesPerResource(v interface{}) error {
+	_, ok := v.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	return nil
+}
+
+// validateMaxSchemaLength validates the MaxSchemaLength param
+func validateMaxSchemaLength(v interface{}) error {
+	_, ok := v.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	return nil
+}
+
+// validateOnlyApprovedSchemas validates the OnlyApprovedSchemas param
+func validateOnlyApprovedSchemas(v interface{}) error {
+	_, ok := v.(bool)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	return nil
+}
+
+// validateUniqueNames validates the UniqueNames param
+func validateUniqueNames(v interface{}) error {
+	_, ok := v.(bool)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
 	return nil
 }
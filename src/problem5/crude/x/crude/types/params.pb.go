@@ -5,6 +5,8 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -26,6 +28,42 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // Params defines the parameters for the module.
 type Params struct {
+	// maxNameLength is the maximum length in bytes of a resource name, zero
+	// disables the check.
+	MaxNameLength uint64 `protobuf:"varint,1,opt,name=maxNameLength,proto3" json:"maxNameLength,omitempty"`
+	// nameCharset lists the characters allowed in a resource name, an empty
+	// charset allows any character.
+	NameCharset string `protobuf:"bytes,2,opt,name=nameCharset,proto3" json:"nameCharset,omitempty"`
+	// maxResourcesPerCreator caps the number of live resources created by a
+	// single account, zero disables the quota.
+	MaxResourcesPerCreator uint64 `protobuf:"varint,3,opt,name=maxResourcesPerCreator,proto3" json:"maxResourcesPerCreator,omitempty"`
+	// creationFee is charged to the creator and sent to the fee collector each
+	// time a resource is created.
+	CreationFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=creationFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"creationFee"`
+	// depositPerByte is escrowed in the module account for each byte of an
+	// encoded resource and refunded once the resource is deleted.
+	DepositPerByte github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=depositPerByte,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"depositPerByte"`
+	// maxPrunedPerBlock caps the number of expired resources removed at the end
+	// of each block, zero disables pruning.
+	MaxPrunedPerBlock uint64 `protobuf:"varint,6,opt,name=maxPrunedPerBlock,proto3" json:"maxPrunedPerBlock,omitempty"`
+	// maxAttributeKeyLength is the maximum length in bytes of an attribute key,
+	// zero disables the check.
+	MaxAttributeKeyLength uint64 `protobuf:"varint,7,opt,name=maxAttributeKeyLength,proto3" json:"maxAttributeKeyLength,omitempty"`
+	// maxAttributeValueLength is the maximum length in bytes of an attribute
+	// value, zero disables the check.
+	MaxAttributeValueLength uint64 `protobuf:"varint,8,opt,name=maxAttributeValueLength,proto3" json:"maxAttributeValueLength,omitempty"`
+	// maxAttributesPerResource caps the number of attributes of a resource,
+	// zero disables the cap.
+	MaxAttributesPerResource uint64 `protobuf:"varint,9,opt,name=maxAttributesPerResource,proto3" json:"maxAttributesPerResource,omitempty"`
+	// maxSchemaLength is the maximum length in bytes of a resource schema
+	// document, zero disables the check.
+	MaxSchemaLength uint64 `protobuf:"varint,10,opt,name=maxSchemaLength,proto3" json:"maxSchemaLength,omitempty"`
+	// onlyApprovedSchemas restricts resources to the schemas approved by the
+	// authority.
+	OnlyApprovedSchemas bool `protobuf:"varint,11,opt,name=onlyApprovedSchemas,proto3" json:"onlyApprovedSchemas,omitempty"`
+	// uniqueNames rejects creating a resource, or renaming one, with a name
+	// another resource already has.
+	UniqueNames bool `protobuf:"varint,12,opt,name=uniqueNames,proto3" json:"uniqueNames,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -61,6 +99,90 @@ func (m *Params) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Params proto.InternalMessageInfo
 
+func (m *Params) GetMaxNameLength() uint64 {
+	if m != nil {
+		return m.MaxNameLength
+	}
+	return 0
+}
+
+func (m *Params) GetNameCharset() string {
+	if m != nil {
+		return m.NameCharset
+	}
+	return ""
+}
+
+func (m *Params) GetMaxResourcesPerCreator() uint64 {
+	if m != nil {
+		return m.MaxResourcesPerCreator
+	}
+	return 0
+}
+
+func (m *Params) GetCreationFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.CreationFee
+	}
+	return nil
+}
+
+func (m *Params) GetDepositPerByte() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.DepositPerByte
+	}
+	return nil
+}
+
+func (m *Params) GetMaxPrunedPerBlock() uint64 {
+	if m != nil {
+		return m.MaxPrunedPerBlock
+	}
+	return 0
+}
+
+func (m *Params) GetMaxAttributeKeyLength() uint64 {
+	if m != nil {
+		return m.MaxAttributeKeyLength
+	}
+	return 0
+}
+
+func (m *Params) GetMaxAttributeValueLength() uint64 {
+	if m != nil {
+		return m.MaxAttributeValueLength
+	}
+	return 0
+}
+
+func (m *Params) GetMaxAttributesPerResource() uint64 {
+	if m != nil {
+		return m.MaxAttributesPerResource
+	}
+	return 0
+}
+
+func (m *Params) GetMaxSchemaLength() uint64 {
+	if m != nil {
+		return m.MaxSchemaLength
+	}
+	return 0
+}
+
+func (m *Params) GetOnlyApprovedSchemas() bool {
+	if m != nil {
+		return m.OnlyApprovedSchemas
+	}
+	return false
+}
+
+func (m *Params) GetUniqueNames() bool {
+	if m != nil {
+		return m.UniqueNames
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "crude.crude.Params")
 }
@@ -68,16 +190,39 @@ func init() {
 func init() { proto.RegisterFile("crude/crude/params.proto", fileDescriptor_bae99116d4d66e47) }
 
 var fileDescriptor_bae99116d4d66e47 = []byte{
-	// 144 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x48, 0x2e, 0x2a, 0x4d,
-	0x49, 0xd5, 0x87, 0x90, 0x05, 0x89, 0x45, 0x89, 0xb9, 0xc5, 0x7a, 0x05, 0x45, 0xf9, 0x25, 0xf9,
-	0x42, 0xdc, 0x60, 0x31, 0x3d, 0x30, 0x29, 0x25, 0x98, 0x98, 0x9b, 0x99, 0x97, 0xaf, 0x0f, 0x26,
-	0x21, 0xf2, 0x52, 0x22, 0xe9, 0xf9, 0xe9, 0xf9, 0x60, 0xa6, 0x3e, 0x88, 0x05, 0x11, 0x55, 0x52,
-	0xe7, 0x62, 0x0b, 0x00, 0x9b, 0x62, 0x25, 0xfb, 0x62, 0x81, 0x3c, 0x63, 0xd7, 0xf3, 0x0d, 0x5a,
-	0x22, 0x10, 0xc3, 0x2b, 0xa0, 0x96, 0x40, 0xa4, 0x9d, 0x74, 0x4f, 0x3c, 0x92, 0x63, 0xbc, 0xf0,
-	0x48, 0x8e, 0xf1, 0xc1, 0x23, 0x39, 0xc6, 0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96, 0x63, 0xb8,
-	0xf1, 0x58, 0x8e, 0x21, 0x4a, 0x18, 0x55, 0x7d, 0x49, 0x65, 0x41, 0x6a, 0x71, 0x12, 0x1b, 0xd8,
-	0x78, 0x63, 0x40, 0x00, 0x00, 0x00, 0xff, 0xff, 0x39, 0x52, 0xce, 0x7f, 0xb0, 0x00, 0x00, 0x00,
+	// 506 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x93, 0xb1, 0x6f, 0xd3, 0x4e,
+	0x14, 0xc7, 0x73, 0xbf, 0xf6, 0x17, 0xda, 0x4b, 0x01, 0xf5, 0x5a, 0xe0, 0xa8, 0x84, 0x13, 0x21,
+	0x06, 0x2b, 0xa2, 0x36, 0x05, 0x84, 0x50, 0xb7, 0x26, 0x52, 0x17, 0x10, 0x8a, 0x8c, 0xc4, 0xc0,
+	0x82, 0xce, 0xf6, 0x93, 0x63, 0x35, 0xe7, 0x33, 0x77, 0xe7, 0xca, 0x9e, 0x99, 0x60, 0x82, 0x95,
+	0x89, 0x11, 0x31, 0xe5, 0xcf, 0xe8, 0xd8, 0x91, 0x09, 0x50, 0x32, 0x84, 0x3f, 0x03, 0xf9, 0xec,
+	0x4a, 0x6e, 0x69, 0x56, 0x96, 0x97, 0xa7, 0xef, 0xe7, 0x5e, 0x9e, 0xdf, 0x7d, 0xef, 0x61, 0x1a,
+	0xc8, 0x2c, 0x04, 0xb7, 0x8a, 0x29, 0x93, 0x8c, 0x2b, 0x27, 0x95, 0x42, 0x0b, 0xd2, 0x31, 0x9a,
+	0x63, 0xe2, 0xce, 0x26, 0xe3, 0x71, 0x22, 0x5c, 0x13, 0x2b, 0xbe, 0xb3, 0x1d, 0x89, 0x48, 0x98,
+	0xd4, 0x2d, 0xb3, 0x5a, 0xb5, 0x02, 0xa1, 0xb8, 0x50, 0xae, 0xcf, 0x14, 0xb8, 0xc7, 0x7b, 0x3e,
+	0x68, 0xb6, 0xe7, 0x06, 0x22, 0x4e, 0x2a, 0x7e, 0xf7, 0x53, 0x1b, 0xb7, 0x47, 0xa6, 0x0d, 0xb9,
+	0x87, 0xaf, 0x72, 0x96, 0xbf, 0x60, 0x1c, 0x9e, 0x43, 0x12, 0xe9, 0x31, 0x45, 0x3d, 0x64, 0xaf,
+	0x7a, 0xe7, 0x45, 0xd2, 0xc3, 0x9d, 0x84, 0x71, 0x18, 0x8e, 0x99, 0x54, 0xa0, 0xe9, 0x7f, 0x3d,
+	0x64, 0xaf, 0x7b, 0x4d, 0x89, 0x3c, 0xc1, 0x37, 0x39, 0xcb, 0x3d, 0x50, 0x22, 0x93, 0x01, 0xa8,
+	0x11, 0xc8, 0xa1, 0x04, 0xa6, 0x85, 0xa4, 0x2b, 0xe6, 0x0f, 0x97, 0x50, 0xf2, 0x0e, 0xe1, 0x4e,
+	0x50, 0xe6, 0xb1, 0x48, 0x0e, 0x01, 0xe8, 0x6a, 0x6f, 0xc5, 0xee, 0x3c, 0xbc, 0xed, 0x54, 0x13,
+	0x38, 0xe5, 0x04, 0x4e, 0x3d, 0x81, 0x33, 0x14, 0x71, 0x32, 0x38, 0x3c, 0xf9, 0xd1, 0x6d, 0x7d,
+	0xfb, 0xd9, 0xb5, 0xa3, 0x58, 0x8f, 0x33, 0xdf, 0x09, 0x04, 0x77, 0xeb, 0x71, 0xab, 0x9f, 0x5d,
+	0x15, 0x1e, 0xb9, 0xba, 0x48, 0x41, 0x99, 0x02, 0xf5, 0x79, 0x31, 0xed, 0x6f, 0x4c, 0x20, 0x62,
+	0x41, 0xf1, 0xa6, 0xbc, 0x03, 0xf5, 0x75, 0x31, 0xed, 0x23, 0xaf, 0xd9, 0x95, 0xbc, 0x47, 0xf8,
+	0x5a, 0x08, 0xa9, 0x50, 0xb1, 0x1e, 0x81, 0x1c, 0x14, 0x1a, 0xe8, 0xff, 0xff, 0xea, 0x43, 0x2e,
+	0x34, 0x26, 0xf7, 0xf1, 0x26, 0x67, 0xf9, 0x48, 0x66, 0x09, 0x84, 0xa5, 0x36, 0x11, 0xc1, 0x11,
+	0x6d, 0x9b, 0x4b, 0xfc, 0x1b, 0x90, 0xc7, 0xf8, 0x06, 0x67, 0xf9, 0x81, 0xd6, 0x32, 0xf6, 0x33,
+	0x0d, 0xcf, 0xa0, 0xa8, 0x7d, 0xbc, 0x62, 0x2a, 0x2e, 0x87, 0xe4, 0x29, 0xbe, 0xd5, 0x04, 0xaf,
+	0xd8, 0x24, 0x3b, 0xf3, 0x7f, 0xcd, 0xd4, 0x2d, 0xc3, 0x64, 0x1f, 0xd3, 0x26, 0x2a, 0xad, 0x3c,
+	0xb3, 0x95, 0xae, 0x9b, 0xd2, 0xa5, 0x9c, 0xd8, 0xf8, 0x3a, 0x67, 0xf9, 0xcb, 0x60, 0x0c, 0x9c,
+	0xd5, 0xdd, 0xb0, 0x29, 0xb9, 0x28, 0x93, 0x07, 0x78, 0x4b, 0x24, 0x93, 0xe2, 0x20, 0x4d, 0xa5,
+	0x38, 0x86, 0xb0, 0x62, 0x8a, 0x76, 0x7a, 0xc8, 0x5e, 0xf3, 0x2e, 0x43, 0xe5, 0x0b, 0xcd, 0x92,
+	0xf8, 0x6d, 0x06, 0xe5, 0xab, 0x55, 0x74, 0xc3, 0x9c, 0x6c, 0x4a, 0xfb, 0x77, 0x7e, 0x7f, 0xe9,
+	0xa2, 0x0f, 0x8b, 0x69, 0x7f, 0xbb, 0xda, 0xb3, 0xbc, 0xde, 0xb7, 0x6a, 0x11, 0x06, 0xbb, 0x27,
+	0x33, 0x0b, 0x9d, 0xce, 0x2c, 0xf4, 0x6b, 0x66, 0xa1, 0x8f, 0x73, 0xab, 0x75, 0x3a, 0xb7, 0x5a,
+	0xdf, 0xe7, 0x56, 0xeb, 0xf5, 0xd6, 0xf9, 0xf3, 0xc6, 0x51, 0xbf, 0x6d, 0x36, 0xe9, 0xd1, 0x9f,
+	0x01, 0x00, 0x37, 0x99, 0x66, 0xd7, 0xbb, 0x03, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -99,6 +244,52 @@ func (this *Params) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
+	if this.MaxNameLength != that1.MaxNameLength {
+		return false
+	}
+	if this.NameCharset != that1.NameCharset {
+		return false
+	}
+	if this.MaxResourcesPerCreator != that1.MaxResourcesPerCreator {
+		return false
+	}
+	if len(this.CreationFee) != len(that1.CreationFee) {
+		return false
+	}
+	for i := range this.CreationFee {
+		if !this.CreationFee[i].Equal(&that1.CreationFee[i]) {
+			return false
+		}
+	}
+	if len(this.DepositPerByte) != len(that1.DepositPerByte) {
+		return false
+	}
+	for i := range this.DepositPerByte {
+		if !this.DepositPerByte[i].Equal(&that1.DepositPerByte[i]) {
+			return false
+		}
+	}
+	if this.MaxPrunedPerBlock != that1.MaxPrunedPerBlock {
+		return false
+	}
+	if this.MaxAttributeKeyLength != that1.MaxAttributeKeyLength {
+		return false
+	}
+	if this.MaxAttributeValueLength != that1.MaxAttributeValueLength {
+		return false
+	}
+	if this.MaxAttributesPerResource != that1.MaxAttributesPerResource {
+		return false
+	}
+	if this.MaxSchemaLength != that1.MaxSchemaLength {
+		return false
+	}
+	if this.OnlyApprovedSchemas != that1.OnlyApprovedSchemas {
+		return false
+	}
+	if this.UniqueNames != that1.UniqueNames {
+		return false
+	}
 	return true
 }
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -121,6 +312,96 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.UniqueNames {
+		i--
+		if m.UniqueNames {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.OnlyApprovedSchemas {
+		i--
+		if m.OnlyApprovedSchemas {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.MaxSchemaLength != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxSchemaLength))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.MaxAttributesPerResource != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxAttributesPerResource))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.MaxAttributeValueLength != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxAttributeValueLength))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.MaxAttributeKeyLength != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxAttributeKeyLength))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.MaxPrunedPerBlock != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxPrunedPerBlock))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.DepositPerByte) > 0 {
+		for iNdEx := len(m.DepositPerByte) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DepositPerByte[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.CreationFee) > 0 {
+		for iNdEx := len(m.CreationFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CreationFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.MaxResourcesPerCreator != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxResourcesPerCreator))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.NameCharset) > 0 {
+		i -= len(m.NameCharset)
+		copy(dAtA[i:], m.NameCharset)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.NameCharset)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.MaxNameLength != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxNameLength))
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
@@ -141,6 +422,49 @@ func (m *Params) Size() (n int) {
 	}
 	var l int
 	_ = l
+	if m.MaxNameLength != 0 {
+		n += 1 + sovParams(uint64(m.MaxNameLength))
+	}
+	l = len(m.NameCharset)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if m.MaxResourcesPerCreator != 0 {
+		n += 1 + sovParams(uint64(m.MaxResourcesPerCreator))
+	}
+	if len(m.CreationFee) > 0 {
+		for _, e := range m.CreationFee {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if len(m.DepositPerByte) > 0 {
+		for _, e := range m.DepositPerByte {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if m.MaxPrunedPerBlock != 0 {
+		n += 1 + sovParams(uint64(m.MaxPrunedPerBlock))
+	}
+	if m.MaxAttributeKeyLength != 0 {
+		n += 1 + sovParams(uint64(m.MaxAttributeKeyLength))
+	}
+	if m.MaxAttributeValueLength != 0 {
+		n += 1 + sovParams(uint64(m.MaxAttributeValueLength))
+	}
+	if m.MaxAttributesPerResource != 0 {
+		n += 1 + sovParams(uint64(m.MaxAttributesPerResource))
+	}
+	if m.MaxSchemaLength != 0 {
+		n += 1 + sovParams(uint64(m.MaxSchemaLength))
+	}
+	if m.OnlyApprovedSchemas {
+		n += 2
+	}
+	if m.UniqueNames {
+		n += 2
+	}
 	return n
 }
 
@@ -179,6 +503,279 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxNameLength", wireType)
+			}
+			m.MaxNameLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxNameLength |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NameCharset", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NameCharset = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxResourcesPerCreator", wireType)
+			}
+			m.MaxResourcesPerCreator = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxResourcesPerCreator |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreationFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CreationFee = append(m.CreationFee, types.Coin{})
+			if err := m.CreationFee[len(m.CreationFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositPerByte", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DepositPerByte = append(m.DepositPerByte, types.Coin{})
+			if err := m.DepositPerByte[len(m.DepositPerByte)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPrunedPerBlock", wireType)
+			}
+			m.MaxPrunedPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPrunedPerBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAttributeKeyLength", wireType)
+			}
+			m.MaxAttributeKeyLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxAttributeKeyLength |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAttributeValueLength", wireType)
+			}
+			m.MaxAttributeValueLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxAttributeValueLength |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAttributesPerResource", wireType)
+			}
+			m.MaxAttributesPerResource = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxAttributesPerResource |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSchemaLength", wireType)
+			}
+			m.MaxSchemaLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxSchemaLength |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OnlyApprovedSchemas", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.OnlyApprovedSchemas = bool(v != 0)
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UniqueNames", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.UniqueNames = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -0,0 +1,95 @@
+package types
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParams_Validate(t *testing.T) {
+	tests := []struct {
+		name   string
+		params Params
+		valid  bool
+	}{
+		{
+			name:   "default",
+			params: DefaultParams(),
+			valid:  true,
+		},
+		{
+			name:   "empty",
+			params: Params{},
+			valid:  true,
+		},
+		{
+			name:   "creation fee",
+			params: NewParams(10, "ab", 5, sdk.NewCoins(sdk.NewInt64Coin("stake", 10)), nil, 0, 0, 0, 0, 0, false, false),
+			valid:  true,
+		},
+		{
+			name:   "invalid charset",
+			params: Params{NameCharset: "\xff"},
+		},
+		{
+			name:   "negative creation fee",
+			params: Params{CreationFee: sdk.Coins{sdk.Coin{Denom: "stake", Amount: math.NewInt(-1)}}},
+		},
+		{
+			name:   "negative deposit per byte",
+			params: Params{DepositPerByte: sdk.Coins{sdk.Coin{Denom: "stake", Amount: math.NewInt(-1)}}},
+		},
+		{
+			name:   "unsorted creation fee",
+			params: Params{CreationFee: sdk.Coins{sdk.NewInt64Coin("zoo", 1), sdk.NewInt64Coin("abc", 1)}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.params.Validate()
+			if tt.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestParams_ValidateName(t *testing.T) {
+	params := NewParams(5, "abc", 0, nil, nil, 0, 0, 0, 0, 0, false, false)
+
+	require.NoError(t, params.ValidateName(""))
+	require.NoError(t, params.ValidateName("abcab"))
+	require.Error(t, params.ValidateName("abcabc"))
+	require.Error(t, params.ValidateName("abd"))
+
+	// Zero values disable both checks
+	require.NoError(t, Params{}.ValidateName("any name, any length ✓"))
+
+	// NUL bytes are never allowed, they delimit names in the name index
+	require.Error(t, Params{}.ValidateName("a\x00b"))
+}
+
+func TestParams_ValidateAttribute(t *testing.T) {
+	params := NewParams(0, "", 0, nil, nil, 0, 3, 4, 0, 0, false, false)
+
+	require.NoError(t, params.ValidateAttribute(ResourceAttribute{Key: "abc", Value: "abcd"}))
+	require.Error(t, params.ValidateAttribute(ResourceAttribute{Key: "abcd", Value: "abcd"}))
+	require.Error(t, params.ValidateAttribute(ResourceAttribute{Key: "abc", Value: "abcde"}))
+
+	// Zero values disable both checks
+	require.NoError(t, Params{}.ValidateAttribute(ResourceAttribute{Key: "any key", Value: "any value"}))
+}
+
+func TestParams_ValidateSchemaDocument(t *testing.T) {
+	params := NewParams(0, "", 0, nil, nil, 0, 0, 0, 0, 4, false, false)
+
+	require.NoError(t, params.ValidateSchemaDocument("{}"))
+	require.Error(t, params.ValidateSchemaDocument(`{"a":1}`))
+
+	// A zero value disables the check
+	require.NoError(t, Params{}.ValidateSchemaDocument(`{"a":1}`))
+}
@@ -5,7 +5,10 @@ package types
 
 import (
 	context "context"
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	crypto "github.com/cometbft/cometbft/proto/tendermint/crypto"
+	_ "github.com/cosmos/cosmos-proto"
 	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
@@ -202,8 +205,30 @@ func (m *QueryGetResourceResponse) GetResource() Resource {
 	return Resource{}
 }
 
+// QueryAllResourceRequest lists the resources matching every filter set. The
+// first filter set among namespace, creator and namePrefix picks the index
+// walked, in id order for the first two and in name order for the last, the
+// other filters are checked on each entry of the index.
 type QueryAllResourceRequest struct {
 	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// namespace, if set, restricts the resources to those of a namespace.
+	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// creator, if set, restricts the resources to those created by an address.
+	Creator string `protobuf:"bytes,3,opt,name=creator,proto3" json:"creator,omitempty"`
+	// namePrefix, if set, restricts the resources to those whose name starts
+	// with it.
+	NamePrefix string `protobuf:"bytes,4,opt,name=namePrefix,proto3" json:"namePrefix,omitempty"`
+	// valueRange, if set, restricts the resources to those holding a uint64
+	// value within [min, max]. The value range has no index: set without any
+	// of the above, it is checked on every resource.
+	ValueRange *ResourceValueBounds `protobuf:"bytes,5,opt,name=valueRange,proto3" json:"valueRange,omitempty"`
+	// reverse lists the resources in descending order, like pagination.reverse.
+	Reverse bool `protobuf:"varint,6,opt,name=reverse,proto3" json:"reverse,omitempty"`
+	// countOnly returns the number of matching resources in count instead of
+	// the resources themselves. Pagination is ignored. Only the entries of the
+	// index picked are counted, and the value range alone is counted from the
+	// value aggregates.
+	CountOnly bool `protobuf:"varint,7,opt,name=countOnly,proto3" json:"countOnly,omitempty"`
 }
 
 func (m *QueryAllResourceRequest) Reset()         { *m = QueryAllResourceRequest{} }
@@ -246,9 +271,53 @@ func (m *QueryAllResourceRequest) GetPagination() *query.PageRequest {
 	return nil
 }
 
+func (m *QueryAllResourceRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+func (m *QueryAllResourceRequest) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *QueryAllResourceRequest) GetNamePrefix() string {
+	if m != nil {
+		return m.NamePrefix
+	}
+	return ""
+}
+
+func (m *QueryAllResourceRequest) GetValueRange() *ResourceValueBounds {
+	if m != nil {
+		return m.ValueRange
+	}
+	return nil
+}
+
+func (m *QueryAllResourceRequest) GetReverse() bool {
+	if m != nil {
+		return m.Reverse
+	}
+	return false
+}
+
+func (m *QueryAllResourceRequest) GetCountOnly() bool {
+	if m != nil {
+		return m.CountOnly
+	}
+	return false
+}
+
 type QueryAllResourceResponse struct {
 	Resource   []Resource          `protobuf:"bytes,1,rep,name=Resource,proto3" json:"Resource"`
 	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// count is the number of matching resources, only set in countOnly mode.
+	Count uint64 `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
 }
 
 func (m *QueryAllResourceResponse) Reset()         { *m = QueryAllResourceResponse{} }
@@ -298,502 +367,10774 @@ func (m *QueryAllResourceResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "crude.crude.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "crude.crude.QueryParamsResponse")
-	proto.RegisterType((*QueryGetResourceRequest)(nil), "crude.crude.QueryGetResourceRequest")
-	proto.RegisterType((*QueryGetResourceResponse)(nil), "crude.crude.QueryGetResourceResponse")
-	proto.RegisterType((*QueryAllResourceRequest)(nil), "crude.crude.QueryAllResourceRequest")
-	proto.RegisterType((*QueryAllResourceResponse)(nil), "crude.crude.QueryAllResourceResponse")
-}
-
-func init() { proto.RegisterFile("crude/crude/query.proto", fileDescriptor_5f2383a33128a245) }
-
-var fileDescriptor_5f2383a33128a245 = []byte{
-	// 480 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0xcf, 0x8b, 0xd3, 0x40,
-	0x14, 0xc7, 0x93, 0xb8, 0x16, 0x9d, 0x82, 0xe0, 0x64, 0x4b, 0x4b, 0x5c, 0xb3, 0xcb, 0xe0, 0xcf,
-	0x05, 0x67, 0xd8, 0x15, 0xf4, 0xbc, 0x3d, 0xd8, 0x93, 0x50, 0xe3, 0xcd, 0xdb, 0xb4, 0x19, 0x62,
-	0x20, 0xcd, 0xa4, 0x99, 0x44, 0x2c, 0xc5, 0x8b, 0x7f, 0x81, 0xe0, 0xd5, 0x3f, 0xc0, 0xa3, 0x7f,
-	0x46, 0x8f, 0x05, 0x2f, 0x9e, 0x44, 0x5a, 0x41, 0xfc, 0x2f, 0x24, 0x33, 0x13, 0x9b, 0x98, 0x48,
-	0xf7, 0x32, 0x84, 0xf7, 0xbe, 0xef, 0x7d, 0xbe, 0xf3, 0xe6, 0x05, 0xf4, 0xa7, 0x69, 0xee, 0x33,
-	0xa2, 0xce, 0x79, 0xce, 0xd2, 0x05, 0x4e, 0x52, 0x9e, 0x71, 0xd8, 0x95, 0x21, 0x2c, 0x4f, 0xe7,
-	0x26, 0x9d, 0x85, 0x31, 0x27, 0xf2, 0x54, 0x79, 0xe7, 0x30, 0xe0, 0x01, 0x97, 0x9f, 0xa4, 0xf8,
-	0xd2, 0xd1, 0xa3, 0x80, 0xf3, 0x20, 0x62, 0x84, 0x26, 0x21, 0xa1, 0x71, 0xcc, 0x33, 0x9a, 0x85,
-	0x3c, 0x16, 0x3a, 0x7b, 0x3a, 0xe5, 0x62, 0xc6, 0x05, 0x99, 0x50, 0xa1, 0x61, 0xe4, 0xcd, 0xd9,
-	0x84, 0x65, 0xf4, 0x8c, 0x24, 0x34, 0x08, 0x63, 0x29, 0xd6, 0xda, 0x41, 0xd5, 0x58, 0x42, 0x53,
-	0x3a, 0x2b, 0xbb, 0x38, 0xd5, 0x4c, 0xca, 0x04, 0xcf, 0xd3, 0x29, 0x53, 0x39, 0x74, 0x08, 0xe0,
-	0x8b, 0xa2, 0xef, 0x58, 0x16, 0x78, 0x6c, 0x9e, 0x33, 0x91, 0xa1, 0xe7, 0xc0, 0xae, 0x45, 0x45,
-	0xc2, 0x63, 0xc1, 0xe0, 0x13, 0xd0, 0x51, 0x8d, 0x07, 0xe6, 0x89, 0xf9, 0xa0, 0x7b, 0x6e, 0xe3,
-	0xca, 0x9d, 0xb1, 0x12, 0x0f, 0xaf, 0xaf, 0xbe, 0x1f, 0x1b, 0x9f, 0x7f, 0x7d, 0x39, 0x35, 0x3d,
-	0xad, 0x46, 0x0f, 0x41, 0x5f, 0xb6, 0x1b, 0xb1, 0xcc, 0xd3, 0x78, 0x4d, 0x82, 0x37, 0x80, 0x15,
-	0xfa, 0xb2, 0xdd, 0x81, 0x67, 0x85, 0x3e, 0x7a, 0x09, 0x06, 0x4d, 0xa9, 0xc6, 0x3f, 0x05, 0xd7,
-	0xca, 0x98, 0x36, 0xd0, 0xab, 0x19, 0x28, 0x93, 0xc3, 0x83, 0xc2, 0x82, 0xf7, 0x57, 0x8c, 0xa8,
-	0xe6, 0x5f, 0x44, 0xd1, 0xbf, 0xfc, 0x67, 0x00, 0xec, 0x26, 0xa9, 0xbb, 0xde, 0xc3, 0x6a, 0xec,
-	0xb8, 0x18, 0x3b, 0x56, 0x6f, 0xac, 0xc7, 0x8e, 0xc7, 0x34, 0x28, 0x6b, 0xbd, 0x4a, 0x25, 0xfa,
-	0x64, 0x6a, 0xe3, 0x35, 0x46, 0xab, 0xf1, 0x2b, 0x97, 0x36, 0x0e, 0x47, 0x35, 0x77, 0x96, 0x74,
-	0x77, 0x7f, 0xaf, 0x3b, 0x45, 0xad, 0xda, 0x3b, 0xff, 0x6d, 0x81, 0xab, 0xd2, 0x1e, 0x7c, 0x0d,
-	0x3a, 0xea, 0xa1, 0xe0, 0x71, 0xcd, 0x43, 0x73, 0x0b, 0x9c, 0x93, 0xff, 0x0b, 0x14, 0x02, 0xdd,
-	0x7a, 0xff, 0xf5, 0xe7, 0x47, 0xab, 0x07, 0x6d, 0xd2, 0x5c, 0x3e, 0xb8, 0xdc, 0xdd, 0x1a, 0xde,
-	0x69, 0xb6, 0x6a, 0x2e, 0x83, 0x73, 0x77, 0x8f, 0x4a, 0x53, 0x91, 0xa4, 0x1e, 0x41, 0x87, 0xb4,
-	0x2d, 0x36, 0x59, 0x86, 0xfe, 0x3b, 0xb8, 0x00, 0xdd, 0xb2, 0xee, 0x22, 0x8a, 0xda, 0xf8, 0xcd,
-	0x65, 0x68, 0xe3, 0xb7, 0x3c, 0x27, 0xba, 0x2d, 0xf9, 0x7d, 0xd8, 0x6b, 0xe5, 0x0f, 0x1f, 0xad,
-	0x36, 0xae, 0xb9, 0xde, 0xb8, 0xe6, 0x8f, 0x8d, 0x6b, 0x7e, 0xd8, 0xba, 0xc6, 0x7a, 0xeb, 0x1a,
-	0xdf, 0xb6, 0xae, 0xf1, 0xca, 0x56, 0xca, 0xb7, 0xba, 0x22, 0x5b, 0x24, 0x4c, 0x4c, 0x3a, 0xf2,
-	0x47, 0x7c, 0xfc, 0x27, 0x00, 0x00, 0xff, 0xff, 0x71, 0xde, 0x4b, 0xb7, 0x59, 0x04, 0x00, 0x00,
+func (m *QueryAllResourceResponse) GetCount() uint64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Parameters queries the parameters of the module.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// Queries a list of Resource items.
-	Resource(ctx context.Context, in *QueryGetResourceRequest, opts ...grpc.CallOption) (*QueryGetResourceResponse, error)
-	ResourceAll(ctx context.Context, in *QueryAllResourceRequest, opts ...grpc.CallOption) (*QueryAllResourceResponse, error)
+type QueryResourcesByCreatorRequest struct {
+	Creator    string             `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryResourcesByCreatorRequest) Reset()         { *m = QueryResourcesByCreatorRequest{} }
+func (m *QueryResourcesByCreatorRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryResourcesByCreatorRequest) ProtoMessage()    {}
+func (*QueryResourcesByCreatorRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f2383a33128a245, []int{6}
 }
-
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryResourcesByCreatorRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/crude.crude.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryResourcesByCreatorRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryResourcesByCreatorRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryResourcesByCreatorRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryResourcesByCreatorRequest.Merge(m, src)
+}
+func (m *QueryResourcesByCreatorRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryResourcesByCreatorRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryResourcesByCreatorRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) Resource(ctx context.Context, in *QueryGetResourceRequest, opts ...grpc.CallOption) (*QueryGetResourceResponse, error) {
-	out := new(QueryGetResourceResponse)
-	err := c.cc.Invoke(ctx, "/crude.crude.Query/Resource", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryResourcesByCreatorRequest proto.InternalMessageInfo
+
+func (m *QueryResourcesByCreatorRequest) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) ResourceAll(ctx context.Context, in *QueryAllResourceRequest, opts ...grpc.CallOption) (*QueryAllResourceResponse, error) {
-	out := new(QueryAllResourceResponse)
-	err := c.cc.Invoke(ctx, "/crude.crude.Query/ResourceAll", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryResourcesByCreatorRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return out, nil
+	return nil
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Parameters queries the parameters of the module.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// Queries a list of Resource items.
-	Resource(context.Context, *QueryGetResourceRequest) (*QueryGetResourceResponse, error)
-	ResourceAll(context.Context, *QueryAllResourceRequest) (*QueryAllResourceResponse, error)
+type QueryResourcesByCreatorResponse struct {
+	Resource   []Resource          `protobuf:"bytes,1,rep,name=Resource,proto3" json:"Resource"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *QueryResourcesByCreatorResponse) Reset()         { *m = QueryResourcesByCreatorResponse{} }
+func (m *QueryResourcesByCreatorResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryResourcesByCreatorResponse) ProtoMessage()    {}
+func (*QueryResourcesByCreatorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f2383a33128a245, []int{7}
 }
-
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *QueryResourcesByCreatorResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) Resource(ctx context.Context, req *QueryGetResourceRequest) (*QueryGetResourceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Resource not implemented")
+func (m *QueryResourcesByCreatorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryResourcesByCreatorResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) ResourceAll(ctx context.Context, req *QueryAllResourceRequest) (*QueryAllResourceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ResourceAll not implemented")
+func (m *QueryResourcesByCreatorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryResourcesByCreatorResponse.Merge(m, src)
 }
-
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (m *QueryResourcesByCreatorResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/crude.crude.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *QueryResourcesByCreatorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryResourcesByCreatorResponse.DiscardUnknown(m)
 }
 
-func _Query_Resource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetResourceRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Resource(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/crude.crude.Query/Resource",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Resource(ctx, req.(*QueryGetResourceRequest))
+var xxx_messageInfo_QueryResourcesByCreatorResponse proto.InternalMessageInfo
+
+func (m *QueryResourcesByCreatorResponse) GetResource() []Resource {
+	if m != nil {
+		return m.Resource
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_ResourceAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllResourceRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ResourceAll(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/crude.crude.Query/ResourceAll",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ResourceAll(ctx, req.(*QueryAllResourceRequest))
+func (m *QueryResourcesByCreatorResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-var Query_serviceDesc = _Query_serviceDesc
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "crude.crude.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "Resource",
-			Handler:    _Query_Resource_Handler,
-		},
-		{
-			MethodName: "ResourceAll",
-			Handler:    _Query_ResourceAll_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "crude/crude/query.proto",
+type QueryResourceHistoryRequest struct {
+	Id         uint64             `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryResourceHistoryRequest) Reset()         { *m = QueryResourceHistoryRequest{} }
+func (m *QueryResourceHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryResourceHistoryRequest) ProtoMessage()    {}
+func (*QueryResourceHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f2383a33128a245, []int{8}
+}
+func (m *QueryResourceHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryResourceHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryResourceHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryResourceHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryResourceHistoryRequest.Merge(m, src)
+}
+func (m *QueryResourceHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryResourceHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryResourceHistoryRequest.DiscardUnknown(m)
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var xxx_messageInfo_QueryResourceHistoryRequest proto.InternalMessageInfo
+
+func (m *QueryResourceHistoryRequest) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryResourceHistoryRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryResourceHistoryResponse struct {
+	Revisions  []ResourceRevision  `protobuf:"bytes,1,rep,name=revisions,proto3" json:"revisions"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryResourceHistoryResponse) Reset()         { *m = QueryResourceHistoryResponse{} }
+func (m *QueryResourceHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryResourceHistoryResponse) ProtoMessage()    {}
+func (*QueryResourceHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f2383a33128a245, []int{9}
+}
+func (m *QueryResourceHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryResourceHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryResourceHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryGetResourceRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryResourceHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryResourceHistoryResponse.Merge(m, src)
 }
-
-func (m *QueryGetResourceRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryResourceHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryResourceHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryResourceHistoryResponse.DiscardUnknown(m)
 }
 
-func (m *QueryGetResourceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Id != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
+var xxx_messageInfo_QueryResourceHistoryResponse proto.InternalMessageInfo
+
+func (m *QueryResourceHistoryResponse) GetRevisions() []ResourceRevision {
+	if m != nil {
+		return m.Revisions
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryGetResourceResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryResourceHistoryResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryGetResourceResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryResourceAtRevisionRequest struct {
+	Id       uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Revision uint64 `protobuf:"varint,2,opt,name=revision,proto3" json:"revision,omitempty"`
 }
 
-func (m *QueryGetResourceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Resource.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryResourceAtRevisionRequest) Reset()         { *m = QueryResourceAtRevisionRequest{} }
+func (m *QueryResourceAtRevisionRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryResourceAtRevisionRequest) ProtoMessage()    {}
+func (*QueryResourceAtRevisionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f2383a33128a245, []int{10}
+}
+func (m *QueryResourceAtRevisionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryResourceAtRevisionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryResourceAtRevisionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryAllResourceRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryResourceAtRevisionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryResourceAtRevisionRequest.Merge(m, src)
 }
-
-func (m *QueryAllResourceRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryResourceAtRevisionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryResourceAtRevisionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryResourceAtRevisionRequest.DiscardUnknown(m)
 }
 
-func (m *QueryAllResourceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_QueryResourceAtRevisionRequest proto.InternalMessageInfo
+
+func (m *QueryResourceAtRevisionRequest) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *QueryAllResourceResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryResourceAtRevisionRequest) GetRevision() uint64 {
+	if m != nil {
+		return m.Revision
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryAllResourceResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryResourceAtRevisionResponse struct {
+	Revision ResourceRevision `protobuf:"bytes,1,opt,name=revision,proto3" json:"revision"`
 }
 
-func (m *QueryAllResourceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Resource) > 0 {
-		for iNdEx := len(m.Resource) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Resource[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryResourceAtRevisionResponse) Reset()         { *m = QueryResourceAtRevisionResponse{} }
+func (m *QueryResourceAtRevisionResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryResourceAtRevisionResponse) ProtoMessage()    {}
+func (*QueryResourceAtRevisionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f2383a33128a245, []int{11}
+}
+func (m *QueryResourceAtRevisionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryResourceAtRevisionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryResourceAtRevisionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryResourceAtRevisionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryResourceAtRevisionResponse.Merge(m, src)
+}
+func (m *QueryResourceAtRevisionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryResourceAtRevisionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryResourceAtRevisionResponse.DiscardUnknown(m)
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_QueryResourceAtRevisionResponse proto.InternalMessageInfo
+
+func (m *QueryResourceAtRevisionResponse) GetRevision() ResourceRevision {
+	if m != nil {
+		return m.Revision
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return ResourceRevision{}
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+
+type QueryResourcesByOwnerRequest struct {
+	Owner      string             `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryResourcesByOwnerRequest) Reset()         { *m = QueryResourcesByOwnerRequest{} }
+func (m *QueryResourcesByOwnerRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryResourcesByOwnerRequest) ProtoMessage()    {}
+func (*QueryResourcesByOwnerRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f2383a33128a245, []int{12}
+}
+func (m *QueryResourcesByOwnerRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryResourcesByOwnerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryResourcesByOwnerRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+}
+func (m *QueryResourcesByOwnerRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryResourcesByOwnerRequest.Merge(m, src)
+}
+func (m *QueryResourcesByOwnerRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryResourcesByOwnerRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryResourcesByOwnerRequest.DiscardUnknown(m)
 }
 
-func (m *QueryGetResourceRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sovQuery(uint64(m.Id))
+var xxx_messageInfo_QueryResourcesByOwnerRequest proto.InternalMessageInfo
+
+func (m *QueryResourcesByOwnerRequest) GetOwner() string {
+	if m != nil {
+		return m.Owner
 	}
-	return n
+	return ""
 }
 
-func (m *QueryGetResourceResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryResourcesByOwnerRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	var l int
-	_ = l
-	l = m.Resource.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return nil
 }
 
-func (m *QueryAllResourceRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+type QueryResourcesByOwnerResponse struct {
+	Resource   []Resource          `protobuf:"bytes,1,rep,name=Resource,proto3" json:"Resource"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryAllResourceResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryResourcesByOwnerResponse) Reset()         { *m = QueryResourcesByOwnerResponse{} }
+func (m *QueryResourcesByOwnerResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryResourcesByOwnerResponse) ProtoMessage()    {}
+func (*QueryResourcesByOwnerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f2383a33128a245, []int{13}
+}
+func (m *QueryResourcesByOwnerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryResourcesByOwnerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryResourcesByOwnerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	if len(m.Resource) > 0 {
-		for _, e := range m.Resource {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+}
+func (m *QueryResourcesByOwnerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryResourcesByOwnerResponse.Merge(m, src)
+}
+func (m *QueryResourcesByOwnerResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryResourcesByOwnerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryResourcesByOwnerResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryResourcesByOwnerResponse proto.InternalMessageInfo
+
+func (m *QueryResourcesByOwnerResponse) GetResource() []Resource {
+	if m != nil {
+		return m.Resource
+	}
+	return nil
+}
+
+func (m *QueryResourcesByOwnerResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryResourcesByAttributeRequest struct {
+	Key        string             `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value      string             `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryResourcesByAttributeRequest) Reset()         { *m = QueryResourcesByAttributeRequest{} }
+func (m *QueryResourcesByAttributeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryResourcesByAttributeRequest) ProtoMessage()    {}
+func (*QueryResourcesByAttributeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f2383a33128a245, []int{14}
+}
+func (m *QueryResourcesByAttributeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryResourcesByAttributeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryResourcesByAttributeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+}
+func (m *QueryResourcesByAttributeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryResourcesByAttributeRequest.Merge(m, src)
+}
+func (m *QueryResourcesByAttributeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryResourcesByAttributeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryResourcesByAttributeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryResourcesByAttributeRequest proto.InternalMessageInfo
+
+func (m *QueryResourcesByAttributeRequest) GetKey() string {
+	if m != nil {
+		return m.Key
 	}
-	return n
+	return ""
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *QueryResourcesByAttributeRequest) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
 }
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *QueryResourcesByAttributeRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryResourcesByAttributeResponse struct {
+	Resource   []Resource          `protobuf:"bytes,1,rep,name=Resource,proto3" json:"Resource"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryResourcesByAttributeResponse) Reset()         { *m = QueryResourcesByAttributeResponse{} }
+func (m *QueryResourcesByAttributeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryResourcesByAttributeResponse) ProtoMessage()    {}
+func (*QueryResourcesByAttributeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f2383a33128a245, []int{15}
+}
+func (m *QueryResourcesByAttributeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryResourcesByAttributeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryResourcesByAttributeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryResourcesByAttributeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryResourcesByAttributeResponse.Merge(m, src)
+}
+func (m *QueryResourcesByAttributeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryResourcesByAttributeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryResourcesByAttributeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryResourcesByAttributeResponse proto.InternalMessageInfo
+
+func (m *QueryResourcesByAttributeResponse) GetResource() []Resource {
+	if m != nil {
+		return m.Resource
+	}
+	return nil
+}
+
+func (m *QueryResourcesByAttributeResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryResourcesByTagRequest struct {
+	Tag        string             `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryResourcesByTagRequest) Reset()         { *m = QueryResourcesByTagRequest{} }
+func (m *QueryResourcesByTagRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryResourcesByTagRequest) ProtoMessage()    {}
+func (*QueryResourcesByTagRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f2383a33128a245, []int{16}
+}
+func (m *QueryResourcesByTagRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryResourcesByTagRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryResourcesByTagRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryResourcesByTagRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryResourcesByTagRequest.Merge(m, src)
+}
+func (m *QueryResourcesByTagRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryResourcesByTagRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryResourcesByTagRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryResourcesByTagRequest proto.InternalMessageInfo
+
+func (m *QueryResourcesByTagRequest) GetTag() string {
+	if m != nil {
+		return m.Tag
+	}
+	return ""
+}
+
+func (m *QueryResourcesByTagRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryResourcesByTagResponse struct {
+	Resource   []Resource          `protobuf:"bytes,1,rep,name=Resource,proto3" json:"Resource"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryResourcesByTagResponse) Reset()         { *m = QueryResourcesByTagResponse{} }
+func (m *QueryResourcesByTagResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryResourcesByTagResponse) ProtoMessage()    {}
+func (*QueryResourcesByTagResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f2383a33128a245, []int{17}
+}
+func (m *QueryResourcesByTagResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryResourcesByTagResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryResourcesByTagResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryResourcesByTagResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryResourcesByTagResponse.Merge(m, src)
+}
+func (m *QueryResourcesByTagResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryResourcesByTagResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryResourcesByTagResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryResourcesByTagResponse proto.InternalMessageInfo
+
+func (m *QueryResourcesByTagResponse) GetResource() []Resource {
+	if m != nil {
+		return m.Resource
+	}
+	return nil
+}
+
+func (m *QueryResourcesByTagResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryGetResourceTransferOfferRequest struct {
+	ResourceId uint64 `protobuf:"varint,1,opt,name=resourceId,proto3" json:"resourceId,omitempty"`
+}
+
+func (m *QueryGetResourceTransferOfferRequest) Reset()         { *m = QueryGetResourceTransferOfferRequest{} }
+func (m *QueryGetResourceTransferOfferRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetResourceTransferOfferRequest) ProtoMessage()    {}
+func (*QueryGetResourceTransferOfferRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f2383a33128a245, []int{18}
+}
+func (m *QueryGetResourceTransferOfferRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetResourceTransferOfferRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetResourceTransferOfferRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetResourceTransferOfferRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetResourceTransferOfferRequest.Merge(m, src)
+}
+func (m *QueryGetResourceTransferOfferRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetResourceTransferOfferRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetResourceTransferOfferRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetResourceTransferOfferRequest proto.InternalMessageInfo
+
+func (m *QueryGetResourceTransferOfferRequest) GetResourceId() uint64 {
+	if m != nil {
+		return m.ResourceId
+	}
+	return 0
+}
+
+type QueryGetResourceTransferOfferResponse struct {
+	ResourceTransferOffer ResourceTransferOffer `protobuf:"bytes,1,opt,name=resourceTransferOffer,proto3" json:"resourceTransferOffer"`
+}
+
+func (m *QueryGetResourceTransferOfferResponse) Reset()         { *m = QueryGetResourceTransferOfferResponse{} }
+func (m *QueryGetResourceTransferOfferResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetResourceTransferOfferResponse) ProtoMessage()    {}
+func (*QueryGetResourceTransferOfferResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f2383a33128a245, []int{19}
+}
+func (m *QueryGetResourceTransferOfferResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetResourceTransferOfferResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetResourceTransferOfferResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetResourceTransferOfferResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetResourceTransferOfferResponse.Merge(m, src)
+}
+func (m *QueryGetResourceTransferOfferResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetResourceTransferOfferResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetResourceTransferOfferResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetResourceTransferOfferResponse proto.InternalMessageInfo
+
+func (m *QueryGetResourceTransferOfferResponse) GetResourceTransferOffer() ResourceTransferOffer {
+	if m != nil {
+		return m.ResourceTransferOffer
+	}
+	return ResourceTransferOffer{}
+}
+
+type QueryAllResourceTransferOfferRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllResourceTransferOfferRequest) Reset()         { *m = QueryAllResourceTransferOfferRequest{} }
+func (m *QueryAllResourceTransferOfferRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllResourceTransferOfferRequest) ProtoMessage()    {}
+func (*QueryAllResourceTransferOfferRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f2383a33128a245, []int{20}
+}
+func (m *QueryAllResourceTransferOfferRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllResourceTransferOfferRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllResourceTransferOfferRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllResourceTransferOfferRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllResourceTransferOfferRequest.Merge(m, src)
+}
+func (m *QueryAllResourceTransferOfferRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllResourceTransferOfferRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllResourceTransferOfferRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllResourceTransferOfferRequest proto.InternalMessageInfo
+
+func (m *QueryAllResourceTransferOfferRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryAllResourceTransferOfferResponse struct {
+	ResourceTransferOffer []ResourceTransferOffer `protobuf:"bytes,1,rep,name=resourceTransferOffer,proto3" json:"resourceTransferOffer"`
+	Pagination            *query.PageResponse     `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllResourceTransferOfferResponse) Reset()         { *m = QueryAllResourceTransferOfferResponse{} }
+func (m *QueryAllResourceTransferOfferResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllResourceTransferOfferResponse) ProtoMessage()    {}
+func (*QueryAllResourceTransferOfferResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f2383a33128a245, []int{21}
+}
+func (m *QueryAllResourceTransferOfferResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllResourceTransferOfferResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllResourceTransferOfferResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllResourceTransferOfferResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllResourceTransferOfferResponse.Merge(m, src)
+}
+func (m *QueryAllResourceTransferOfferResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllResourceTransferOfferResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllResourceTransferOfferResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllResourceTransferOfferResponse proto.InternalMessageInfo
+
+func (m *QueryAllResourceTransferOfferResponse) GetResourceTransferOffer() []ResourceTransferOffer {
+	if m != nil {
+		return m.ResourceTransferOffer
+	}
+	return nil
+}
+
+func (m *QueryAllResourceTransferOfferResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryResourceRolesRequest struct {
+	Id         uint64             `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryResourceRolesRequest) Reset()         { *m = QueryResourceRolesRequest{} }
+func (m *QueryResourceRolesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryResourceRolesRequest) ProtoMessage()    {}
+func (*QueryResourceRolesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f2383a33128a245, []int{22}
+}
+func (m *QueryResourceRolesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryResourceRolesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryResourceRolesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryResourceRolesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryResourceRolesRequest.Merge(m, src)
+}
+func (m *QueryResourceRolesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryResourceRolesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryResourceRolesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryResourceRolesRequest proto.InternalMessageInfo
+
+func (m *QueryResourceRolesRequest) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *QueryResourceRolesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryResourceRolesResponse struct {
+	// owner holds RESOURCE_ROLE_OWNER implicitly and is not part of grants.
+	Owner      string              `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	Grants     []ResourceRoleGrant `protobuf:"bytes,2,rep,name=grants,proto3" json:"grants"`
+	Pagination *query.PageResponse `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryResourceRolesResponse) Reset()         { *m = QueryResourceRolesResponse{} }
+func (m *QueryResourceRolesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryResourceRolesResponse) ProtoMessage()    {}
+func (*QueryResourceRolesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f2383a33128a245, []int{23}
+}
+func (m *QueryResourceRolesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryResourceRolesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryResourceRolesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryResourceRolesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryResourceRolesResponse.Merge(m, src)
+}
+func (m *QueryResourceRolesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryResourceRolesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryResourceRolesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryResourceRolesResponse proto.InternalMessageInfo
+
+func (m *QueryResourceRolesResponse) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *QueryResourceRolesResponse) GetGrants() []ResourceRoleGrant {
+	if m != nil {
+		return m.Grants
+	}
+	return nil
+}
+
+func (m *QueryResourceRolesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryEditableResourcesRequest struct {
+	Address    string             `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryEditableResourcesRequest) Reset()         { *m = QueryEditableResourcesRequest{} }
+func (m *QueryEditableResourcesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEditableResourcesRequest) ProtoMessage()    {}
+func (*QueryEditableResourcesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f2383a33128a245, []int{24}
+}
+func (m *QueryEditableResourcesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEditableResourcesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEditableResourcesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEditableResourcesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEditableResourcesRequest.Merge(m, src)
+}
+func (m *QueryEditableResourcesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEditableResourcesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEditableResourcesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEditableResourcesRequest proto.InternalMessageInfo
+
+func (m *QueryEditableResourcesRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *QueryEditableResourcesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryEditableResourcesResponse struct {
+	Grants     []ResourceRoleGrant `protobuf:"bytes,1,rep,name=grants,proto3" json:"grants"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryEditableResourcesResponse) Reset()         { *m = QueryEditableResourcesResponse{} }
+func (m *QueryEditableResourcesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEditableResourcesResponse) ProtoMessage()    {}
+func (*QueryEditableResourcesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f2383a33128a245, []int{25}
+}
+func (m *QueryEditableResourcesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEditableResourcesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEditableResourcesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEditableResourcesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEditableResourcesResponse.Merge(m, src)
+}
+func (m *QueryEditableResourcesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEditableResourcesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEditableResourcesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEditableResourcesResponse proto.InternalMessageInfo
+
+func (m *QueryEditableResourcesResponse) GetGrants() []ResourceRoleGrant {
+	if m != nil {
+		return m.Grants
+	}
+	return nil
+}
+
+func (m *QueryEditableResourcesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryGetResourceSchemaRequest struct {
+	Id      string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Version uint64 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+}
+
+func (m *QueryGetResourceSchemaRequest) Reset()         { *m = QueryGetResourceSchemaRequest{} }
+func (m *QueryGetResourceSchemaRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetResourceSchemaRequest) ProtoMessage()    {}
+func (*QueryGetResourceSchemaRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f2383a33128a245, []int{26}
+}
+func (m *QueryGetResourceSchemaRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetResourceSchemaRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetResourceSchemaRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetResourceSchemaRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetResourceSchemaRequest.Merge(m, src)
+}
+func (m *QueryGetResourceSchemaRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetResourceSchemaRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetResourceSchemaRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetResourceSchemaRequest proto.InternalMessageInfo
+
+func (m *QueryGetResourceSchemaRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *QueryGetResourceSchemaRequest) GetVersion() uint64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+type QueryGetResourceSchemaResponse struct {
+	ResourceSchema ResourceSchema `protobuf:"bytes,1,opt,name=resourceSchema,proto3" json:"resourceSchema"`
+}
+
+func (m *QueryGetResourceSchemaResponse) Reset()         { *m = QueryGetResourceSchemaResponse{} }
+func (m *QueryGetResourceSchemaResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetResourceSchemaResponse) ProtoMessage()    {}
+func (*QueryGetResourceSchemaResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f2383a33128a245, []int{27}
+}
+func (m *QueryGetResourceSchemaResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetResourceSchemaResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetResourceSchemaResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetResourceSchemaResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetResourceSchemaResponse.Merge(m, src)
+}
+func (m *QueryGetResourceSchemaResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetResourceSchemaResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetResourceSchemaResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetResourceSchemaResponse proto.InternalMessageInfo
+
+func (m *QueryGetResourceSchemaResponse) GetResourceSchema() ResourceSchema {
+	if m != nil {
+		return m.ResourceSchema
+	}
+	return ResourceSchema{}
+}
+
+type QueryAllResourceSchemaRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllResourceSchemaRequest) Reset()         { *m = QueryAllResourceSchemaRequest{} }
+func (m *QueryAllResourceSchemaRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllResourceSchemaRequest) ProtoMessage()    {}
+func (*QueryAllResourceSchemaRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f2383a33128a245, []int{28}
+}
+func (m *QueryAllResourceSchemaRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllResourceSchemaRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllResourceSchemaRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllResourceSchemaRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllResourceSchemaRequest.Merge(m, src)
+}
+func (m *QueryAllResourceSchemaRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllResourceSchemaRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllResourceSchemaRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllResourceSchemaRequest proto.InternalMessageInfo
+
+func (m *QueryAllResourceSchemaRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryAllResourceSchemaResponse struct {
+	ResourceSchema []ResourceSchema    `protobuf:"bytes,1,rep,name=resourceSchema,proto3" json:"resourceSchema"`
+	Pagination     *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllResourceSchemaResponse) Reset()         { *m = QueryAllResourceSchemaResponse{} }
+func (m *QueryAllResourceSchemaResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllResourceSchemaResponse) ProtoMessage()    {}
+func (*QueryAllResourceSchemaResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f2383a33128a245, []int{29}
+}
+func (m *QueryAllResourceSchemaResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllResourceSchemaResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllResourceSchemaResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllResourceSchemaResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllResourceSchemaResponse.Merge(m, src)
+}
+func (m *QueryAllResourceSchemaResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllResourceSchemaResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllResourceSchemaResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllResourceSchemaResponse proto.InternalMessageInfo
+
+func (m *QueryAllResourceSchemaResponse) GetResourceSchema() []ResourceSchema {
+	if m != nil {
+		return m.ResourceSchema
+	}
+	return nil
+}
+
+func (m *QueryAllResourceSchemaResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryGetNamespaceRequest struct {
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (m *QueryGetNamespaceRequest) Reset()         { *m = QueryGetNamespaceRequest{} }
+func (m *QueryGetNamespaceRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetNamespaceRequest) ProtoMessage()    {}
+func (*QueryGetNamespaceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f2383a33128a245, []int{30}
+}
+func (m *QueryGetNamespaceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetNamespaceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetNamespaceRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetNamespaceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetNamespaceRequest.Merge(m, src)
+}
+func (m *QueryGetNamespaceRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetNamespaceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetNamespaceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetNamespaceRequest proto.InternalMessageInfo
+
+func (m *QueryGetNamespaceRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+type QueryGetNamespaceResponse struct {
+	Namespace Namespace `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace"`
+}
+
+func (m *QueryGetNamespaceResponse) Reset()         { *m = QueryGetNamespaceResponse{} }
+func (m *QueryGetNamespaceResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetNamespaceResponse) ProtoMessage()    {}
+func (*QueryGetNamespaceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f2383a33128a245, []int{31}
+}
+func (m *QueryGetNamespaceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetNamespaceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetNamespaceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetNamespaceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetNamespaceResponse.Merge(m, src)
+}
+func (m *QueryGetNamespaceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetNamespaceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetNamespaceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetNamespaceResponse proto.InternalMessageInfo
+
+func (m *QueryGetNamespaceResponse) GetNamespace() Namespace {
+	if m != nil {
+		return m.Namespace
+	}
+	return Namespace{}
+}
+
+type QueryAllNamespaceRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllNamespaceRequest) Reset()         { *m = QueryAllNamespaceRequest{} }
+func (m *QueryAllNamespaceRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllNamespaceRequest) ProtoMessage()    {}
+func (*QueryAllNamespaceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f2383a33128a245, []int{32}
+}
+func (m *QueryAllNamespaceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllNamespaceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllNamespaceRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllNamespaceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllNamespaceRequest.Merge(m, src)
+}
+func (m *QueryAllNamespaceRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllNamespaceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllNamespaceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllNamespaceRequest proto.InternalMessageInfo
+
+func (m *QueryAllNamespaceRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryAllNamespaceResponse struct {
+	Namespace  []Namespace         `protobuf:"bytes,1,rep,name=namespace,proto3" json:"namespace"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllNamespaceResponse) Reset()         { *m = QueryAllNamespaceResponse{} }
+func (m *QueryAllNamespaceResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllNamespaceResponse) ProtoMessage()    {}
+func (*QueryAllNamespaceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f2383a33128a245, []int{33}
+}
+func (m *QueryAllNamespaceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllNamespaceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllNamespaceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllNamespaceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllNamespaceResponse.Merge(m, src)
+}
+func (m *QueryAllNamespaceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllNamespaceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllNamespaceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllNamespaceResponse proto.InternalMessageInfo
+
+func (m *QueryAllNamespaceResponse) GetNamespace() []Namespace {
+	if m != nil {
+		return m.Namespace
+	}
+	return nil
+}
+
+func (m *QueryAllNamespaceResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryResourcesInNamespaceRequest struct {
+	Namespace  string             `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryResourcesInNamespaceRequest) Reset()         { *m = QueryResourcesInNamespaceRequest{} }
+func (m *QueryResourcesInNamespaceRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryResourcesInNamespaceRequest) ProtoMessage()    {}
+func (*QueryResourcesInNamespaceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f2383a33128a245, []int{34}
+}
+func (m *QueryResourcesInNamespaceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryResourcesInNamespaceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryResourcesInNamespaceRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryResourcesInNamespaceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryResourcesInNamespaceRequest.Merge(m, src)
+}
+func (m *QueryResourcesInNamespaceRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryResourcesInNamespaceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryResourcesInNamespaceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryResourcesInNamespaceRequest proto.InternalMessageInfo
+
+func (m *QueryResourcesInNamespaceRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+func (m *QueryResourcesInNamespaceRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryResourcesInNamespaceResponse struct {
+	Resource   []Resource          `protobuf:"bytes,1,rep,name=Resource,proto3" json:"Resource"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryResourcesInNamespaceResponse) Reset()         { *m = QueryResourcesInNamespaceResponse{} }
+func (m *QueryResourcesInNamespaceResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryResourcesInNamespaceResponse) ProtoMessage()    {}
+func (*QueryResourcesInNamespaceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f2383a33128a245, []int{35}
+}
+func (m *QueryResourcesInNamespaceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryResourcesInNamespaceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryResourcesInNamespaceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryResourcesInNamespaceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryResourcesInNamespaceResponse.Merge(m, src)
+}
+func (m *QueryResourcesInNamespaceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryResourcesInNamespaceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryResourcesInNamespaceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryResourcesInNamespaceResponse proto.InternalMessageInfo
+
+func (m *QueryResourcesInNamespaceResponse) GetResource() []Resource {
+	if m != nil {
+		return m.Resource
+	}
+	return nil
+}
+
+func (m *QueryResourcesInNamespaceResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryResourceByNameRequest struct {
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (m *QueryResourceByNameRequest) Reset()         { *m = QueryResourceByNameRequest{} }
+func (m *QueryResourceByNameRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryResourceByNameRequest) ProtoMessage()    {}
+func (*QueryResourceByNameRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f2383a33128a245, []int{36}
+}
+func (m *QueryResourceByNameRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryResourceByNameRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryResourceByNameRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryResourceByNameRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryResourceByNameRequest.Merge(m, src)
+}
+func (m *QueryResourceByNameRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryResourceByNameRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryResourceByNameRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryResourceByNameRequest proto.InternalMessageInfo
+
+func (m *QueryResourceByNameRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+type QueryResourceByNameResponse struct {
+	Resource Resource `protobuf:"bytes,1,opt,name=resource,proto3" json:"resource"`
+}
+
+func (m *QueryResourceByNameResponse) Reset()         { *m = QueryResourceByNameResponse{} }
+func (m *QueryResourceByNameResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryResourceByNameResponse) ProtoMessage()    {}
+func (*QueryResourceByNameResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f2383a33128a245, []int{37}
+}
+func (m *QueryResourceByNameResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryResourceByNameResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryResourceByNameResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryResourceByNameResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryResourceByNameResponse.Merge(m, src)
+}
+func (m *QueryResourceByNameResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryResourceByNameResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryResourceByNameResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryResourceByNameResponse proto.InternalMessageInfo
+
+func (m *QueryResourceByNameResponse) GetResource() Resource {
+	if m != nil {
+		return m.Resource
+	}
+	return Resource{}
+}
+
+type QueryResourcesByNamePrefixRequest struct {
+	Prefix     string             `protobuf:"bytes,1,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryResourcesByNamePrefixRequest) Reset()         { *m = QueryResourcesByNamePrefixRequest{} }
+func (m *QueryResourcesByNamePrefixRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryResourcesByNamePrefixRequest) ProtoMessage()    {}
+func (*QueryResourcesByNamePrefixRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f2383a33128a245, []int{38}
+}
+func (m *QueryResourcesByNamePrefixRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryResourcesByNamePrefixRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryResourcesByNamePrefixRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryResourcesByNamePrefixRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryResourcesByNamePrefixRequest.Merge(m, src)
+}
+func (m *QueryResourcesByNamePrefixRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryResourcesByNamePrefixRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryResourcesByNamePrefixRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryResourcesByNamePrefixRequest proto.InternalMessageInfo
+
+func (m *QueryResourcesByNamePrefixRequest) GetPrefix() string {
+	if m != nil {
+		return m.Prefix
+	}
+	return ""
+}
+
+func (m *QueryResourcesByNamePrefixRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryResourcesByNamePrefixResponse struct {
+	Resource   []Resource          `protobuf:"bytes,1,rep,name=Resource,proto3" json:"Resource"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryResourcesByNamePrefixResponse) Reset()         { *m = QueryResourcesByNamePrefixResponse{} }
+func (m *QueryResourcesByNamePrefixResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryResourcesByNamePrefixResponse) ProtoMessage()    {}
+func (*QueryResourcesByNamePrefixResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f2383a33128a245, []int{39}
+}
+func (m *QueryResourcesByNamePrefixResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryResourcesByNamePrefixResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryResourcesByNamePrefixResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryResourcesByNamePrefixResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryResourcesByNamePrefixResponse.Merge(m, src)
+}
+func (m *QueryResourcesByNamePrefixResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryResourcesByNamePrefixResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryResourcesByNamePrefixResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryResourcesByNamePrefixResponse proto.InternalMessageInfo
+
+func (m *QueryResourcesByNamePrefixResponse) GetResource() []Resource {
+	if m != nil {
+		return m.Resource
+	}
+	return nil
+}
+
+func (m *QueryResourcesByNamePrefixResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryResourceStatsRequest struct {
+	// top is the number of creators to rank, 10 if unset and at most 100.
+	Top uint32 `protobuf:"varint,1,opt,name=top,proto3" json:"top,omitempty"`
+}
+
+func (m *QueryResourceStatsRequest) Reset()         { *m = QueryResourceStatsRequest{} }
+func (m *QueryResourceStatsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryResourceStatsRequest) ProtoMessage()    {}
+func (*QueryResourceStatsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f2383a33128a245, []int{40}
+}
+func (m *QueryResourceStatsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryResourceStatsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryResourceStatsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryResourceStatsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryResourceStatsRequest.Merge(m, src)
+}
+func (m *QueryResourceStatsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryResourceStatsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryResourceStatsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryResourceStatsRequest proto.InternalMessageInfo
+
+func (m *QueryResourceStatsRequest) GetTop() uint32 {
+	if m != nil {
+		return m.Top
+	}
+	return 0
+}
+
+type QueryResourceStatsResponse struct {
+	// liveCount is the number of stored resources, unlike the resource count
+	// which also covers deleted ones.
+	LiveCount    uint64 `protobuf:"varint,1,opt,name=liveCount,proto3" json:"liveCount,omitempty"`
+	CreatorCount uint64 `protobuf:"varint,2,opt,name=creatorCount,proto3" json:"creatorCount,omitempty"`
+	// valueCount is the number of resources holding a uint64 payload, over
+	// which the sum, min and max are taken. min and max are zero if there are
+	// none.
+	ValueCount uint64                `protobuf:"varint,3,opt,name=valueCount,proto3" json:"valueCount,omitempty"`
+	ValueSum   cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=valueSum,proto3,customtype=cosmossdk.io/math.Int" json:"valueSum"`
+	ValueMin   uint64                `protobuf:"varint,5,opt,name=valueMin,proto3" json:"valueMin,omitempty"`
+	ValueMax   uint64                `protobuf:"varint,6,opt,name=valueMax,proto3" json:"valueMax,omitempty"`
+	// topCreators are ordered by decreasing resource count, then by address.
+	TopCreators []CreatorResourceCount `protobuf:"bytes,7,rep,name=topCreators,proto3" json:"topCreators"`
+}
+
+func (m *QueryResourceStatsResponse) Reset()         { *m = QueryResourceStatsResponse{} }
+func (m *QueryResourceStatsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryResourceStatsResponse) ProtoMessage()    {}
+func (*QueryResourceStatsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f2383a33128a245, []int{41}
+}
+func (m *QueryResourceStatsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryResourceStatsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryResourceStatsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryResourceStatsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryResourceStatsResponse.Merge(m, src)
+}
+func (m *QueryResourceStatsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryResourceStatsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryResourceStatsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryResourceStatsResponse proto.InternalMessageInfo
+
+func (m *QueryResourceStatsResponse) GetLiveCount() uint64 {
+	if m != nil {
+		return m.LiveCount
+	}
+	return 0
+}
+
+func (m *QueryResourceStatsResponse) GetCreatorCount() uint64 {
+	if m != nil {
+		return m.CreatorCount
+	}
+	return 0
+}
+
+func (m *QueryResourceStatsResponse) GetValueCount() uint64 {
+	if m != nil {
+		return m.ValueCount
+	}
+	return 0
+}
+
+func (m *QueryResourceStatsResponse) GetValueMin() uint64 {
+	if m != nil {
+		return m.ValueMin
+	}
+	return 0
+}
+
+func (m *QueryResourceStatsResponse) GetValueMax() uint64 {
+	if m != nil {
+		return m.ValueMax
+	}
+	return 0
+}
+
+func (m *QueryResourceStatsResponse) GetTopCreators() []CreatorResourceCount {
+	if m != nil {
+		return m.TopCreators
+	}
+	return nil
+}
+
+type QueryResourceWithProofRequest struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *QueryResourceWithProofRequest) Reset()         { *m = QueryResourceWithProofRequest{} }
+func (m *QueryResourceWithProofRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryResourceWithProofRequest) ProtoMessage()    {}
+func (*QueryResourceWithProofRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f2383a33128a245, []int{42}
+}
+func (m *QueryResourceWithProofRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryResourceWithProofRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryResourceWithProofRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryResourceWithProofRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryResourceWithProofRequest.Merge(m, src)
+}
+func (m *QueryResourceWithProofRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryResourceWithProofRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryResourceWithProofRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryResourceWithProofRequest proto.InternalMessageInfo
+
+func (m *QueryResourceWithProofRequest) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+type QueryResourceWithProofResponse struct {
+	// resource is unset if no resource has the id at the queried height.
+	Resource *Resource `protobuf:"bytes,1,opt,name=resource,proto3" json:"resource,omitempty"`
+	// key is the key of the resource in the crude store.
+	Key []byte `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	// value is the stored encoding of the resource, empty if it is absent.
+	Value []byte `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	// proof chains the key to the app hash through the crude IAVL tree then the
+	// multistore. It proves the value if one is set and the absence of the key
+	// otherwise.
+	Proof *crypto.ProofOps `protobuf:"bytes,4,opt,name=proof,proto3" json:"proof,omitempty"`
+	// height is the version of the store the proof was taken from. It verifies
+	// against the app hash of the block header at height + 1.
+	Height int64 `protobuf:"varint,5,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *QueryResourceWithProofResponse) Reset()         { *m = QueryResourceWithProofResponse{} }
+func (m *QueryResourceWithProofResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryResourceWithProofResponse) ProtoMessage()    {}
+func (*QueryResourceWithProofResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f2383a33128a245, []int{43}
+}
+func (m *QueryResourceWithProofResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryResourceWithProofResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryResourceWithProofResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryResourceWithProofResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryResourceWithProofResponse.Merge(m, src)
+}
+func (m *QueryResourceWithProofResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryResourceWithProofResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryResourceWithProofResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryResourceWithProofResponse proto.InternalMessageInfo
+
+func (m *QueryResourceWithProofResponse) GetResource() *Resource {
+	if m != nil {
+		return m.Resource
+	}
+	return nil
+}
+
+func (m *QueryResourceWithProofResponse) GetKey() []byte {
+	if m != nil {
+		return m.Key
+	}
+	return nil
+}
+
+func (m *QueryResourceWithProofResponse) GetValue() []byte {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+func (m *QueryResourceWithProofResponse) GetProof() *crypto.ProofOps {
+	if m != nil {
+		return m.Proof
+	}
+	return nil
+}
+
+func (m *QueryResourceWithProofResponse) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+type QueryResourceReplicationsRequest struct {
+	Id         uint64             `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryResourceReplicationsRequest) Reset()         { *m = QueryResourceReplicationsRequest{} }
+func (m *QueryResourceReplicationsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryResourceReplicationsRequest) ProtoMessage()    {}
+func (*QueryResourceReplicationsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f2383a33128a245, []int{44}
+}
+func (m *QueryResourceReplicationsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryResourceReplicationsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryResourceReplicationsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryResourceReplicationsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryResourceReplicationsRequest.Merge(m, src)
+}
+func (m *QueryResourceReplicationsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryResourceReplicationsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryResourceReplicationsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryResourceReplicationsRequest proto.InternalMessageInfo
+
+func (m *QueryResourceReplicationsRequest) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *QueryResourceReplicationsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryResourceReplicationsResponse struct {
+	Replication []ResourceReplication `protobuf:"bytes,1,rep,name=replication,proto3" json:"replication"`
+	Pagination  *query.PageResponse   `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryResourceReplicationsResponse) Reset()         { *m = QueryResourceReplicationsResponse{} }
+func (m *QueryResourceReplicationsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryResourceReplicationsResponse) ProtoMessage()    {}
+func (*QueryResourceReplicationsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f2383a33128a245, []int{45}
+}
+func (m *QueryResourceReplicationsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryResourceReplicationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryResourceReplicationsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryResourceReplicationsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryResourceReplicationsResponse.Merge(m, src)
+}
+func (m *QueryResourceReplicationsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryResourceReplicationsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryResourceReplicationsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryResourceReplicationsResponse proto.InternalMessageInfo
+
+func (m *QueryResourceReplicationsResponse) GetReplication() []ResourceReplication {
+	if m != nil {
+		return m.Replication
+	}
+	return nil
+}
+
+func (m *QueryResourceReplicationsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryResourceReplicaRequest struct {
+	ChannelId string `protobuf:"bytes,1,opt,name=channelId,proto3" json:"channelId,omitempty"`
+	SourceId  uint64 `protobuf:"varint,2,opt,name=sourceId,proto3" json:"sourceId,omitempty"`
+}
+
+func (m *QueryResourceReplicaRequest) Reset()         { *m = QueryResourceReplicaRequest{} }
+func (m *QueryResourceReplicaRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryResourceReplicaRequest) ProtoMessage()    {}
+func (*QueryResourceReplicaRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f2383a33128a245, []int{46}
+}
+func (m *QueryResourceReplicaRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryResourceReplicaRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryResourceReplicaRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryResourceReplicaRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryResourceReplicaRequest.Merge(m, src)
+}
+func (m *QueryResourceReplicaRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryResourceReplicaRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryResourceReplicaRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryResourceReplicaRequest proto.InternalMessageInfo
+
+func (m *QueryResourceReplicaRequest) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *QueryResourceReplicaRequest) GetSourceId() uint64 {
+	if m != nil {
+		return m.SourceId
+	}
+	return 0
+}
+
+type QueryResourceReplicaResponse struct {
+	Replica  ResourceReplica `protobuf:"bytes,1,opt,name=replica,proto3" json:"replica"`
+	Resource Resource        `protobuf:"bytes,2,opt,name=resource,proto3" json:"resource"`
+}
+
+func (m *QueryResourceReplicaResponse) Reset()         { *m = QueryResourceReplicaResponse{} }
+func (m *QueryResourceReplicaResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryResourceReplicaResponse) ProtoMessage()    {}
+func (*QueryResourceReplicaResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f2383a33128a245, []int{47}
+}
+func (m *QueryResourceReplicaResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryResourceReplicaResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryResourceReplicaResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryResourceReplicaResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryResourceReplicaResponse.Merge(m, src)
+}
+func (m *QueryResourceReplicaResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryResourceReplicaResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryResourceReplicaResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryResourceReplicaResponse proto.InternalMessageInfo
+
+func (m *QueryResourceReplicaResponse) GetReplica() ResourceReplica {
+	if m != nil {
+		return m.Replica
+	}
+	return ResourceReplica{}
+}
+
+func (m *QueryResourceReplicaResponse) GetResource() Resource {
+	if m != nil {
+		return m.Resource
+	}
+	return Resource{}
+}
+
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "crude.crude.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "crude.crude.QueryParamsResponse")
+	proto.RegisterType((*QueryGetResourceRequest)(nil), "crude.crude.QueryGetResourceRequest")
+	proto.RegisterType((*QueryGetResourceResponse)(nil), "crude.crude.QueryGetResourceResponse")
+	proto.RegisterType((*QueryAllResourceRequest)(nil), "crude.crude.QueryAllResourceRequest")
+	proto.RegisterType((*QueryAllResourceResponse)(nil), "crude.crude.QueryAllResourceResponse")
+	proto.RegisterType((*QueryResourcesByCreatorRequest)(nil), "crude.crude.QueryResourcesByCreatorRequest")
+	proto.RegisterType((*QueryResourcesByCreatorResponse)(nil), "crude.crude.QueryResourcesByCreatorResponse")
+	proto.RegisterType((*QueryResourceHistoryRequest)(nil), "crude.crude.QueryResourceHistoryRequest")
+	proto.RegisterType((*QueryResourceHistoryResponse)(nil), "crude.crude.QueryResourceHistoryResponse")
+	proto.RegisterType((*QueryResourceAtRevisionRequest)(nil), "crude.crude.QueryResourceAtRevisionRequest")
+	proto.RegisterType((*QueryResourceAtRevisionResponse)(nil), "crude.crude.QueryResourceAtRevisionResponse")
+	proto.RegisterType((*QueryResourcesByOwnerRequest)(nil), "crude.crude.QueryResourcesByOwnerRequest")
+	proto.RegisterType((*QueryResourcesByOwnerResponse)(nil), "crude.crude.QueryResourcesByOwnerResponse")
+	proto.RegisterType((*QueryResourcesByAttributeRequest)(nil), "crude.crude.QueryResourcesByAttributeRequest")
+	proto.RegisterType((*QueryResourcesByAttributeResponse)(nil), "crude.crude.QueryResourcesByAttributeResponse")
+	proto.RegisterType((*QueryResourcesByTagRequest)(nil), "crude.crude.QueryResourcesByTagRequest")
+	proto.RegisterType((*QueryResourcesByTagResponse)(nil), "crude.crude.QueryResourcesByTagResponse")
+	proto.RegisterType((*QueryGetResourceTransferOfferRequest)(nil), "crude.crude.QueryGetResourceTransferOfferRequest")
+	proto.RegisterType((*QueryGetResourceTransferOfferResponse)(nil), "crude.crude.QueryGetResourceTransferOfferResponse")
+	proto.RegisterType((*QueryAllResourceTransferOfferRequest)(nil), "crude.crude.QueryAllResourceTransferOfferRequest")
+	proto.RegisterType((*QueryAllResourceTransferOfferResponse)(nil), "crude.crude.QueryAllResourceTransferOfferResponse")
+	proto.RegisterType((*QueryResourceRolesRequest)(nil), "crude.crude.QueryResourceRolesRequest")
+	proto.RegisterType((*QueryResourceRolesResponse)(nil), "crude.crude.QueryResourceRolesResponse")
+	proto.RegisterType((*QueryEditableResourcesRequest)(nil), "crude.crude.QueryEditableResourcesRequest")
+	proto.RegisterType((*QueryEditableResourcesResponse)(nil), "crude.crude.QueryEditableResourcesResponse")
+	proto.RegisterType((*QueryGetResourceSchemaRequest)(nil), "crude.crude.QueryGetResourceSchemaRequest")
+	proto.RegisterType((*QueryGetResourceSchemaResponse)(nil), "crude.crude.QueryGetResourceSchemaResponse")
+	proto.RegisterType((*QueryAllResourceSchemaRequest)(nil), "crude.crude.QueryAllResourceSchemaRequest")
+	proto.RegisterType((*QueryAllResourceSchemaResponse)(nil), "crude.crude.QueryAllResourceSchemaResponse")
+	proto.RegisterType((*QueryGetNamespaceRequest)(nil), "crude.crude.QueryGetNamespaceRequest")
+	proto.RegisterType((*QueryGetNamespaceResponse)(nil), "crude.crude.QueryGetNamespaceResponse")
+	proto.RegisterType((*QueryAllNamespaceRequest)(nil), "crude.crude.QueryAllNamespaceRequest")
+	proto.RegisterType((*QueryAllNamespaceResponse)(nil), "crude.crude.QueryAllNamespaceResponse")
+	proto.RegisterType((*QueryResourcesInNamespaceRequest)(nil), "crude.crude.QueryResourcesInNamespaceRequest")
+	proto.RegisterType((*QueryResourcesInNamespaceResponse)(nil), "crude.crude.QueryResourcesInNamespaceResponse")
+	proto.RegisterType((*QueryResourceByNameRequest)(nil), "crude.crude.QueryResourceByNameRequest")
+	proto.RegisterType((*QueryResourceByNameResponse)(nil), "crude.crude.QueryResourceByNameResponse")
+	proto.RegisterType((*QueryResourcesByNamePrefixRequest)(nil), "crude.crude.QueryResourcesByNamePrefixRequest")
+	proto.RegisterType((*QueryResourcesByNamePrefixResponse)(nil), "crude.crude.QueryResourcesByNamePrefixResponse")
+	proto.RegisterType((*QueryResourceStatsRequest)(nil), "crude.crude.QueryResourceStatsRequest")
+	proto.RegisterType((*QueryResourceStatsResponse)(nil), "crude.crude.QueryResourceStatsResponse")
+	proto.RegisterType((*QueryResourceWithProofRequest)(nil), "crude.crude.QueryResourceWithProofRequest")
+	proto.RegisterType((*QueryResourceWithProofResponse)(nil), "crude.crude.QueryResourceWithProofResponse")
+	proto.RegisterType((*QueryResourceReplicationsRequest)(nil), "crude.crude.QueryResourceReplicationsRequest")
+	proto.RegisterType((*QueryResourceReplicationsResponse)(nil), "crude.crude.QueryResourceReplicationsResponse")
+	proto.RegisterType((*QueryResourceReplicaRequest)(nil), "crude.crude.QueryResourceReplicaRequest")
+	proto.RegisterType((*QueryResourceReplicaResponse)(nil), "crude.crude.QueryResourceReplicaResponse")
+}
+
+func init() { proto.RegisterFile("crude/crude/query.proto", fileDescriptor_5f2383a33128a245) }
+
+var fileDescriptor_5f2383a33128a245 = []byte{
+	// 2251 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x5a, 0xef, 0x6f, 0x1c, 0x47,
+	0x19, 0xce, 0xfa, 0x67, 0xfc, 0x3a, 0x0d, 0xcd, 0xc4, 0x4e, 0x2e, 0x6b, 0xfb, 0xec, 0x6c, 0xec,
+	0xf8, 0x57, 0x7c, 0x5b, 0x27, 0x02, 0x04, 0x42, 0x82, 0x38, 0x22, 0xee, 0x49, 0xb4, 0x09, 0x9b,
+	0xaa, 0x91, 0xf8, 0x40, 0xb4, 0xbe, 0x1b, 0x9f, 0x57, 0x39, 0xef, 0x5e, 0x77, 0xf7, 0x4c, 0x8e,
+	0xe3, 0x3e, 0xb4, 0x08, 0x81, 0x10, 0x42, 0xa8, 0x08, 0xbe, 0x20, 0x95, 0x22, 0x44, 0x40, 0x55,
+	0x25, 0x10, 0x54, 0x48, 0xfd, 0x07, 0x50, 0x05, 0x5f, 0x22, 0xf8, 0x82, 0xf8, 0x50, 0xa1, 0x04,
+	0x89, 0x7f, 0xa3, 0xda, 0x99, 0x77, 0x77, 0x67, 0x77, 0xe7, 0xf6, 0xd6, 0xe9, 0x55, 0xf2, 0x17,
+	0x67, 0x67, 0xe6, 0x9d, 0x79, 0x9f, 0x79, 0x66, 0xe6, 0x9d, 0x77, 0x9e, 0x0b, 0x5c, 0xac, 0xb9,
+	0xed, 0x3a, 0xd5, 0xf9, 0xdf, 0x37, 0xda, 0xd4, 0xed, 0x54, 0x5a, 0xae, 0xe3, 0x3b, 0x64, 0x9a,
+	0x55, 0x55, 0xd8, 0x5f, 0xf5, 0x9c, 0x79, 0x68, 0xd9, 0x8e, 0xce, 0xfe, 0xf2, 0x76, 0xf5, 0x52,
+	0xcd, 0xf1, 0x0e, 0x1d, 0xef, 0x01, 0x2b, 0xe9, 0xbc, 0x80, 0x4d, 0x33, 0x0d, 0xa7, 0xe1, 0xf0,
+	0xfa, 0xe0, 0x0b, 0x6b, 0xe7, 0x1b, 0x8e, 0xd3, 0x68, 0x52, 0xdd, 0x6c, 0x59, 0xba, 0x69, 0xdb,
+	0x8e, 0x6f, 0xfa, 0x96, 0x63, 0x87, 0x7d, 0x16, 0x7c, 0x6a, 0xd7, 0xa9, 0x7b, 0x68, 0xd9, 0xbe,
+	0x5e, 0x73, 0x3b, 0x2d, 0xdf, 0xd1, 0x5b, 0xae, 0xe3, 0xec, 0x63, 0xf3, 0x06, 0x77, 0xa0, 0xef,
+	0x99, 0x1e, 0xc2, 0xd4, 0x8f, 0xb6, 0xf7, 0xa8, 0x6f, 0x6e, 0xeb, 0x2d, 0xb3, 0x61, 0xd9, 0x6c,
+	0x2c, 0xb4, 0x2d, 0x89, 0x53, 0x6a, 0x99, 0xae, 0x79, 0x18, 0x3a, 0x51, 0xc5, 0x16, 0x97, 0x7a,
+	0x4e, 0xdb, 0xad, 0x51, 0xde, 0xa6, 0xcd, 0x00, 0xf9, 0x66, 0x30, 0xee, 0x5d, 0xd6, 0xc1, 0xa0,
+	0x6f, 0xb4, 0xa9, 0xe7, 0x6b, 0xaf, 0xc0, 0xf9, 0x44, 0xad, 0xd7, 0x72, 0x6c, 0x8f, 0x92, 0x2f,
+	0xc0, 0x04, 0x1f, 0xb8, 0xa4, 0x2c, 0x29, 0x6b, 0xd3, 0xd7, 0xcf, 0x57, 0x04, 0xb6, 0x2a, 0xdc,
+	0x78, 0x67, 0xea, 0xa3, 0x8f, 0x17, 0x4f, 0xfd, 0xe1, 0xff, 0x7f, 0xda, 0x50, 0x0c, 0xb4, 0xd6,
+	0xd6, 0xe1, 0x22, 0x1b, 0x6e, 0x97, 0xfa, 0x06, 0xba, 0x47, 0x4f, 0xe4, 0x2c, 0x8c, 0x58, 0x75,
+	0x36, 0xdc, 0x98, 0x31, 0x62, 0xd5, 0xb5, 0x7b, 0x50, 0xca, 0x9a, 0xa2, 0xfb, 0x2f, 0xc2, 0xe9,
+	0xb0, 0x0e, 0x01, 0xcc, 0x26, 0x00, 0x84, 0x8d, 0x3b, 0x63, 0x01, 0x04, 0x23, 0x32, 0xd6, 0xfe,
+	0x32, 0x82, 0x00, 0x6e, 0x36, 0x9b, 0x69, 0x00, 0xb7, 0x01, 0x62, 0x2a, 0x71, 0xd8, 0xab, 0x15,
+	0x5c, 0xd8, 0x80, 0xf7, 0x0a, 0xdf, 0x1e, 0xc8, 0x7b, 0xe5, 0xae, 0xd9, 0x08, 0xfb, 0x1a, 0x42,
+	0x4f, 0x32, 0x0f, 0x53, 0xb6, 0x79, 0x48, 0xbd, 0x96, 0x59, 0xa3, 0xa5, 0x91, 0x25, 0x65, 0x6d,
+	0xca, 0x88, 0x2b, 0x48, 0x09, 0x26, 0x6b, 0x2e, 0x35, 0x7d, 0xc7, 0x2d, 0x8d, 0xb2, 0xb6, 0xb0,
+	0x48, 0xca, 0x00, 0x81, 0xd9, 0x5d, 0x97, 0xee, 0x5b, 0x8f, 0x4a, 0x63, 0xac, 0x51, 0xa8, 0x21,
+	0x5f, 0x03, 0x38, 0x32, 0x9b, 0x6d, 0x6a, 0x98, 0x76, 0x83, 0x96, 0xc6, 0x19, 0xbe, 0x25, 0xe9,
+	0xb4, 0x5f, 0x0f, 0xcc, 0x76, 0x9c, 0xb6, 0x5d, 0xf7, 0x0c, 0xa1, 0x4f, 0xe0, 0xdb, 0xa5, 0x47,
+	0xd4, 0xf5, 0x68, 0x69, 0x62, 0x49, 0x59, 0x3b, 0x6d, 0x84, 0xc5, 0x00, 0x73, 0xcd, 0x69, 0xdb,
+	0xfe, 0x1d, 0xbb, 0xd9, 0x29, 0x4d, 0xb2, 0xb6, 0xb8, 0x42, 0xfb, 0xb3, 0x02, 0xa5, 0x2c, 0x6b,
+	0xd2, 0xb5, 0x18, 0x2d, 0xbc, 0x16, 0x64, 0x37, 0xc1, 0xf7, 0x08, 0x9b, 0xcf, 0xea, 0x40, 0xbe,
+	0xb9, 0xd7, 0x04, 0xe1, 0x33, 0x30, 0xce, 0xb0, 0x32, 0x42, 0xc7, 0x0c, 0x5e, 0xd0, 0xde, 0x52,
+	0xa0, 0xcc, 0x40, 0x87, 0x0e, 0xbd, 0x9d, 0xce, 0x2d, 0x4e, 0x75, 0xb8, 0xe2, 0xc2, 0x5a, 0x28,
+	0xc9, 0xb5, 0xb8, 0x2d, 0xc1, 0xf6, 0x1c, 0x7b, 0x41, 0xfb, 0xad, 0x02, 0x8b, 0x7d, 0x41, 0x9c,
+	0x14, 0x02, 0xb5, 0x36, 0xcc, 0x25, 0x40, 0xbe, 0x6c, 0x79, 0xbe, 0xe3, 0x76, 0x70, 0x42, 0xe9,
+	0x93, 0x39, 0x34, 0x72, 0xde, 0x53, 0x60, 0x5e, 0xee, 0x17, 0x99, 0xb9, 0x09, 0x53, 0x2e, 0x3d,
+	0xb2, 0xbc, 0x20, 0x4c, 0x22, 0x35, 0x0b, 0x52, 0x6a, 0x0c, 0xb4, 0x42, 0x8a, 0xe2, 0x5e, 0xc3,
+	0xe3, 0xe8, 0x1b, 0xa9, 0xdd, 0x74, 0xd3, 0x0f, 0x9d, 0xf6, 0xa3, 0x49, 0x85, 0xd3, 0x21, 0x0e,
+	0xe6, 0x78, 0xcc, 0x88, 0xca, 0xda, 0x1e, 0x2c, 0xf6, 0x1d, 0x0d, 0x27, 0xff, 0x55, 0xa1, 0x3b,
+	0x0f, 0x46, 0x85, 0xe6, 0x1e, 0xfb, 0xf8, 0x5e, 0x8a, 0x5d, 0x6f, 0xa7, 0x73, 0xe7, 0x3b, 0x36,
+	0x8d, 0x76, 0xff, 0x0c, 0x8c, 0x3b, 0x41, 0x19, 0xf7, 0x3e, 0x2f, 0x0c, 0x6d, 0x71, 0x7f, 0xa3,
+	0xc0, 0x42, 0x1f, 0xf7, 0x27, 0x66, 0xdf, 0xbf, 0xad, 0xc0, 0x52, 0x1a, 0xe3, 0x4d, 0xdf, 0x77,
+	0xad, 0xbd, 0xb6, 0x1f, 0x5d, 0x0b, 0x2f, 0xc2, 0xe8, 0x43, 0xda, 0x41, 0x92, 0x82, 0xcf, 0x80,
+	0x38, 0x16, 0x54, 0x31, 0xb8, 0xf3, 0x42, 0x8a, 0xb8, 0xd1, 0xe7, 0x26, 0xee, 0x77, 0x0a, 0x5c,
+	0xce, 0x01, 0x75, 0x62, 0xc8, 0x3b, 0x02, 0x35, 0x0d, 0xf3, 0x35, 0xb3, 0x21, 0xb0, 0xe6, 0x9b,
+	0x8d, 0x90, 0x35, 0xdf, 0x6c, 0x0c, 0x6d, 0x63, 0xfd, 0x5a, 0x81, 0x39, 0xa9, 0xe3, 0x13, 0xc3,
+	0xcc, 0x6d, 0x58, 0x4e, 0x67, 0x2e, 0xaf, 0xb9, 0xa6, 0xed, 0xed, 0x53, 0xf7, 0xce, 0xfe, 0x7e,
+	0x7c, 0x00, 0xcb, 0x00, 0x61, 0x0e, 0x56, 0x0d, 0x03, 0x87, 0x50, 0xa3, 0xfd, 0x50, 0x81, 0x95,
+	0x01, 0x03, 0xe1, 0x9c, 0xbf, 0x0d, 0xb3, 0xae, 0xcc, 0x00, 0x03, 0x87, 0x26, 0x25, 0x20, 0x61,
+	0x89, 0x6c, 0xc8, 0x87, 0xd1, 0x6c, 0x58, 0x4e, 0xdf, 0xff, 0xd2, 0x19, 0x0d, 0x29, 0x85, 0xd2,
+	0x9e, 0x84, 0x33, 0xef, 0xef, 0x70, 0xf0, 0xcc, 0x47, 0x87, 0x30, 0xf3, 0xe1, 0x6d, 0x0a, 0x0f,
+	0x2e, 0x25, 0x76, 0xad, 0xe1, 0x34, 0xa9, 0xf7, 0x59, 0xdf, 0xb0, 0x1f, 0x2a, 0xa0, 0xca, 0xbc,
+	0x22, 0x79, 0xf2, 0x1b, 0xe0, 0x2b, 0x30, 0xd1, 0x70, 0x4d, 0xdb, 0xf7, 0x4a, 0x23, 0x8c, 0xc3,
+	0xb2, 0xfc, 0xda, 0x71, 0x9a, 0x74, 0x37, 0x30, 0x43, 0xfe, 0xb0, 0x0f, 0xd9, 0x95, 0x84, 0xc1,
+	0xe7, 0x22, 0xec, 0xcd, 0xf0, 0x02, 0xf9, 0x7a, 0xdd, 0xf2, 0xcd, 0xbd, 0x26, 0x8d, 0xce, 0xbb,
+	0x90, 0xbe, 0x99, 0xf5, 0xba, 0x4b, 0x3d, 0x2f, 0x4c, 0xdf, 0xb0, 0x38, 0x34, 0xfe, 0x7e, 0x1f,
+	0xe6, 0x90, 0x12, 0x0c, 0xc8, 0x61, 0xcc, 0x96, 0xf2, 0xa9, 0xd9, 0xfa, 0x14, 0xdb, 0xab, 0x0a,
+	0x0b, 0xe9, 0x50, 0x71, 0xaf, 0x76, 0x40, 0x0f, 0xcd, 0xec, 0x16, 0x9b, 0x62, 0x5b, 0xac, 0x04,
+	0x93, 0x41, 0xea, 0x1f, 0x27, 0x27, 0x61, 0x51, 0x7b, 0x08, 0xe5, 0x7e, 0x43, 0xe1, 0x9c, 0xab,
+	0x70, 0xd6, 0x4d, 0xb4, 0xe0, 0x51, 0x9f, 0x93, 0xce, 0x9d, 0x9b, 0xe0, 0xc4, 0x53, 0x1d, 0xb5,
+	0x06, 0x2c, 0xa4, 0x0f, 0x7a, 0x12, 0xf7, 0xb0, 0x42, 0xca, 0x07, 0xe1, 0x52, 0x4a, 0x3c, 0xe5,
+	0x4c, 0x6b, 0xf4, 0xb9, 0xa6, 0x35, 0xbc, 0x75, 0xad, 0xc4, 0xaf, 0xe0, 0x57, 0xc3, 0x37, 0x64,
+	0x48, 0x0d, 0x81, 0xb1, 0xe0, 0x79, 0x88, 0x8b, 0xca, 0xbe, 0xb5, 0xfb, 0x70, 0x49, 0x62, 0x8f,
+	0x13, 0xfc, 0xb2, 0xf8, 0x32, 0xe5, 0x54, 0x5e, 0x48, 0xcc, 0x2d, 0xea, 0x12, 0x26, 0xd2, 0x91,
+	0xb9, 0xb6, 0x17, 0x3f, 0x01, 0x33, 0x40, 0x86, 0xb5, 0x46, 0xef, 0x2a, 0x70, 0x49, 0xe2, 0x44,
+	0x8e, 0x7e, 0xf4, 0x18, 0xe8, 0x87, 0xb7, 0x1e, 0x3f, 0xca, 0xa4, 0x8c, 0x55, 0x3b, 0xc3, 0xc7,
+	0x7c, 0x9a, 0xe7, 0x84, 0x02, 0x30, 0xac, 0xe0, 0x94, 0x4d, 0x14, 0x13, 0x50, 0x4e, 0x4c, 0x3a,
+	0xf4, 0x52, 0xea, 0x0e, 0xda, 0xe9, 0x04, 0x28, 0xf3, 0x36, 0xf1, 0xeb, 0x30, 0x27, 0xed, 0x11,
+	0x4f, 0xc9, 0x3d, 0x8e, 0xfa, 0x13, 0x1a, 0x6b, 0xdf, 0x97, 0xa4, 0xd6, 0xaf, 0x46, 0x02, 0x4b,
+	0x88, 0xe8, 0x02, 0x4c, 0xb4, 0x58, 0x05, 0x62, 0xc2, 0xd2, 0xd0, 0xd6, 0xed, 0xb1, 0x02, 0x5a,
+	0x1e, 0x8a, 0x13, 0xb3, 0x70, 0x5b, 0xa9, 0x94, 0xe5, 0x9e, 0x6f, 0xfa, 0x9e, 0x98, 0xe0, 0x3b,
+	0x2d, 0x46, 0xd1, 0x0b, 0x46, 0xf0, 0xa9, 0xfd, 0x7d, 0x04, 0x54, 0x99, 0x3d, 0xce, 0x67, 0x1e,
+	0xa6, 0x9a, 0xd6, 0x11, 0xbd, 0xc5, 0x94, 0x1a, 0x9e, 0xea, 0xc4, 0x15, 0x44, 0x83, 0x33, 0xa8,
+	0xbd, 0x70, 0x03, 0x7e, 0x27, 0x25, 0xea, 0x82, 0x7c, 0x99, 0x3d, 0xb5, 0x6e, 0x09, 0x62, 0x8f,
+	0x50, 0x43, 0x76, 0xe1, 0x34, 0x2b, 0xdd, 0x6b, 0x1f, 0x72, 0xf9, 0x6c, 0x67, 0x33, 0xa0, 0xe6,
+	0x3f, 0x1f, 0x2f, 0xce, 0xf2, 0xd9, 0x7b, 0xf5, 0x87, 0x15, 0xcb, 0xd1, 0x0f, 0x4d, 0xff, 0xa0,
+	0x52, 0xb5, 0xfd, 0x7f, 0x7e, 0xb0, 0x05, 0x48, 0x4b, 0xd5, 0xf6, 0x8d, 0xa8, 0x73, 0xf0, 0x72,
+	0x67, 0xdf, 0xaf, 0x58, 0x36, 0xd3, 0xd9, 0xc6, 0x8c, 0xa8, 0x1c, 0xb7, 0x99, 0x8f, 0x4a, 0x13,
+	0x62, 0x9b, 0xf9, 0x88, 0x54, 0x61, 0xda, 0x77, 0x5a, 0xa8, 0xef, 0x78, 0xa5, 0x49, 0xb6, 0x6a,
+	0x97, 0x13, 0xab, 0x16, 0x8b, 0x3f, 0x8c, 0x22, 0x06, 0x1c, 0x57, 0x50, 0xec, 0xab, 0xe9, 0xa9,
+	0xd7, 0xf3, 0x7d, 0xcb, 0x3f, 0xb8, 0x1b, 0xe8, 0xc1, 0xfd, 0xe4, 0xd2, 0xbf, 0xa5, 0xe5, 0x2e,
+	0xa1, 0x07, 0xae, 0xc0, 0x76, 0xc1, 0x73, 0x13, 0x9f, 0x98, 0xf0, 0xf1, 0x1b, 0xac, 0xc6, 0x99,
+	0xd4, 0xe3, 0x77, 0x94, 0xd5, 0xf1, 0x02, 0xd9, 0x86, 0x71, 0xa6, 0x56, 0x33, 0xde, 0x83, 0x1b,
+	0x33, 0x56, 0xb3, 0x2b, 0x5c, 0xcd, 0xae, 0x30, 0x2c, 0x77, 0x5a, 0x9e, 0xc1, 0x2d, 0x83, 0x63,
+	0x76, 0x40, 0xad, 0xc6, 0x81, 0xcf, 0x28, 0x1e, 0x35, 0xb0, 0xa4, 0x7d, 0x37, 0x15, 0x60, 0x0d,
+	0xda, 0x6a, 0x5a, 0x35, 0xae, 0x95, 0x7f, 0xd6, 0xf9, 0xf2, 0x5f, 0xd3, 0x01, 0x22, 0xe9, 0x1c,
+	0x79, 0x7c, 0x19, 0xa6, 0xdd, 0xb8, 0x1e, 0x0f, 0xa7, 0x5c, 0x89, 0x15, 0xfa, 0x87, 0xab, 0x2c,
+	0x74, 0x1d, 0xde, 0x51, 0xbd, 0x9f, 0x8a, 0x98, 0xe8, 0x57, 0xb8, 0x90, 0x6a, 0x07, 0xa6, 0x6d,
+	0xd3, 0x66, 0x35, 0xcc, 0x01, 0xe3, 0x8a, 0x60, 0x4b, 0x47, 0xaf, 0x50, 0x14, 0xaa, 0xc2, 0xb2,
+	0xf6, 0x8b, 0xb4, 0x46, 0x17, 0x8d, 0x1c, 0xe5, 0xbf, 0x93, 0x38, 0x23, 0xdc, 0x53, 0xf3, 0x79,
+	0x44, 0x20, 0x09, 0x61, 0x97, 0x44, 0x28, 0x1f, 0x39, 0x46, 0x28, 0xbf, 0xfe, 0x8f, 0x79, 0x18,
+	0x67, 0xb8, 0xc8, 0x01, 0x4c, 0xf0, 0xdf, 0x1b, 0xc8, 0x62, 0xa2, 0x6b, 0xf6, 0xc7, 0x0c, 0x75,
+	0xa9, 0xbf, 0x01, 0x9f, 0x8d, 0x36, 0xf7, 0xd6, 0xbf, 0xfe, 0xf7, 0xf3, 0x91, 0x59, 0x72, 0x5e,
+	0xcf, 0xfe, 0x86, 0x42, 0xba, 0x71, 0x44, 0x26, 0xcb, 0xd9, 0xa1, 0xb2, 0xbf, 0x69, 0xa8, 0x2b,
+	0x03, 0xac, 0xd0, 0xab, 0xc6, 0xbc, 0xce, 0x13, 0x55, 0x97, 0xfd, 0x3e, 0xa3, 0x77, 0xad, 0x7a,
+	0x8f, 0x74, 0x60, 0x3a, 0x12, 0x0b, 0x9b, 0x4d, 0x99, 0xff, 0xec, 0x4f, 0x1a, 0xea, 0xca, 0x00,
+	0x2b, 0xf4, 0xbf, 0xc0, 0xfc, 0x5f, 0x24, 0xb3, 0x52, 0xff, 0xe4, 0x5d, 0x05, 0x48, 0x56, 0xbf,
+	0x26, 0x9b, 0xd9, 0xc1, 0xfb, 0x4a, 0xed, 0xea, 0xb5, 0x62, 0xc6, 0x08, 0x48, 0x67, 0x80, 0xd6,
+	0xc9, 0xaa, 0x9c, 0x10, 0xbc, 0x15, 0xf4, 0x2e, 0x7e, 0xf4, 0xc8, 0x2f, 0x15, 0x78, 0x31, 0x2d,
+	0x34, 0x92, 0xf5, 0x5c, 0x9f, 0xa2, 0x16, 0xaa, 0x6e, 0x14, 0x31, 0x45, 0x70, 0x9b, 0x0c, 0xdc,
+	0x0a, 0xb9, 0x22, 0x07, 0xc7, 0x1e, 0xd1, 0x7a, 0x97, 0xfd, 0xd3, 0x23, 0x6f, 0x2b, 0xf0, 0xb9,
+	0x94, 0xbc, 0x4d, 0xd6, 0xfa, 0x3b, 0x4b, 0x2a, 0xef, 0xea, 0x7a, 0x01, 0x4b, 0x44, 0xb5, 0xc1,
+	0x50, 0x2d, 0x13, 0xad, 0xff, 0x1e, 0xd2, 0x0f, 0x10, 0xc0, 0x63, 0x61, 0x41, 0x63, 0xe5, 0x39,
+	0x6f, 0x41, 0x33, 0x6a, 0xb7, 0x7a, 0xad, 0x98, 0x31, 0xa2, 0xfb, 0x3c, 0x43, 0xa7, 0x93, 0xad,
+	0xc1, 0xe8, 0xf4, 0x6e, 0xa8, 0x60, 0xf7, 0xc8, 0x87, 0x0a, 0xcc, 0x4a, 0x45, 0x1b, 0xb2, 0x9d,
+	0x7b, 0xb2, 0x64, 0xe2, 0x94, 0x7a, 0xfd, 0x38, 0x5d, 0x10, 0xf7, 0x97, 0x18, 0xee, 0x1b, 0x64,
+	0x5b, 0x8a, 0xfb, 0x81, 0x8f, 0x9d, 0x1e, 0x38, 0x41, 0x2f, 0xbd, 0x1b, 0x36, 0x54, 0xeb, 0x3d,
+	0xf2, 0x47, 0x05, 0x4a, 0xd2, 0xc1, 0x83, 0xe3, 0xbb, 0x9d, 0x7b, 0x30, 0x8b, 0xc2, 0x1f, 0xa4,
+	0x8e, 0x69, 0xd7, 0x18, 0xfc, 0xab, 0x64, 0xb9, 0x08, 0x7c, 0xf2, 0xbe, 0x02, 0x33, 0x32, 0xd1,
+	0x99, 0x6c, 0xe5, 0x9e, 0x8e, 0xb4, 0x62, 0xae, 0x56, 0x8a, 0x9a, 0x17, 0xdb, 0x1c, 0x66, 0xd8,
+	0x41, 0xef, 0x3e, 0xa4, 0x9d, 0x9e, 0xde, 0x65, 0x29, 0x47, 0x8f, 0xfc, 0x44, 0x81, 0xb3, 0x49,
+	0x0d, 0x98, 0xac, 0xe6, 0x7a, 0x8e, 0xe5, 0x69, 0x75, 0x6d, 0xb0, 0x21, 0x82, 0x5b, 0x65, 0xe0,
+	0x2e, 0x93, 0x45, 0x39, 0x38, 0xdf, 0x6c, 0xe8, 0x5d, 0xdf, 0x6c, 0xf4, 0xc8, 0x8f, 0x15, 0x78,
+	0x21, 0x21, 0xb3, 0x91, 0xab, 0xfd, 0x9d, 0x88, 0xea, 0x9f, 0xba, 0x3a, 0xd0, 0x0e, 0xb1, 0xac,
+	0x31, 0x2c, 0x1a, 0x59, 0xca, 0x39, 0x45, 0x2e, 0x73, 0xfd, 0x8e, 0x02, 0xe7, 0x32, 0x9a, 0x15,
+	0x91, 0x44, 0xb9, 0x7e, 0xe2, 0x9a, 0xba, 0x59, 0xc8, 0x16, 0x81, 0xbd, 0xc4, 0x80, 0x6d, 0x90,
+	0x35, 0x39, 0x30, 0x8a, 0x1d, 0xf5, 0x2e, 0x0a, 0x74, 0x3d, 0xf2, 0x8e, 0xb0, 0x78, 0xa8, 0x99,
+	0x6c, 0xe4, 0x9e, 0xcf, 0x84, 0x2a, 0xa4, 0x6e, 0x16, 0xb2, 0x45, 0x74, 0x37, 0x18, 0xba, 0x2d,
+	0xb2, 0x29, 0x3f, 0x05, 0x1e, 0xb3, 0xe6, 0xec, 0x75, 0x51, 0x04, 0x63, 0x81, 0xfb, 0x5c, 0x72,
+	0xbc, 0xe0, 0xdc, 0x6e, 0xe4, 0x1e, 0xc2, 0x81, 0x18, 0xfb, 0x6a, 0x4f, 0xda, 0x32, 0xc3, 0x58,
+	0x26, 0xf3, 0x79, 0x18, 0xc9, 0x9b, 0x0a, 0x4c, 0x45, 0x4f, 0x7c, 0x22, 0xcf, 0x2e, 0xd2, 0x6a,
+	0x84, 0x7a, 0x75, 0x90, 0x19, 0x42, 0x58, 0x61, 0x10, 0x16, 0xc9, 0x42, 0x02, 0x42, 0xa4, 0x5b,
+	0xe8, 0xdd, 0xe0, 0xb3, 0x47, 0x7a, 0x70, 0x26, 0xea, 0x1b, 0x50, 0x22, 0xcf, 0x31, 0x8a, 0xa0,
+	0x90, 0xa9, 0x3c, 0x5a, 0x99, 0xa1, 0x28, 0x91, 0x0b, 0x72, 0x14, 0xe4, 0xa7, 0xc2, 0xc6, 0xe1,
+	0xef, 0xe6, 0xbc, 0x53, 0x9f, 0xd0, 0x1a, 0xd4, 0xb5, 0xc1, 0x86, 0x88, 0x62, 0x9d, 0xa1, 0xb8,
+	0x42, 0x2e, 0xcb, 0x37, 0x74, 0x00, 0x27, 0xe4, 0xe3, 0x7d, 0xe1, 0x8e, 0x4a, 0xbc, 0xe4, 0x49,
+	0x7e, 0x1c, 0xcc, 0x08, 0x0f, 0xaa, 0x5e, 0xd8, 0x1e, 0x51, 0x5e, 0x67, 0x28, 0xaf, 0x91, 0x8d,
+	0xfe, 0x28, 0x1f, 0x70, 0xf1, 0x42, 0xef, 0xf2, 0x7f, 0x7b, 0xe4, 0x3d, 0x31, 0xc8, 0x0b, 0x82,
+	0x51, 0x6e, 0x90, 0xcf, 0x6a, 0x5c, 0x6a, 0xa5, 0xa8, 0x79, 0xa1, 0x43, 0x98, 0xde, 0x66, 0xec,
+	0x93, 0xa5, 0x75, 0xe7, 0x32, 0xef, 0x59, 0x92, 0x93, 0xac, 0xa5, 0x9f, 0xc9, 0xea, 0x66, 0x21,
+	0xdb, 0x63, 0xc4, 0x57, 0xfe, 0x78, 0xfd, 0x81, 0x10, 0xec, 0x99, 0xcc, 0x91, 0x17, 0xec, 0x45,
+	0xdd, 0x44, 0x5d, 0x1d, 0x68, 0x87, 0x60, 0xae, 0x30, 0x30, 0x0b, 0x64, 0xae, 0x4f, 0x44, 0x60,
+	0x5e, 0x1f, 0x0b, 0xab, 0x29, 0xbe, 0x55, 0xf3, 0x56, 0x53, 0xf2, 0xa0, 0x56, 0x2b, 0x45, 0xcd,
+	0x8b, 0x25, 0xe8, 0xfc, 0x26, 0x12, 0xf1, 0xfc, 0x4a, 0xc8, 0x83, 0x71, 0xc4, 0xbc, 0x3c, 0x38,
+	0xf9, 0x7e, 0x55, 0xd7, 0x0b, 0x58, 0x0e, 0xd8, 0x67, 0xcc, 0x4a, 0xef, 0x46, 0xaf, 0xde, 0x9e,
+	0xde, 0x8d, 0x72, 0xb5, 0x9d, 0xad, 0x8f, 0x9e, 0x96, 0x95, 0x27, 0x4f, 0xcb, 0xca, 0x7f, 0x9f,
+	0x96, 0x95, 0x9f, 0x3d, 0x2b, 0x9f, 0x7a, 0xf2, 0xac, 0x7c, 0xea, 0xdf, 0xcf, 0xca, 0xa7, 0xbe,
+	0x75, 0x9e, 0xf7, 0x7f, 0x84, 0xe3, 0xf8, 0x9d, 0x16, 0xf5, 0xf6, 0x26, 0xd8, 0xff, 0x98, 0xbb,
+	0xf1, 0xc9, 0x00, 0xa7, 0x01, 0xaa, 0xfb, 0x3c, 0x28, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Parameters queries the parameters of the module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// Queries a list of Resource items.
+	Resource(ctx context.Context, in *QueryGetResourceRequest, opts ...grpc.CallOption) (*QueryGetResourceResponse, error)
+	ResourceAll(ctx context.Context, in *QueryAllResourceRequest, opts ...grpc.CallOption) (*QueryAllResourceResponse, error)
+	// Queries a list of Resource items originally created by an address.
+	ResourcesByCreator(ctx context.Context, in *QueryResourcesByCreatorRequest, opts ...grpc.CallOption) (*QueryResourcesByCreatorResponse, error)
+	// Queries a list of Resource items currently owned by an address.
+	ResourcesByOwner(ctx context.Context, in *QueryResourcesByOwnerRequest, opts ...grpc.CallOption) (*QueryResourcesByOwnerResponse, error)
+	// Queries the revision history of a resource.
+	ResourceHistory(ctx context.Context, in *QueryResourceHistoryRequest, opts ...grpc.CallOption) (*QueryResourceHistoryResponse, error)
+	// Queries a single revision of a resource.
+	ResourceAtRevision(ctx context.Context, in *QueryResourceAtRevisionRequest, opts ...grpc.CallOption) (*QueryResourceAtRevisionResponse, error)
+	// Queries a list of pending ResourceTransferOffer items.
+	ResourceTransferOffer(ctx context.Context, in *QueryGetResourceTransferOfferRequest, opts ...grpc.CallOption) (*QueryGetResourceTransferOfferResponse, error)
+	ResourceTransferOfferAll(ctx context.Context, in *QueryAllResourceTransferOfferRequest, opts ...grpc.CallOption) (*QueryAllResourceTransferOfferResponse, error)
+	// Queries a list of Resource items carrying an attribute key/value pair.
+	ResourcesByAttribute(ctx context.Context, in *QueryResourcesByAttributeRequest, opts ...grpc.CallOption) (*QueryResourcesByAttributeResponse, error)
+	// Queries a list of Resource items carrying a tag.
+	ResourcesByTag(ctx context.Context, in *QueryResourcesByTagRequest, opts ...grpc.CallOption) (*QueryResourcesByTagResponse, error)
+	// Queries the roles granted on a resource.
+	ResourceRoles(ctx context.Context, in *QueryResourceRolesRequest, opts ...grpc.CallOption) (*QueryResourceRolesResponse, error)
+	// Queries the resources an address has been granted edit access to.
+	EditableResources(ctx context.Context, in *QueryEditableResourcesRequest, opts ...grpc.CallOption) (*QueryEditableResourcesResponse, error)
+	// Queries a version of a ResourceSchema, the latest one if version is zero.
+	ResourceSchema(ctx context.Context, in *QueryGetResourceSchemaRequest, opts ...grpc.CallOption) (*QueryGetResourceSchemaResponse, error)
+	ResourceSchemaAll(ctx context.Context, in *QueryAllResourceSchemaRequest, opts ...grpc.CallOption) (*QueryAllResourceSchemaResponse, error)
+	// Queries a list of Namespace items.
+	Namespace(ctx context.Context, in *QueryGetNamespaceRequest, opts ...grpc.CallOption) (*QueryGetNamespaceResponse, error)
+	NamespaceAll(ctx context.Context, in *QueryAllNamespaceRequest, opts ...grpc.CallOption) (*QueryAllNamespaceResponse, error)
+	// Queries a resource by name, the one with the lowest id if several share
+	// the name.
+	ResourceByName(ctx context.Context, in *QueryResourceByNameRequest, opts ...grpc.CallOption) (*QueryResourceByNameResponse, error)
+	// Queries a list of Resource items whose name starts with a prefix, in name
+	// order.
+	ResourcesByNamePrefix(ctx context.Context, in *QueryResourcesByNamePrefixRequest, opts ...grpc.CallOption) (*QueryResourcesByNamePrefixResponse, error)
+	// Queries a list of Resource items of a namespace.
+	ResourcesInNamespace(ctx context.Context, in *QueryResourcesInNamespaceRequest, opts ...grpc.CallOption) (*QueryResourcesInNamespaceResponse, error)
+	// Queries a resource along with a Merkle proof of its presence, or of its
+	// absence, against the app hash.
+	ResourceWithProof(ctx context.Context, in *QueryResourceWithProofRequest, opts ...grpc.CallOption) (*QueryResourceWithProofResponse, error)
+	// Queries aggregate statistics over the live resources.
+	ResourceStats(ctx context.Context, in *QueryResourceStatsRequest, opts ...grpc.CallOption) (*QueryResourceStatsResponse, error)
+	// Queries the IBC channels a resource is replicated on.
+	ResourceReplications(ctx context.Context, in *QueryResourceReplicationsRequest, opts ...grpc.CallOption) (*QueryResourceReplicationsResponse, error)
+	// Queries the replica received over an IBC channel of a resource of the
+	// counterparty chain.
+	ResourceReplica(ctx context.Context, in *QueryResourceReplicaRequest, opts ...grpc.CallOption) (*QueryResourceReplicaResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/crude.crude.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Resource(ctx context.Context, in *QueryGetResourceRequest, opts ...grpc.CallOption) (*QueryGetResourceResponse, error) {
+	out := new(QueryGetResourceResponse)
+	err := c.cc.Invoke(ctx, "/crude.crude.Query/Resource", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ResourceAll(ctx context.Context, in *QueryAllResourceRequest, opts ...grpc.CallOption) (*QueryAllResourceResponse, error) {
+	out := new(QueryAllResourceResponse)
+	err := c.cc.Invoke(ctx, "/crude.crude.Query/ResourceAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ResourcesByCreator(ctx context.Context, in *QueryResourcesByCreatorRequest, opts ...grpc.CallOption) (*QueryResourcesByCreatorResponse, error) {
+	out := new(QueryResourcesByCreatorResponse)
+	err := c.cc.Invoke(ctx, "/crude.crude.Query/ResourcesByCreator", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ResourcesByOwner(ctx context.Context, in *QueryResourcesByOwnerRequest, opts ...grpc.CallOption) (*QueryResourcesByOwnerResponse, error) {
+	out := new(QueryResourcesByOwnerResponse)
+	err := c.cc.Invoke(ctx, "/crude.crude.Query/ResourcesByOwner", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ResourceHistory(ctx context.Context, in *QueryResourceHistoryRequest, opts ...grpc.CallOption) (*QueryResourceHistoryResponse, error) {
+	out := new(QueryResourceHistoryResponse)
+	err := c.cc.Invoke(ctx, "/crude.crude.Query/ResourceHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ResourceAtRevision(ctx context.Context, in *QueryResourceAtRevisionRequest, opts ...grpc.CallOption) (*QueryResourceAtRevisionResponse, error) {
+	out := new(QueryResourceAtRevisionResponse)
+	err := c.cc.Invoke(ctx, "/crude.crude.Query/ResourceAtRevision", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ResourceTransferOffer(ctx context.Context, in *QueryGetResourceTransferOfferRequest, opts ...grpc.CallOption) (*QueryGetResourceTransferOfferResponse, error) {
+	out := new(QueryGetResourceTransferOfferResponse)
+	err := c.cc.Invoke(ctx, "/crude.crude.Query/ResourceTransferOffer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ResourceTransferOfferAll(ctx context.Context, in *QueryAllResourceTransferOfferRequest, opts ...grpc.CallOption) (*QueryAllResourceTransferOfferResponse, error) {
+	out := new(QueryAllResourceTransferOfferResponse)
+	err := c.cc.Invoke(ctx, "/crude.crude.Query/ResourceTransferOfferAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ResourcesByAttribute(ctx context.Context, in *QueryResourcesByAttributeRequest, opts ...grpc.CallOption) (*QueryResourcesByAttributeResponse, error) {
+	out := new(QueryResourcesByAttributeResponse)
+	err := c.cc.Invoke(ctx, "/crude.crude.Query/ResourcesByAttribute", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ResourcesByTag(ctx context.Context, in *QueryResourcesByTagRequest, opts ...grpc.CallOption) (*QueryResourcesByTagResponse, error) {
+	out := new(QueryResourcesByTagResponse)
+	err := c.cc.Invoke(ctx, "/crude.crude.Query/ResourcesByTag", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ResourceRoles(ctx context.Context, in *QueryResourceRolesRequest, opts ...grpc.CallOption) (*QueryResourceRolesResponse, error) {
+	out := new(QueryResourceRolesResponse)
+	err := c.cc.Invoke(ctx, "/crude.crude.Query/ResourceRoles", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EditableResources(ctx context.Context, in *QueryEditableResourcesRequest, opts ...grpc.CallOption) (*QueryEditableResourcesResponse, error) {
+	out := new(QueryEditableResourcesResponse)
+	err := c.cc.Invoke(ctx, "/crude.crude.Query/EditableResources", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ResourceSchema(ctx context.Context, in *QueryGetResourceSchemaRequest, opts ...grpc.CallOption) (*QueryGetResourceSchemaResponse, error) {
+	out := new(QueryGetResourceSchemaResponse)
+	err := c.cc.Invoke(ctx, "/crude.crude.Query/ResourceSchema", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ResourceSchemaAll(ctx context.Context, in *QueryAllResourceSchemaRequest, opts ...grpc.CallOption) (*QueryAllResourceSchemaResponse, error) {
+	out := new(QueryAllResourceSchemaResponse)
+	err := c.cc.Invoke(ctx, "/crude.crude.Query/ResourceSchemaAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Namespace(ctx context.Context, in *QueryGetNamespaceRequest, opts ...grpc.CallOption) (*QueryGetNamespaceResponse, error) {
+	out := new(QueryGetNamespaceResponse)
+	err := c.cc.Invoke(ctx, "/crude.crude.Query/Namespace", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) NamespaceAll(ctx context.Context, in *QueryAllNamespaceRequest, opts ...grpc.CallOption) (*QueryAllNamespaceResponse, error) {
+	out := new(QueryAllNamespaceResponse)
+	err := c.cc.Invoke(ctx, "/crude.crude.Query/NamespaceAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ResourceByName(ctx context.Context, in *QueryResourceByNameRequest, opts ...grpc.CallOption) (*QueryResourceByNameResponse, error) {
+	out := new(QueryResourceByNameResponse)
+	err := c.cc.Invoke(ctx, "/crude.crude.Query/ResourceByName", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ResourcesByNamePrefix(ctx context.Context, in *QueryResourcesByNamePrefixRequest, opts ...grpc.CallOption) (*QueryResourcesByNamePrefixResponse, error) {
+	out := new(QueryResourcesByNamePrefixResponse)
+	err := c.cc.Invoke(ctx, "/crude.crude.Query/ResourcesByNamePrefix", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ResourcesInNamespace(ctx context.Context, in *QueryResourcesInNamespaceRequest, opts ...grpc.CallOption) (*QueryResourcesInNamespaceResponse, error) {
+	out := new(QueryResourcesInNamespaceResponse)
+	err := c.cc.Invoke(ctx, "/crude.crude.Query/ResourcesInNamespace", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ResourceWithProof(ctx context.Context, in *QueryResourceWithProofRequest, opts ...grpc.CallOption) (*QueryResourceWithProofResponse, error) {
+	out := new(QueryResourceWithProofResponse)
+	err := c.cc.Invoke(ctx, "/crude.crude.Query/ResourceWithProof", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ResourceStats(ctx context.Context, in *QueryResourceStatsRequest, opts ...grpc.CallOption) (*QueryResourceStatsResponse, error) {
+	out := new(QueryResourceStatsResponse)
+	err := c.cc.Invoke(ctx, "/crude.crude.Query/ResourceStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ResourceReplications(ctx context.Context, in *QueryResourceReplicationsRequest, opts ...grpc.CallOption) (*QueryResourceReplicationsResponse, error) {
+	out := new(QueryResourceReplicationsResponse)
+	err := c.cc.Invoke(ctx, "/crude.crude.Query/ResourceReplications", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ResourceReplica(ctx context.Context, in *QueryResourceReplicaRequest, opts ...grpc.CallOption) (*QueryResourceReplicaResponse, error) {
+	out := new(QueryResourceReplicaResponse)
+	err := c.cc.Invoke(ctx, "/crude.crude.Query/ResourceReplica", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Parameters queries the parameters of the module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// Queries a list of Resource items.
+	Resource(context.Context, *QueryGetResourceRequest) (*QueryGetResourceResponse, error)
+	ResourceAll(context.Context, *QueryAllResourceRequest) (*QueryAllResourceResponse, error)
+	// Queries a list of Resource items originally created by an address.
+	ResourcesByCreator(context.Context, *QueryResourcesByCreatorRequest) (*QueryResourcesByCreatorResponse, error)
+	// Queries a list of Resource items currently owned by an address.
+	ResourcesByOwner(context.Context, *QueryResourcesByOwnerRequest) (*QueryResourcesByOwnerResponse, error)
+	// Queries the revision history of a resource.
+	ResourceHistory(context.Context, *QueryResourceHistoryRequest) (*QueryResourceHistoryResponse, error)
+	// Queries a single revision of a resource.
+	ResourceAtRevision(context.Context, *QueryResourceAtRevisionRequest) (*QueryResourceAtRevisionResponse, error)
+	// Queries a list of pending ResourceTransferOffer items.
+	ResourceTransferOffer(context.Context, *QueryGetResourceTransferOfferRequest) (*QueryGetResourceTransferOfferResponse, error)
+	ResourceTransferOfferAll(context.Context, *QueryAllResourceTransferOfferRequest) (*QueryAllResourceTransferOfferResponse, error)
+	// Queries a list of Resource items carrying an attribute key/value pair.
+	ResourcesByAttribute(context.Context, *QueryResourcesByAttributeRequest) (*QueryResourcesByAttributeResponse, error)
+	// Queries a list of Resource items carrying a tag.
+	ResourcesByTag(context.Context, *QueryResourcesByTagRequest) (*QueryResourcesByTagResponse, error)
+	// Queries the roles granted on a resource.
+	ResourceRoles(context.Context, *QueryResourceRolesRequest) (*QueryResourceRolesResponse, error)
+	// Queries the resources an address has been granted edit access to.
+	EditableResources(context.Context, *QueryEditableResourcesRequest) (*QueryEditableResourcesResponse, error)
+	// Queries a version of a ResourceSchema, the latest one if version is zero.
+	ResourceSchema(context.Context, *QueryGetResourceSchemaRequest) (*QueryGetResourceSchemaResponse, error)
+	ResourceSchemaAll(context.Context, *QueryAllResourceSchemaRequest) (*QueryAllResourceSchemaResponse, error)
+	// Queries a list of Namespace items.
+	Namespace(context.Context, *QueryGetNamespaceRequest) (*QueryGetNamespaceResponse, error)
+	NamespaceAll(context.Context, *QueryAllNamespaceRequest) (*QueryAllNamespaceResponse, error)
+	// Queries a resource by name, the one with the lowest id if several share
+	// the name.
+	ResourceByName(context.Context, *QueryResourceByNameRequest) (*QueryResourceByNameResponse, error)
+	// Queries a list of Resource items whose name starts with a prefix, in name
+	// order.
+	ResourcesByNamePrefix(context.Context, *QueryResourcesByNamePrefixRequest) (*QueryResourcesByNamePrefixResponse, error)
+	// Queries a list of Resource items of a namespace.
+	ResourcesInNamespace(context.Context, *QueryResourcesInNamespaceRequest) (*QueryResourcesInNamespaceResponse, error)
+	// Queries a resource along with a Merkle proof of its presence, or of its
+	// absence, against the app hash.
+	ResourceWithProof(context.Context, *QueryResourceWithProofRequest) (*QueryResourceWithProofResponse, error)
+	// Queries aggregate statistics over the live resources.
+	ResourceStats(context.Context, *QueryResourceStatsRequest) (*QueryResourceStatsResponse, error)
+	// Queries the IBC channels a resource is replicated on.
+	ResourceReplications(context.Context, *QueryResourceReplicationsRequest) (*QueryResourceReplicationsResponse, error)
+	// Queries the replica received over an IBC channel of a resource of the
+	// counterparty chain.
+	ResourceReplica(context.Context, *QueryResourceReplicaRequest) (*QueryResourceReplicaResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) Resource(ctx context.Context, req *QueryGetResourceRequest) (*QueryGetResourceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Resource not implemented")
+}
+func (*UnimplementedQueryServer) ResourceAll(ctx context.Context, req *QueryAllResourceRequest) (*QueryAllResourceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResourceAll not implemented")
+}
+func (*UnimplementedQueryServer) ResourcesByCreator(ctx context.Context, req *QueryResourcesByCreatorRequest) (*QueryResourcesByCreatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResourcesByCreator not implemented")
+}
+func (*UnimplementedQueryServer) ResourcesByOwner(ctx context.Context, req *QueryResourcesByOwnerRequest) (*QueryResourcesByOwnerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResourcesByOwner not implemented")
+}
+func (*UnimplementedQueryServer) ResourceHistory(ctx context.Context, req *QueryResourceHistoryRequest) (*QueryResourceHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResourceHistory not implemented")
+}
+func (*UnimplementedQueryServer) ResourceAtRevision(ctx context.Context, req *QueryResourceAtRevisionRequest) (*QueryResourceAtRevisionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResourceAtRevision not implemented")
+}
+func (*UnimplementedQueryServer) ResourceTransferOffer(ctx context.Context, req *QueryGetResourceTransferOfferRequest) (*QueryGetResourceTransferOfferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResourceTransferOffer not implemented")
+}
+func (*UnimplementedQueryServer) ResourceTransferOfferAll(ctx context.Context, req *QueryAllResourceTransferOfferRequest) (*QueryAllResourceTransferOfferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResourceTransferOfferAll not implemented")
+}
+func (*UnimplementedQueryServer) ResourcesByAttribute(ctx context.Context, req *QueryResourcesByAttributeRequest) (*QueryResourcesByAttributeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResourcesByAttribute not implemented")
+}
+func (*UnimplementedQueryServer) ResourcesByTag(ctx context.Context, req *QueryResourcesByTagRequest) (*QueryResourcesByTagResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResourcesByTag not implemented")
+}
+func (*UnimplementedQueryServer) ResourceRoles(ctx context.Context, req *QueryResourceRolesRequest) (*QueryResourceRolesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResourceRoles not implemented")
+}
+func (*UnimplementedQueryServer) EditableResources(ctx context.Context, req *QueryEditableResourcesRequest) (*QueryEditableResourcesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EditableResources not implemented")
+}
+func (*UnimplementedQueryServer) ResourceSchema(ctx context.Context, req *QueryGetResourceSchemaRequest) (*QueryGetResourceSchemaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResourceSchema not implemented")
+}
+func (*UnimplementedQueryServer) ResourceSchemaAll(ctx context.Context, req *QueryAllResourceSchemaRequest) (*QueryAllResourceSchemaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResourceSchemaAll not implemented")
+}
+func (*UnimplementedQueryServer) Namespace(ctx context.Context, req *QueryGetNamespaceRequest) (*QueryGetNamespaceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Namespace not implemented")
+}
+func (*UnimplementedQueryServer) NamespaceAll(ctx context.Context, req *QueryAllNamespaceRequest) (*QueryAllNamespaceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NamespaceAll not implemented")
+}
+func (*UnimplementedQueryServer) ResourceByName(ctx context.Context, req *QueryResourceByNameRequest) (*QueryResourceByNameResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResourceByName not implemented")
+}
+func (*UnimplementedQueryServer) ResourcesByNamePrefix(ctx context.Context, req *QueryResourcesByNamePrefixRequest) (*QueryResourcesByNamePrefixResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResourcesByNamePrefix not implemented")
+}
+func (*UnimplementedQueryServer) ResourcesInNamespace(ctx context.Context, req *QueryResourcesInNamespaceRequest) (*QueryResourcesInNamespaceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResourcesInNamespace not implemented")
+}
+func (*UnimplementedQueryServer) ResourceWithProof(ctx context.Context, req *QueryResourceWithProofRequest) (*QueryResourceWithProofResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResourceWithProof not implemented")
+}
+func (*UnimplementedQueryServer) ResourceStats(ctx context.Context, req *QueryResourceStatsRequest) (*QueryResourceStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResourceStats not implemented")
+}
+func (*UnimplementedQueryServer) ResourceReplications(ctx context.Context, req *QueryResourceReplicationsRequest) (*QueryResourceReplicationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResourceReplications not implemented")
+}
+func (*UnimplementedQueryServer) ResourceReplica(ctx context.Context, req *QueryResourceReplicaRequest) (*QueryResourceReplicaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResourceReplica not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/crude.crude.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Resource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetResourceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Resource(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/crude.crude.Query/Resource",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Resource(ctx, req.(*QueryGetResourceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ResourceAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllResourceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ResourceAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/crude.crude.Query/ResourceAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ResourceAll(ctx, req.(*QueryAllResourceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ResourcesByCreator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryResourcesByCreatorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ResourcesByCreator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/crude.crude.Query/ResourcesByCreator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ResourcesByCreator(ctx, req.(*QueryResourcesByCreatorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ResourcesByOwner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryResourcesByOwnerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ResourcesByOwner(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/crude.crude.Query/ResourcesByOwner",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ResourcesByOwner(ctx, req.(*QueryResourcesByOwnerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ResourceHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryResourceHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ResourceHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/crude.crude.Query/ResourceHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ResourceHistory(ctx, req.(*QueryResourceHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ResourceAtRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryResourceAtRevisionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ResourceAtRevision(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/crude.crude.Query/ResourceAtRevision",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ResourceAtRevision(ctx, req.(*QueryResourceAtRevisionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ResourceTransferOffer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetResourceTransferOfferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ResourceTransferOffer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/crude.crude.Query/ResourceTransferOffer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ResourceTransferOffer(ctx, req.(*QueryGetResourceTransferOfferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ResourceTransferOfferAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllResourceTransferOfferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ResourceTransferOfferAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/crude.crude.Query/ResourceTransferOfferAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ResourceTransferOfferAll(ctx, req.(*QueryAllResourceTransferOfferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ResourcesByAttribute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryResourcesByAttributeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ResourcesByAttribute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/crude.crude.Query/ResourcesByAttribute",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ResourcesByAttribute(ctx, req.(*QueryResourcesByAttributeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ResourcesByTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryResourcesByTagRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ResourcesByTag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/crude.crude.Query/ResourcesByTag",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ResourcesByTag(ctx, req.(*QueryResourcesByTagRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ResourceRoles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryResourceRolesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ResourceRoles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/crude.crude.Query/ResourceRoles",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ResourceRoles(ctx, req.(*QueryResourceRolesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EditableResources_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEditableResourcesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EditableResources(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/crude.crude.Query/EditableResources",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EditableResources(ctx, req.(*QueryEditableResourcesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ResourceSchema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetResourceSchemaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ResourceSchema(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/crude.crude.Query/ResourceSchema",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ResourceSchema(ctx, req.(*QueryGetResourceSchemaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ResourceSchemaAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllResourceSchemaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ResourceSchemaAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/crude.crude.Query/ResourceSchemaAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ResourceSchemaAll(ctx, req.(*QueryAllResourceSchemaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Namespace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetNamespaceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Namespace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/crude.crude.Query/Namespace",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Namespace(ctx, req.(*QueryGetNamespaceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_NamespaceAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllNamespaceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).NamespaceAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/crude.crude.Query/NamespaceAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).NamespaceAll(ctx, req.(*QueryAllNamespaceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ResourceByName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryResourceByNameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ResourceByName(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/crude.crude.Query/ResourceByName",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ResourceByName(ctx, req.(*QueryResourceByNameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ResourcesByNamePrefix_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryResourcesByNamePrefixRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ResourcesByNamePrefix(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/crude.crude.Query/ResourcesByNamePrefix",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ResourcesByNamePrefix(ctx, req.(*QueryResourcesByNamePrefixRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ResourcesInNamespace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryResourcesInNamespaceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ResourcesInNamespace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/crude.crude.Query/ResourcesInNamespace",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ResourcesInNamespace(ctx, req.(*QueryResourcesInNamespaceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ResourceWithProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryResourceWithProofRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ResourceWithProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/crude.crude.Query/ResourceWithProof",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ResourceWithProof(ctx, req.(*QueryResourceWithProofRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ResourceStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryResourceStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ResourceStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/crude.crude.Query/ResourceStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ResourceStats(ctx, req.(*QueryResourceStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ResourceReplications_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryResourceReplicationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ResourceReplications(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/crude.crude.Query/ResourceReplications",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ResourceReplications(ctx, req.(*QueryResourceReplicationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ResourceReplica_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryResourceReplicaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ResourceReplica(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/crude.crude.Query/ResourceReplica",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ResourceReplica(ctx, req.(*QueryResourceReplicaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "crude.crude.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "Resource",
+			Handler:    _Query_Resource_Handler,
+		},
+		{
+			MethodName: "ResourceAll",
+			Handler:    _Query_ResourceAll_Handler,
+		},
+		{
+			MethodName: "ResourcesByCreator",
+			Handler:    _Query_ResourcesByCreator_Handler,
+		},
+		{
+			MethodName: "ResourcesByOwner",
+			Handler:    _Query_ResourcesByOwner_Handler,
+		},
+		{
+			MethodName: "ResourceHistory",
+			Handler:    _Query_ResourceHistory_Handler,
+		},
+		{
+			MethodName: "ResourceAtRevision",
+			Handler:    _Query_ResourceAtRevision_Handler,
+		},
+		{
+			MethodName: "ResourceTransferOffer",
+			Handler:    _Query_ResourceTransferOffer_Handler,
+		},
+		{
+			MethodName: "ResourceTransferOfferAll",
+			Handler:    _Query_ResourceTransferOfferAll_Handler,
+		},
+		{
+			MethodName: "ResourcesByAttribute",
+			Handler:    _Query_ResourcesByAttribute_Handler,
+		},
+		{
+			MethodName: "ResourcesByTag",
+			Handler:    _Query_ResourcesByTag_Handler,
+		},
+		{
+			MethodName: "ResourceRoles",
+			Handler:    _Query_ResourceRoles_Handler,
+		},
+		{
+			MethodName: "EditableResources",
+			Handler:    _Query_EditableResources_Handler,
+		},
+		{
+			MethodName: "ResourceSchema",
+			Handler:    _Query_ResourceSchema_Handler,
+		},
+		{
+			MethodName: "ResourceSchemaAll",
+			Handler:    _Query_ResourceSchemaAll_Handler,
+		},
+		{
+			MethodName: "Namespace",
+			Handler:    _Query_Namespace_Handler,
+		},
+		{
+			MethodName: "NamespaceAll",
+			Handler:    _Query_NamespaceAll_Handler,
+		},
+		{
+			MethodName: "ResourceByName",
+			Handler:    _Query_ResourceByName_Handler,
+		},
+		{
+			MethodName: "ResourcesByNamePrefix",
+			Handler:    _Query_ResourcesByNamePrefix_Handler,
+		},
+		{
+			MethodName: "ResourcesInNamespace",
+			Handler:    _Query_ResourcesInNamespace_Handler,
+		},
+		{
+			MethodName: "ResourceWithProof",
+			Handler:    _Query_ResourceWithProof_Handler,
+		},
+		{
+			MethodName: "ResourceStats",
+			Handler:    _Query_ResourceStats_Handler,
+		},
+		{
+			MethodName: "ResourceReplications",
+			Handler:    _Query_ResourceReplications_Handler,
+		},
+		{
+			MethodName: "ResourceReplica",
+			Handler:    _Query_ResourceReplica_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "crude/crude/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetResourceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetResourceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetResourceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetResourceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetResourceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetResourceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Resource.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllResourceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllResourceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllResourceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CountOnly {
+		i--
+		if m.CountOnly {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Reverse {
+		i--
+		if m.Reverse {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.ValueRange != nil {
+		{
+			size, err := m.ValueRange.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.NamePrefix) > 0 {
+		i -= len(m.NamePrefix)
+		copy(dAtA[i:], m.NamePrefix)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.NamePrefix)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllResourceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllResourceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllResourceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Count != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Resource) > 0 {
+		for iNdEx := len(m.Resource) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Resource[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryResourcesByCreatorRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryResourcesByCreatorRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryResourcesByCreatorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryResourcesByCreatorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryResourcesByCreatorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryResourcesByCreatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Resource) > 0 {
+		for iNdEx := len(m.Resource) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Resource[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryResourceHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryResourceHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryResourceHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryResourceHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryResourceHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryResourceHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Revisions) > 0 {
+		for iNdEx := len(m.Revisions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Revisions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryResourceAtRevisionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryResourceAtRevisionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryResourceAtRevisionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Revision != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Revision))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryResourceAtRevisionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryResourceAtRevisionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryResourceAtRevisionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Revision.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryResourcesByOwnerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryResourcesByOwnerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryResourcesByOwnerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryResourcesByOwnerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryResourcesByOwnerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryResourcesByOwnerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Resource) > 0 {
+		for iNdEx := len(m.Resource) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Resource[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryResourcesByAttributeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryResourcesByAttributeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryResourcesByAttributeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryResourcesByAttributeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryResourcesByAttributeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryResourcesByAttributeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Resource) > 0 {
+		for iNdEx := len(m.Resource) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Resource[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryResourcesByTagRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryResourcesByTagRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryResourcesByTagRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Tag) > 0 {
+		i -= len(m.Tag)
+		copy(dAtA[i:], m.Tag)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Tag)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryResourcesByTagResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryResourcesByTagResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryResourcesByTagResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Resource) > 0 {
+		for iNdEx := len(m.Resource) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Resource[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetResourceTransferOfferRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetResourceTransferOfferRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetResourceTransferOfferRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ResourceId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ResourceId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetResourceTransferOfferResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetResourceTransferOfferResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetResourceTransferOfferResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.ResourceTransferOffer.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllResourceTransferOfferRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllResourceTransferOfferRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllResourceTransferOfferRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllResourceTransferOfferResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllResourceTransferOfferResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllResourceTransferOfferResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ResourceTransferOffer) > 0 {
+		for iNdEx := len(m.ResourceTransferOffer) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ResourceTransferOffer[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryResourceRolesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryResourceRolesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryResourceRolesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryResourceRolesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryResourceRolesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryResourceRolesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Grants) > 0 {
+		for iNdEx := len(m.Grants) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Grants[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEditableResourcesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEditableResourcesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEditableResourcesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEditableResourcesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEditableResourcesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEditableResourcesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Grants) > 0 {
+		for iNdEx := len(m.Grants) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Grants[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetResourceSchemaRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetResourceSchemaRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetResourceSchemaRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Version != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetResourceSchemaResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetResourceSchemaResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetResourceSchemaResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.ResourceSchema.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllResourceSchemaRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllResourceSchemaRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllResourceSchemaRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllResourceSchemaResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllResourceSchemaResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllResourceSchemaResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ResourceSchema) > 0 {
+		for iNdEx := len(m.ResourceSchema) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ResourceSchema[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetNamespaceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetNamespaceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetNamespaceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetNamespaceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetNamespaceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetNamespaceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Namespace.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllNamespaceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllNamespaceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllNamespaceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllNamespaceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllNamespaceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllNamespaceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Namespace) > 0 {
+		for iNdEx := len(m.Namespace) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Namespace[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryResourcesInNamespaceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryResourcesInNamespaceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryResourcesInNamespaceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryResourcesInNamespaceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryResourcesInNamespaceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryResourcesInNamespaceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Resource) > 0 {
+		for iNdEx := len(m.Resource) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Resource[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryResourceByNameRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryResourceByNameRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryResourceByNameRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryResourceByNameResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryResourceByNameResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryResourceByNameResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Resource.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryResourcesByNamePrefixRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryResourcesByNamePrefixRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryResourcesByNamePrefixRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Prefix) > 0 {
+		i -= len(m.Prefix)
+		copy(dAtA[i:], m.Prefix)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Prefix)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryResourcesByNamePrefixResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryResourcesByNamePrefixResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryResourcesByNamePrefixResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Resource) > 0 {
+		for iNdEx := len(m.Resource) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Resource[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryResourceStatsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryResourceStatsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryResourceStatsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Top != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Top))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryResourceStatsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryResourceStatsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryResourceStatsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TopCreators) > 0 {
+		for iNdEx := len(m.TopCreators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TopCreators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.ValueMax != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ValueMax))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.ValueMin != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ValueMin))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.ValueSum.Size()
+		i -= size
+		if _, err := m.ValueSum.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.ValueCount != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ValueCount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.CreatorCount != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CreatorCount))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.LiveCount != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.LiveCount))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryResourceWithProofRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryResourceWithProofRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryResourceWithProofRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryResourceWithProofResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryResourceWithProofResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryResourceWithProofResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Proof != nil {
+		{
+			size, err := m.Proof.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Resource != nil {
+		{
+			size, err := m.Resource.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryResourceReplicationsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryResourceReplicationsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryResourceReplicationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryResourceReplicationsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryResourceReplicationsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryResourceReplicationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Replication) > 0 {
+		for iNdEx := len(m.Replication) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Replication[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryResourceReplicaRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryResourceReplicaRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryResourceReplicaRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SourceId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SourceId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryResourceReplicaResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryResourceReplicaResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryResourceReplicaResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Resource.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Replica.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGetResourceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryGetResourceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Resource.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllResourceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.NamePrefix)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ValueRange != nil {
+		l = m.ValueRange.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Reverse {
+		n += 2
+	}
+	if m.CountOnly {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryAllResourceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Resource) > 0 {
+		for _, e := range m.Resource {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Count != 0 {
+		n += 1 + sovQuery(uint64(m.Count))
+	}
+	return n
+}
+
+func (m *QueryResourcesByCreatorRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryResourcesByCreatorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Resource) > 0 {
+		for _, e := range m.Resource {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryResourceHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryResourceHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Revisions) > 0 {
+		for _, e := range m.Revisions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryResourceAtRevisionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	if m.Revision != 0 {
+		n += 1 + sovQuery(uint64(m.Revision))
+	}
+	return n
+}
+
+func (m *QueryResourceAtRevisionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Revision.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryResourcesByOwnerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryResourcesByOwnerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Resource) > 0 {
+		for _, e := range m.Resource {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryResourcesByAttributeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryResourcesByAttributeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Resource) > 0 {
+		for _, e := range m.Resource {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryResourcesByTagRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Tag)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryResourcesByTagResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Resource) > 0 {
+		for _, e := range m.Resource {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetResourceTransferOfferRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ResourceId != 0 {
+		n += 1 + sovQuery(uint64(m.ResourceId))
+	}
+	return n
+}
+
+func (m *QueryGetResourceTransferOfferResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ResourceTransferOffer.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllResourceTransferOfferRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllResourceTransferOfferResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ResourceTransferOffer) > 0 {
+		for _, e := range m.ResourceTransferOffer {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryResourceRolesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryResourceRolesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Grants) > 0 {
+		for _, e := range m.Grants {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryEditableResourcesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryEditableResourcesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Grants) > 0 {
+		for _, e := range m.Grants {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetResourceSchemaRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Version != 0 {
+		n += 1 + sovQuery(uint64(m.Version))
+	}
+	return n
+}
+
+func (m *QueryGetResourceSchemaResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ResourceSchema.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllResourceSchemaRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllResourceSchemaResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ResourceSchema) > 0 {
+		for _, e := range m.ResourceSchema {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetNamespaceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetNamespaceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Namespace.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllNamespaceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllNamespaceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Namespace) > 0 {
+		for _, e := range m.Namespace {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryResourcesInNamespaceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryResourcesInNamespaceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Resource) > 0 {
+		for _, e := range m.Resource {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryResourceByNameRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryResourceByNameResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Resource.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryResourcesByNamePrefixRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Prefix)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryResourcesByNamePrefixResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Resource) > 0 {
+		for _, e := range m.Resource {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryResourceStatsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Top != 0 {
+		n += 1 + sovQuery(uint64(m.Top))
+	}
+	return n
+}
+
+func (m *QueryResourceStatsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LiveCount != 0 {
+		n += 1 + sovQuery(uint64(m.LiveCount))
+	}
+	if m.CreatorCount != 0 {
+		n += 1 + sovQuery(uint64(m.CreatorCount))
+	}
+	if m.ValueCount != 0 {
+		n += 1 + sovQuery(uint64(m.ValueCount))
+	}
+	l = m.ValueSum.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.ValueMin != 0 {
+		n += 1 + sovQuery(uint64(m.ValueMin))
+	}
+	if m.ValueMax != 0 {
+		n += 1 + sovQuery(uint64(m.ValueMax))
+	}
+	if len(m.TopCreators) > 0 {
+		for _, e := range m.TopCreators {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryResourceWithProofRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryResourceWithProofResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Resource != nil {
+		l = m.Resource.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Proof != nil {
+		l = m.Proof.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *QueryResourceReplicationsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryResourceReplicationsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Replication) > 0 {
+		for _, e := range m.Replication {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryResourceReplicaRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.SourceId != 0 {
+		n += 1 + sovQuery(uint64(m.SourceId))
+	}
+	return n
+}
+
+func (m *QueryResourceReplicaResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Replica.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Resource.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetResourceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetResourceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetResourceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetResourceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetResourceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetResourceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Resource", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Resource.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllResourceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllResourceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllResourceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NamePrefix", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NamePrefix = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValueRange", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ValueRange == nil {
+				m.ValueRange = &ResourceValueBounds{}
+			}
+			if err := m.ValueRange.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reverse", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Reverse = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CountOnly", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.CountOnly = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllResourceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllResourceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllResourceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Resource", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Resource = append(m.Resource, Resource{})
+			if err := m.Resource[len(m.Resource)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryResourcesByCreatorRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryResourcesByCreatorRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryResourcesByCreatorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryResourcesByCreatorResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryResourcesByCreatorResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryResourcesByCreatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Resource", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Resource = append(m.Resource, Resource{})
+			if err := m.Resource[len(m.Resource)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryResourceHistoryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryResourceHistoryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryResourceHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryResourceHistoryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryResourceHistoryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryResourceHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revisions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Revisions = append(m.Revisions, ResourceRevision{})
+			if err := m.Revisions[len(m.Revisions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryResourceAtRevisionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryResourceAtRevisionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryResourceAtRevisionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+			}
+			m.Revision = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Revision |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryResourceAtRevisionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryResourceAtRevisionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryResourceAtRevisionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Revision.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryResourcesByOwnerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryResourcesByOwnerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryResourcesByOwnerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryResourcesByOwnerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryResourcesByOwnerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryResourcesByOwnerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Resource", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Resource = append(m.Resource, Resource{})
+			if err := m.Resource[len(m.Resource)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryResourcesByAttributeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryResourcesByAttributeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryResourcesByAttributeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryResourcesByAttributeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryResourcesByAttributeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryResourcesByAttributeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Resource", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Resource = append(m.Resource, Resource{})
+			if err := m.Resource[len(m.Resource)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryResourcesByTagRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryResourcesByTagRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryResourcesByTagRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tag", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tag = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryResourcesByTagResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryResourcesByTagResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryResourcesByTagResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Resource", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Resource = append(m.Resource, Resource{})
+			if err := m.Resource[len(m.Resource)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetResourceTransferOfferRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetResourceTransferOfferRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetResourceTransferOfferRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceId", wireType)
+			}
+			m.ResourceId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ResourceId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetResourceTransferOfferResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetResourceTransferOfferResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetResourceTransferOfferResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceTransferOffer", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ResourceTransferOffer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllResourceTransferOfferRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllResourceTransferOfferRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllResourceTransferOfferRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllResourceTransferOfferResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllResourceTransferOfferResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllResourceTransferOfferResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceTransferOffer", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ResourceTransferOffer = append(m.ResourceTransferOffer, ResourceTransferOffer{})
+			if err := m.ResourceTransferOffer[len(m.ResourceTransferOffer)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryResourceRolesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryResourceRolesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryResourceRolesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryResourceRolesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryResourceRolesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryResourceRolesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Grants", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Grants = append(m.Grants, ResourceRoleGrant{})
+			if err := m.Grants[len(m.Grants)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEditableResourcesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEditableResourcesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEditableResourcesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEditableResourcesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEditableResourcesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEditableResourcesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Grants", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Grants = append(m.Grants, ResourceRoleGrant{})
+			if err := m.Grants[len(m.Grants)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetResourceSchemaRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetResourceSchemaRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetResourceSchemaRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetResourceSchemaResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetResourceSchemaResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetResourceSchemaResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceSchema", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ResourceSchema.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllResourceSchemaRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllResourceSchemaRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllResourceSchemaRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllResourceSchemaResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllResourceSchemaResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllResourceSchemaResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceSchema", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ResourceSchema = append(m.ResourceSchema, ResourceSchema{})
+			if err := m.ResourceSchema[len(m.ResourceSchema)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetNamespaceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetNamespaceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetNamespaceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetNamespaceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetNamespaceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetNamespaceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Namespace.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllNamespaceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllNamespaceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllNamespaceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllNamespaceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllNamespaceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllNamespaceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = append(m.Namespace, Namespace{})
+			if err := m.Namespace[len(m.Namespace)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryResourcesInNamespaceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryResourcesInNamespaceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryResourcesInNamespaceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryResourcesInNamespaceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryResourcesInNamespaceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryResourcesInNamespaceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Resource", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Resource = append(m.Resource, Resource{})
+			if err := m.Resource[len(m.Resource)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryResourceByNameRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryResourceByNameRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryResourceByNameRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryResourceByNameResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryResourceByNameResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryResourceByNameResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Resource", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Resource.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryResourcesByNamePrefixRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryResourcesByNamePrefixRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryResourcesByNamePrefixRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Prefix", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Prefix = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryResourcesByNamePrefixResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryResourcesByNamePrefixResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryResourcesByNamePrefixResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Resource", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Resource = append(m.Resource, Resource{})
+			if err := m.Resource[len(m.Resource)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryResourceStatsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryResourceStatsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryResourceStatsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Top", wireType)
+			}
+			m.Top = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Top |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryResourceStatsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryResourceStatsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryResourceStatsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiveCount", wireType)
+			}
+			m.LiveCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LiveCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatorCount", wireType)
+			}
+			m.CreatorCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreatorCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValueCount", wireType)
+			}
+			m.ValueCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValueCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValueSum", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ValueSum.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValueMin", wireType)
+			}
+			m.ValueMin = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValueMin |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValueMax", wireType)
+			}
+			m.ValueMax = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValueMax |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopCreators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TopCreators = append(m.TopCreators, CreatorResourceCount{})
+			if err := m.TopCreators[len(m.TopCreators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryResourceWithProofRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -816,12 +11157,31 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryResourceWithProofRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryResourceWithProofRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -843,7 +11203,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryResourceWithProofResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -866,15 +11226,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryResourceWithProofResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryResourceWithProofResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Resource", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -901,10 +11261,136 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Resource == nil {
+				m.Resource = &Resource{}
+			}
+			if err := m.Resource.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = append(m.Key[:0], dAtA[iNdEx:postIndex]...)
+			if m.Key == nil {
+				m.Key = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
+			if m.Value == nil {
+				m.Value = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Proof == nil {
+				m.Proof = &crypto.ProofOps{}
+			}
+			if err := m.Proof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -926,7 +11412,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetResourceRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryResourceReplicationsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -949,10 +11435,10 @@ func (m *QueryGetResourceRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetResourceRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryResourceReplicationsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetResourceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryResourceReplicationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -974,6 +11460,42 @@ func (m *QueryGetResourceRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -995,7 +11517,7 @@ func (m *QueryGetResourceRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetResourceResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryResourceReplicationsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1018,15 +11540,15 @@ func (m *QueryGetResourceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetResourceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryResourceReplicationsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetResourceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryResourceReplicationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Resource", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Replication", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1053,7 +11575,44 @@ func (m *QueryGetResourceResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Resource.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Replication = append(m.Replication, ResourceReplication{})
+			if err := m.Replication[len(m.Replication)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1078,7 +11637,7 @@ func (m *QueryGetResourceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllResourceRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryResourceReplicaRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1101,17 +11660,17 @@ func (m *QueryAllResourceRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllResourceRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryResourceReplicaRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllResourceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryResourceReplicaRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1121,28 +11680,43 @@ func (m *QueryAllResourceRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceId", wireType)
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.SourceId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SourceId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1164,7 +11738,7 @@ func (m *QueryAllResourceRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllResourceResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryResourceReplicaResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1187,15 +11761,15 @@ func (m *QueryAllResourceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllResourceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryResourceReplicaResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllResourceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryResourceReplicaResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Resource", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Replica", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1222,14 +11796,13 @@ func (m *QueryAllResourceResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Resource = append(m.Resource, Resource{})
-			if err := m.Resource[len(m.Resource)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Replica.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Resource", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1256,10 +11829,7 @@ func (m *QueryAllResourceResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Resource.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4,17 +4,28 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -22,11 +33,75 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// ResourceRole is the level of access an account holds on a resource.
+type ResourceRole int32
+
+const (
+	ResourceRole_RESOURCE_ROLE_UNSPECIFIED ResourceRole = 0
+	// RESOURCE_ROLE_OWNER is held implicitly by the resource owner and is never
+	// stored in the access control list.
+	ResourceRole_RESOURCE_ROLE_OWNER ResourceRole = 1
+	// RESOURCE_ROLE_EDITOR may update and revert the resource.
+	ResourceRole_RESOURCE_ROLE_EDITOR ResourceRole = 2
+	// RESOURCE_ROLE_ADMIN may additionally delete the resource and manage its
+	// access control list.
+	ResourceRole_RESOURCE_ROLE_ADMIN ResourceRole = 3
+)
+
+var ResourceRole_name = map[int32]string{
+	0: "RESOURCE_ROLE_UNSPECIFIED",
+	1: "RESOURCE_ROLE_OWNER",
+	2: "RESOURCE_ROLE_EDITOR",
+	3: "RESOURCE_ROLE_ADMIN",
+}
+
+var ResourceRole_value = map[string]int32{
+	"RESOURCE_ROLE_UNSPECIFIED": 0,
+	"RESOURCE_ROLE_OWNER":       1,
+	"RESOURCE_ROLE_EDITOR":      2,
+	"RESOURCE_ROLE_ADMIN":       3,
+}
+
+func (x ResourceRole) String() string {
+	return proto.EnumName(ResourceRole_name, int32(x))
+}
+
+func (ResourceRole) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_a4e983fdb4b8595a, []int{0}
+}
+
 type Resource struct {
-	Id      uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name    string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Value   uint64 `protobuf:"varint,3,opt,name=value,proto3" json:"value,omitempty"`
-	Creator string `protobuf:"bytes,4,opt,name=creator,proto3" json:"creator,omitempty"`
+	Id   uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// owner is the account currently allowed to manage the resource.
+	Owner string `protobuf:"bytes,4,opt,name=owner,proto3" json:"owner,omitempty"`
+	// creator is the account that originally created the resource.
+	Creator string `protobuf:"bytes,5,opt,name=creator,proto3" json:"creator,omitempty"`
+	// deposit is held in escrow by the module account and refunded to the
+	// owner when the resource is deleted.
+	Deposit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=deposit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"deposit"`
+	// expiryHeight is the block height from which the resource is pruned, zero
+	// if it never expires by height.
+	ExpiryHeight int64 `protobuf:"varint,7,opt,name=expiryHeight,proto3" json:"expiryHeight,omitempty"`
+	// expiryTime is the block time from which the resource is pruned, unset if
+	// it never expires by time.
+	ExpiryTime *time.Time `protobuf:"bytes,8,opt,name=expiryTime,proto3,stdtime" json:"expiryTime,omitempty"`
+	// version starts at 1 and is incremented on every change to the resource
+	// but renewals, for optimistic concurrency control through expectedVersion.
+	Version uint64 `protobuf:"varint,9,opt,name=version,proto3" json:"version,omitempty"`
+	// valueBounds, if set, are the limits the value is kept within.
+	ValueBounds *ResourceValueBounds `protobuf:"bytes,10,opt,name=valueBounds,proto3" json:"valueBounds,omitempty"`
+	// attributes are kept sorted by key, each key appears at most once.
+	Attributes []ResourceAttribute `protobuf:"bytes,11,rep,name=attributes,proto3" json:"attributes"`
+	// tags are free-form labels such as env:prod, kept sorted and unique.
+	Tags []string `protobuf:"bytes,12,rep,name=tags,proto3" json:"tags,omitempty"`
+	// payload is the typed value of the resource.
+	Payload *ResourcePayload `protobuf:"bytes,13,opt,name=payload,proto3" json:"payload,omitempty"`
+	// schema, if set, is the JSON schema the name of the resource conforms to.
+	Schema *ResourceSchemaRef `protobuf:"bytes,14,opt,name=schema,proto3" json:"schema,omitempty"`
+	// namespace, if set, is the namespace the resource was created in. It never
+	// changes afterwards.
+	Namespace string `protobuf:"bytes,15,opt,name=namespace,proto3" json:"namespace,omitempty"`
 }
 
 func (m *Resource) Reset()         { *m = Resource{} }
@@ -76,11 +151,11 @@ func (m *Resource) GetName() string {
 	return ""
 }
 
-func (m *Resource) GetValue() uint64 {
+func (m *Resource) GetOwner() string {
 	if m != nil {
-		return m.Value
+		return m.Owner
 	}
-	return 0
+	return ""
 }
 
 func (m *Resource) GetCreator() string {
@@ -90,115 +165,4711 @@ func (m *Resource) GetCreator() string {
 	return ""
 }
 
-func init() {
-	proto.RegisterType((*Resource)(nil), "crude.crude.Resource")
+func (m *Resource) GetDeposit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Deposit
+	}
+	return nil
 }
 
-func init() { proto.RegisterFile("crude/crude/resource.proto", fileDescriptor_a4e983fdb4b8595a) }
+func (m *Resource) GetExpiryHeight() int64 {
+	if m != nil {
+		return m.ExpiryHeight
+	}
+	return 0
+}
 
-var fileDescriptor_a4e983fdb4b8595a = []byte{
-	// 171 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4a, 0x2e, 0x2a, 0x4d,
-	0x49, 0xd5, 0x87, 0x90, 0x45, 0xa9, 0xc5, 0xf9, 0xa5, 0x45, 0xc9, 0xa9, 0x7a, 0x05, 0x45, 0xf9,
-	0x25, 0xf9, 0x42, 0xdc, 0x60, 0x51, 0x3d, 0x30, 0xa9, 0x14, 0xc7, 0xc5, 0x11, 0x04, 0x95, 0x16,
-	0xe2, 0xe3, 0x62, 0xca, 0x4c, 0x91, 0x60, 0x54, 0x60, 0xd4, 0x60, 0x09, 0x62, 0xca, 0x4c, 0x11,
-	0x12, 0xe2, 0x62, 0xc9, 0x4b, 0xcc, 0x4d, 0x95, 0x60, 0x52, 0x60, 0xd4, 0xe0, 0x0c, 0x02, 0xb3,
-	0x85, 0x44, 0xb8, 0x58, 0xcb, 0x12, 0x73, 0x4a, 0x53, 0x25, 0x98, 0xc1, 0xca, 0x20, 0x1c, 0x21,
-	0x09, 0x2e, 0xf6, 0xe4, 0xa2, 0xd4, 0xc4, 0x92, 0xfc, 0x22, 0x09, 0x16, 0xb0, 0x62, 0x18, 0xd7,
-	0x49, 0xf7, 0xc4, 0x23, 0x39, 0xc6, 0x0b, 0x8f, 0xe4, 0x18, 0x1f, 0x3c, 0x92, 0x63, 0x9c, 0xf0,
-	0x58, 0x8e, 0xe1, 0xc2, 0x63, 0x39, 0x86, 0x1b, 0x8f, 0xe5, 0x18, 0xa2, 0x84, 0x21, 0x8e, 0xab,
-	0x80, 0x3a, 0xb2, 0xa4, 0xb2, 0x20, 0xb5, 0x38, 0x89, 0x0d, 0xec, 0x44, 0x63, 0x40, 0x00, 0x00,
-	0x00, 0xff, 0xff, 0x36, 0x96, 0xb7, 0x67, 0xc0, 0x00, 0x00, 0x00,
+func (m *Resource) GetExpiryTime() *time.Time {
+	if m != nil {
+		return m.ExpiryTime
+	}
+	return nil
 }
 
-func (m *Resource) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Resource) GetVersion() uint64 {
+	if m != nil {
+		return m.Version
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *Resource) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *Resource) GetValueBounds() *ResourceValueBounds {
+	if m != nil {
+		return m.ValueBounds
+	}
+	return nil
 }
 
-func (m *Resource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintResource(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0x22
+func (m *Resource) GetAttributes() []ResourceAttribute {
+	if m != nil {
+		return m.Attributes
 	}
-	if m.Value != 0 {
-		i = encodeVarintResource(dAtA, i, uint64(m.Value))
-		i--
-		dAtA[i] = 0x18
+	return nil
+}
+
+func (m *Resource) GetTags() []string {
+	if m != nil {
+		return m.Tags
 	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintResource(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0x12
+	return nil
+}
+
+func (m *Resource) GetPayload() *ResourcePayload {
+	if m != nil {
+		return m.Payload
 	}
-	if m.Id != 0 {
-		i = encodeVarintResource(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
+	return nil
+}
+
+func (m *Resource) GetSchema() *ResourceSchemaRef {
+	if m != nil {
+		return m.Schema
+	}
+	return nil
+}
+
+func (m *Resource) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+// ResourcePayload is the typed value of a resource, exactly one arm is set.
+type ResourcePayload struct {
+	// Types that are valid to be assigned to Value:
+	//	*ResourcePayload_Uint64Value
+	//	*ResourcePayload_Int64Value
+	//	*ResourcePayload_DecValue
+	//	*ResourcePayload_StringValue
+	//	*ResourcePayload_BytesValue
+	//	*ResourcePayload_CoinValue
+	//	*ResourcePayload_JsonValue
+	Value isResourcePayload_Value `protobuf_oneof:"value"`
+}
+
+func (m *ResourcePayload) Reset()         { *m = ResourcePayload{} }
+func (m *ResourcePayload) String() string { return proto.CompactTextString(m) }
+func (*ResourcePayload) ProtoMessage()    {}
+func (*ResourcePayload) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a4e983fdb4b8595a, []int{1}
+}
+func (m *ResourcePayload) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ResourcePayload) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ResourcePayload.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ResourcePayload) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResourcePayload.Merge(m, src)
+}
+func (m *ResourcePayload) XXX_Size() int {
+	return m.Size()
+}
+func (m *ResourcePayload) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResourcePayload.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResourcePayload proto.InternalMessageInfo
+
+type isResourcePayload_Value interface {
+	isResourcePayload_Value()
+	MarshalTo([]byte) (int, error)
+	Size() int
+}
+
+type ResourcePayload_Uint64Value struct {
+	Uint64Value uint64 `protobuf:"varint,1,opt,name=uint64Value,proto3,oneof" json:"uint64Value,omitempty"`
+}
+type ResourcePayload_Int64Value struct {
+	Int64Value int64 `protobuf:"varint,2,opt,name=int64Value,proto3,oneof" json:"int64Value,omitempty"`
+}
+type ResourcePayload_DecValue struct {
+	DecValue cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=decValue,proto3,oneof,customtype=cosmossdk.io/math.LegacyDec" json:"decValue,omitempty"`
+}
+type ResourcePayload_StringValue struct {
+	StringValue string `protobuf:"bytes,4,opt,name=stringValue,proto3,oneof" json:"stringValue,omitempty"`
+}
+type ResourcePayload_BytesValue struct {
+	BytesValue []byte `protobuf:"bytes,5,opt,name=bytesValue,proto3,oneof" json:"bytesValue,omitempty"`
+}
+type ResourcePayload_CoinValue struct {
+	CoinValue *types.Coin `protobuf:"bytes,6,opt,name=coinValue,proto3,oneof" json:"coinValue,omitempty"`
+}
+type ResourcePayload_JsonValue struct {
+	JsonValue string `protobuf:"bytes,7,opt,name=jsonValue,proto3,oneof" json:"jsonValue,omitempty"`
+}
+
+func (*ResourcePayload_Uint64Value) isResourcePayload_Value() {}
+func (*ResourcePayload_Int64Value) isResourcePayload_Value()  {}
+func (*ResourcePayload_DecValue) isResourcePayload_Value()    {}
+func (*ResourcePayload_StringValue) isResourcePayload_Value() {}
+func (*ResourcePayload_BytesValue) isResourcePayload_Value()  {}
+func (*ResourcePayload_CoinValue) isResourcePayload_Value()   {}
+func (*ResourcePayload_JsonValue) isResourcePayload_Value()   {}
+
+func (m *ResourcePayload) GetValue() isResourcePayload_Value {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+func (m *ResourcePayload) GetUint64Value() uint64 {
+	if x, ok := m.GetValue().(*ResourcePayload_Uint64Value); ok {
+		return x.Uint64Value
+	}
+	return 0
+}
+
+func (m *ResourcePayload) GetInt64Value() int64 {
+	if x, ok := m.GetValue().(*ResourcePayload_Int64Value); ok {
+		return x.Int64Value
+	}
+	return 0
+}
+
+func (m *ResourcePayload) GetStringValue() string {
+	if x, ok := m.GetValue().(*ResourcePayload_StringValue); ok {
+		return x.StringValue
+	}
+	return ""
+}
+
+func (m *ResourcePayload) GetBytesValue() []byte {
+	if x, ok := m.GetValue().(*ResourcePayload_BytesValue); ok {
+		return x.BytesValue
+	}
+	return nil
+}
+
+func (m *ResourcePayload) GetCoinValue() *types.Coin {
+	if x, ok := m.GetValue().(*ResourcePayload_CoinValue); ok {
+		return x.CoinValue
+	}
+	return nil
+}
+
+func (m *ResourcePayload) GetJsonValue() string {
+	if x, ok := m.GetValue().(*ResourcePayload_JsonValue); ok {
+		return x.JsonValue
+	}
+	return ""
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*ResourcePayload) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*ResourcePayload_Uint64Value)(nil),
+		(*ResourcePayload_Int64Value)(nil),
+		(*ResourcePayload_DecValue)(nil),
+		(*ResourcePayload_StringValue)(nil),
+		(*ResourcePayload_BytesValue)(nil),
+		(*ResourcePayload_CoinValue)(nil),
+		(*ResourcePayload_JsonValue)(nil),
+	}
+}
+
+// ResourceAttribute is a key/value pair attached to a resource.
+type ResourceAttribute struct {
+	Key   string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (m *ResourceAttribute) Reset()         { *m = ResourceAttribute{} }
+func (m *ResourceAttribute) String() string { return proto.CompactTextString(m) }
+func (*ResourceAttribute) ProtoMessage()    {}
+func (*ResourceAttribute) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a4e983fdb4b8595a, []int{2}
+}
+func (m *ResourceAttribute) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ResourceAttribute) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ResourceAttribute.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ResourceAttribute) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResourceAttribute.Merge(m, src)
+}
+func (m *ResourceAttribute) XXX_Size() int {
+	return m.Size()
+}
+func (m *ResourceAttribute) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResourceAttribute.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResourceAttribute proto.InternalMessageInfo
+
+func (m *ResourceAttribute) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *ResourceAttribute) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+// ResourceValueBounds are the inclusive limits of the value of a resource.
+type ResourceValueBounds struct {
+	Min uint64 `protobuf:"varint,1,opt,name=min,proto3" json:"min,omitempty"`
+	Max uint64 `protobuf:"varint,2,opt,name=max,proto3" json:"max,omitempty"`
+}
+
+func (m *ResourceValueBounds) Reset()         { *m = ResourceValueBounds{} }
+func (m *ResourceValueBounds) String() string { return proto.CompactTextString(m) }
+func (*ResourceValueBounds) ProtoMessage()    {}
+func (*ResourceValueBounds) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a4e983fdb4b8595a, []int{3}
+}
+func (m *ResourceValueBounds) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ResourceValueBounds) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ResourceValueBounds.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ResourceValueBounds) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResourceValueBounds.Merge(m, src)
+}
+func (m *ResourceValueBounds) XXX_Size() int {
+	return m.Size()
+}
+func (m *ResourceValueBounds) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResourceValueBounds.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResourceValueBounds proto.InternalMessageInfo
+
+func (m *ResourceValueBounds) GetMin() uint64 {
+	if m != nil {
+		return m.Min
+	}
+	return 0
+}
+
+func (m *ResourceValueBounds) GetMax() uint64 {
+	if m != nil {
+		return m.Max
+	}
+	return 0
+}
+
+// ResourceSchema is an immutable version of a JSON Schema that resource names
+// are validated against.
+type ResourceSchema struct {
+	Id      string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Version uint64 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	// owner registered the first version of the schema and is the only account
+	// that may register the following ones.
+	Owner string `protobuf:"bytes,3,opt,name=owner,proto3" json:"owner,omitempty"`
+	// document is the JSON Schema itself.
+	Document string `protobuf:"bytes,4,opt,name=document,proto3" json:"document,omitempty"`
+	// approved is set by the authority, see Params.onlyApprovedSchemas.
+	Approved bool `protobuf:"varint,5,opt,name=approved,proto3" json:"approved,omitempty"`
+}
+
+func (m *ResourceSchema) Reset()         { *m = ResourceSchema{} }
+func (m *ResourceSchema) String() string { return proto.CompactTextString(m) }
+func (*ResourceSchema) ProtoMessage()    {}
+func (*ResourceSchema) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a4e983fdb4b8595a, []int{4}
+}
+func (m *ResourceSchema) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ResourceSchema) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ResourceSchema.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ResourceSchema) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResourceSchema.Merge(m, src)
+}
+func (m *ResourceSchema) XXX_Size() int {
+	return m.Size()
+}
+func (m *ResourceSchema) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResourceSchema.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResourceSchema proto.InternalMessageInfo
+
+func (m *ResourceSchema) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *ResourceSchema) GetVersion() uint64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *ResourceSchema) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *ResourceSchema) GetDocument() string {
+	if m != nil {
+		return m.Document
+	}
+	return ""
+}
+
+func (m *ResourceSchema) GetApproved() bool {
+	if m != nil {
+		return m.Approved
+	}
+	return false
+}
+
+// ResourceSchemaRef points at a version of a registered ResourceSchema.
+type ResourceSchemaRef struct {
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// version zero stands for the latest version of the schema in messages and
+	// is resolved before the reference is stored.
+	Version uint64 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+}
+
+func (m *ResourceSchemaRef) Reset()         { *m = ResourceSchemaRef{} }
+func (m *ResourceSchemaRef) String() string { return proto.CompactTextString(m) }
+func (*ResourceSchemaRef) ProtoMessage()    {}
+func (*ResourceSchemaRef) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a4e983fdb4b8595a, []int{5}
+}
+func (m *ResourceSchemaRef) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ResourceSchemaRef) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ResourceSchemaRef.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ResourceSchemaRef) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResourceSchemaRef.Merge(m, src)
+}
+func (m *ResourceSchemaRef) XXX_Size() int {
+	return m.Size()
+}
+func (m *ResourceSchemaRef) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResourceSchemaRef.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResourceSchemaRef proto.InternalMessageInfo
+
+func (m *ResourceSchemaRef) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *ResourceSchemaRef) GetVersion() uint64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+// Namespace groups resources under a shared admin, quota and default access
+// control list.
+type Namespace struct {
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// admin holds RESOURCE_ROLE_ADMIN on every resource of the namespace and
+	// may create resources in it.
+	Admin string `protobuf:"bytes,2,opt,name=admin,proto3" json:"admin,omitempty"`
+	// maxResources caps the number of live resources in the namespace, zero
+	// disables the quota.
+	MaxResources uint64 `protobuf:"varint,3,opt,name=maxResources,proto3" json:"maxResources,omitempty"`
+	// defaultRoles apply to every resource of the namespace on top of their own
+	// access control list. Editors and admins may create resources in it.
+	DefaultRoles []NamespaceRoleGrant `protobuf:"bytes,4,rep,name=defaultRoles,proto3" json:"defaultRoles"`
+}
+
+func (m *Namespace) Reset()         { *m = Namespace{} }
+func (m *Namespace) String() string { return proto.CompactTextString(m) }
+func (*Namespace) ProtoMessage()    {}
+func (*Namespace) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a4e983fdb4b8595a, []int{6}
+}
+func (m *Namespace) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Namespace) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Namespace.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Namespace) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Namespace.Merge(m, src)
+}
+func (m *Namespace) XXX_Size() int {
+	return m.Size()
+}
+func (m *Namespace) XXX_DiscardUnknown() {
+	xxx_messageInfo_Namespace.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Namespace proto.InternalMessageInfo
+
+func (m *Namespace) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Namespace) GetAdmin() string {
+	if m != nil {
+		return m.Admin
+	}
+	return ""
+}
+
+func (m *Namespace) GetMaxResources() uint64 {
+	if m != nil {
+		return m.MaxResources
+	}
+	return 0
+}
+
+func (m *Namespace) GetDefaultRoles() []NamespaceRoleGrant {
+	if m != nil {
+		return m.DefaultRoles
+	}
+	return nil
+}
+
+// NamespaceRoleGrant is an entry of the default access control list of a
+// namespace.
+type NamespaceRoleGrant struct {
+	Address string       `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Role    ResourceRole `protobuf:"varint,2,opt,name=role,proto3,enum=crude.crude.ResourceRole" json:"role,omitempty"`
+}
+
+func (m *NamespaceRoleGrant) Reset()         { *m = NamespaceRoleGrant{} }
+func (m *NamespaceRoleGrant) String() string { return proto.CompactTextString(m) }
+func (*NamespaceRoleGrant) ProtoMessage()    {}
+func (*NamespaceRoleGrant) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a4e983fdb4b8595a, []int{7}
+}
+func (m *NamespaceRoleGrant) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NamespaceRoleGrant) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NamespaceRoleGrant.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *NamespaceRoleGrant) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NamespaceRoleGrant.Merge(m, src)
+}
+func (m *NamespaceRoleGrant) XXX_Size() int {
+	return m.Size()
+}
+func (m *NamespaceRoleGrant) XXX_DiscardUnknown() {
+	xxx_messageInfo_NamespaceRoleGrant.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NamespaceRoleGrant proto.InternalMessageInfo
+
+func (m *NamespaceRoleGrant) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *NamespaceRoleGrant) GetRole() ResourceRole {
+	if m != nil {
+		return m.Role
+	}
+	return ResourceRole_RESOURCE_ROLE_UNSPECIFIED
+}
+
+// ResourceRoleGrant is an entry of the access control list of a resource.
+type ResourceRoleGrant struct {
+	ResourceId uint64       `protobuf:"varint,1,opt,name=resourceId,proto3" json:"resourceId,omitempty"`
+	Address    string       `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Role       ResourceRole `protobuf:"varint,3,opt,name=role,proto3,enum=crude.crude.ResourceRole" json:"role,omitempty"`
+}
+
+func (m *ResourceRoleGrant) Reset()         { *m = ResourceRoleGrant{} }
+func (m *ResourceRoleGrant) String() string { return proto.CompactTextString(m) }
+func (*ResourceRoleGrant) ProtoMessage()    {}
+func (*ResourceRoleGrant) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a4e983fdb4b8595a, []int{8}
+}
+func (m *ResourceRoleGrant) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ResourceRoleGrant) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ResourceRoleGrant.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ResourceRoleGrant) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResourceRoleGrant.Merge(m, src)
+}
+func (m *ResourceRoleGrant) XXX_Size() int {
+	return m.Size()
+}
+func (m *ResourceRoleGrant) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResourceRoleGrant.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResourceRoleGrant proto.InternalMessageInfo
+
+func (m *ResourceRoleGrant) GetResourceId() uint64 {
+	if m != nil {
+		return m.ResourceId
+	}
+	return 0
+}
+
+func (m *ResourceRoleGrant) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *ResourceRoleGrant) GetRole() ResourceRole {
+	if m != nil {
+		return m.Role
+	}
+	return ResourceRole_RESOURCE_ROLE_UNSPECIFIED
+}
+
+// ResourceTransferOffer is a pending ownership transfer that the recipient
+// must accept before it takes effect.
+type ResourceTransferOffer struct {
+	ResourceId uint64 `protobuf:"varint,1,opt,name=resourceId,proto3" json:"resourceId,omitempty"`
+	Owner      string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	Recipient  string `protobuf:"bytes,3,opt,name=recipient,proto3" json:"recipient,omitempty"`
+}
+
+func (m *ResourceTransferOffer) Reset()         { *m = ResourceTransferOffer{} }
+func (m *ResourceTransferOffer) String() string { return proto.CompactTextString(m) }
+func (*ResourceTransferOffer) ProtoMessage()    {}
+func (*ResourceTransferOffer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a4e983fdb4b8595a, []int{9}
+}
+func (m *ResourceTransferOffer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ResourceTransferOffer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ResourceTransferOffer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ResourceTransferOffer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResourceTransferOffer.Merge(m, src)
+}
+func (m *ResourceTransferOffer) XXX_Size() int {
+	return m.Size()
+}
+func (m *ResourceTransferOffer) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResourceTransferOffer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResourceTransferOffer proto.InternalMessageInfo
+
+func (m *ResourceTransferOffer) GetResourceId() uint64 {
+	if m != nil {
+		return m.ResourceId
+	}
+	return 0
+}
+
+func (m *ResourceTransferOffer) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *ResourceTransferOffer) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
+// ResourceRevision is an immutable snapshot of a resource taken each time it
+// is created, updated or reverted.
+type ResourceRevision struct {
+	ResourceId  uint64           `protobuf:"varint,1,opt,name=resourceId,proto3" json:"resourceId,omitempty"`
+	Revision    uint64           `protobuf:"varint,2,opt,name=revision,proto3" json:"revision,omitempty"`
+	Name        string           `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Signer      string           `protobuf:"bytes,5,opt,name=signer,proto3" json:"signer,omitempty"`
+	BlockHeight int64            `protobuf:"varint,6,opt,name=blockHeight,proto3" json:"blockHeight,omitempty"`
+	BlockTime   time.Time        `protobuf:"bytes,7,opt,name=blockTime,proto3,stdtime" json:"blockTime"`
+	Payload     *ResourcePayload `protobuf:"bytes,8,opt,name=payload,proto3" json:"payload,omitempty"`
+}
+
+func (m *ResourceRevision) Reset()         { *m = ResourceRevision{} }
+func (m *ResourceRevision) String() string { return proto.CompactTextString(m) }
+func (*ResourceRevision) ProtoMessage()    {}
+func (*ResourceRevision) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a4e983fdb4b8595a, []int{10}
+}
+func (m *ResourceRevision) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ResourceRevision) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ResourceRevision.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ResourceRevision) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResourceRevision.Merge(m, src)
+}
+func (m *ResourceRevision) XXX_Size() int {
+	return m.Size()
+}
+func (m *ResourceRevision) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResourceRevision.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResourceRevision proto.InternalMessageInfo
+
+func (m *ResourceRevision) GetResourceId() uint64 {
+	if m != nil {
+		return m.ResourceId
+	}
+	return 0
+}
+
+func (m *ResourceRevision) GetRevision() uint64 {
+	if m != nil {
+		return m.Revision
+	}
+	return 0
+}
+
+func (m *ResourceRevision) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ResourceRevision) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
+}
+
+func (m *ResourceRevision) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *ResourceRevision) GetBlockTime() time.Time {
+	if m != nil {
+		return m.BlockTime
+	}
+	return time.Time{}
+}
+
+func (m *ResourceRevision) GetPayload() *ResourcePayload {
+	if m != nil {
+		return m.Payload
+	}
+	return nil
+}
+
+// ResourceTotals are the running aggregates over the live resources, updated
+// as resources are written and removed.
+type ResourceTotals struct {
+	LiveCount    uint64 `protobuf:"varint,1,opt,name=liveCount,proto3" json:"liveCount,omitempty"`
+	CreatorCount uint64 `protobuf:"varint,2,opt,name=creatorCount,proto3" json:"creatorCount,omitempty"`
+	// valueCount is the number of resources holding a uint64 payload, the only
+	// ones that are summed.
+	ValueCount uint64                `protobuf:"varint,3,opt,name=valueCount,proto3" json:"valueCount,omitempty"`
+	ValueSum   cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=valueSum,proto3,customtype=cosmossdk.io/math.Int" json:"valueSum"`
+}
+
+func (m *ResourceTotals) Reset()         { *m = ResourceTotals{} }
+func (m *ResourceTotals) String() string { return proto.CompactTextString(m) }
+func (*ResourceTotals) ProtoMessage()    {}
+func (*ResourceTotals) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a4e983fdb4b8595a, []int{11}
+}
+func (m *ResourceTotals) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ResourceTotals) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ResourceTotals.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ResourceTotals) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResourceTotals.Merge(m, src)
+}
+func (m *ResourceTotals) XXX_Size() int {
+	return m.Size()
+}
+func (m *ResourceTotals) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResourceTotals.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResourceTotals proto.InternalMessageInfo
+
+func (m *ResourceTotals) GetLiveCount() uint64 {
+	if m != nil {
+		return m.LiveCount
+	}
+	return 0
+}
+
+func (m *ResourceTotals) GetCreatorCount() uint64 {
+	if m != nil {
+		return m.CreatorCount
+	}
+	return 0
+}
+
+func (m *ResourceTotals) GetValueCount() uint64 {
+	if m != nil {
+		return m.ValueCount
+	}
+	return 0
+}
+
+// CreatorResourceCount is the number of live resources created by an address.
+type CreatorResourceCount struct {
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Count   uint64 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (m *CreatorResourceCount) Reset()         { *m = CreatorResourceCount{} }
+func (m *CreatorResourceCount) String() string { return proto.CompactTextString(m) }
+func (*CreatorResourceCount) ProtoMessage()    {}
+func (*CreatorResourceCount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a4e983fdb4b8595a, []int{12}
+}
+func (m *CreatorResourceCount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CreatorResourceCount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CreatorResourceCount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CreatorResourceCount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreatorResourceCount.Merge(m, src)
+}
+func (m *CreatorResourceCount) XXX_Size() int {
+	return m.Size()
+}
+func (m *CreatorResourceCount) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreatorResourceCount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreatorResourceCount proto.InternalMessageInfo
+
+func (m *CreatorResourceCount) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *CreatorResourceCount) GetCount() uint64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+// ResourceReplication subscribes a resource to be replicated to the
+// counterparty of an IBC channel. Updates and the deletion of the resource are
+// sent on the channel until the subscription is dropped.
+type ResourceReplication struct {
+	ResourceId uint64 `protobuf:"varint,1,opt,name=resourceId,proto3" json:"resourceId,omitempty"`
+	ChannelId  string `protobuf:"bytes,2,opt,name=channelId,proto3" json:"channelId,omitempty"`
+	// timeoutPeriod is how long each replication packet may wait to be relayed
+	// before timing out, which closes the ordered channel.
+	TimeoutPeriod time.Duration `protobuf:"bytes,3,opt,name=timeoutPeriod,proto3,stdduration" json:"timeoutPeriod"`
+	// replicaId is the id of the replica on the counterparty chain, set once the
+	// creation of the replica is acknowledged.
+	ReplicaId uint64 `protobuf:"varint,4,opt,name=replicaId,proto3" json:"replicaId,omitempty"`
+}
+
+func (m *ResourceReplication) Reset()         { *m = ResourceReplication{} }
+func (m *ResourceReplication) String() string { return proto.CompactTextString(m) }
+func (*ResourceReplication) ProtoMessage()    {}
+func (*ResourceReplication) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a4e983fdb4b8595a, []int{13}
+}
+func (m *ResourceReplication) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ResourceReplication) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ResourceReplication.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ResourceReplication) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResourceReplication.Merge(m, src)
+}
+func (m *ResourceReplication) XXX_Size() int {
+	return m.Size()
+}
+func (m *ResourceReplication) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResourceReplication.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResourceReplication proto.InternalMessageInfo
+
+func (m *ResourceReplication) GetResourceId() uint64 {
+	if m != nil {
+		return m.ResourceId
+	}
+	return 0
+}
+
+func (m *ResourceReplication) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *ResourceReplication) GetTimeoutPeriod() time.Duration {
+	if m != nil {
+		return m.TimeoutPeriod
+	}
+	return 0
+}
+
+func (m *ResourceReplication) GetReplicaId() uint64 {
+	if m != nil {
+		return m.ReplicaId
+	}
+	return 0
+}
+
+// ResourceReplica links a resource received over an IBC channel to the
+// resource it mirrors on the counterparty chain.
+type ResourceReplica struct {
+	// channelId is the channel on this chain the replica is received through.
+	ChannelId string `protobuf:"bytes,1,opt,name=channelId,proto3" json:"channelId,omitempty"`
+	// sourceId is the id of the resource on the counterparty chain.
+	SourceId uint64 `protobuf:"varint,2,opt,name=sourceId,proto3" json:"sourceId,omitempty"`
+	// resourceId is the id of the replica on this chain.
+	ResourceId uint64 `protobuf:"varint,3,opt,name=resourceId,proto3" json:"resourceId,omitempty"`
+}
+
+func (m *ResourceReplica) Reset()         { *m = ResourceReplica{} }
+func (m *ResourceReplica) String() string { return proto.CompactTextString(m) }
+func (*ResourceReplica) ProtoMessage()    {}
+func (*ResourceReplica) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a4e983fdb4b8595a, []int{14}
+}
+func (m *ResourceReplica) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ResourceReplica) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ResourceReplica.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ResourceReplica) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResourceReplica.Merge(m, src)
+}
+func (m *ResourceReplica) XXX_Size() int {
+	return m.Size()
+}
+func (m *ResourceReplica) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResourceReplica.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResourceReplica proto.InternalMessageInfo
+
+func (m *ResourceReplica) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *ResourceReplica) GetSourceId() uint64 {
+	if m != nil {
+		return m.SourceId
+	}
+	return 0
+}
+
+func (m *ResourceReplica) GetResourceId() uint64 {
+	if m != nil {
+		return m.ResourceId
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("crude.crude.ResourceRole", ResourceRole_name, ResourceRole_value)
+	proto.RegisterType((*Resource)(nil), "crude.crude.Resource")
+	proto.RegisterType((*ResourcePayload)(nil), "crude.crude.ResourcePayload")
+	proto.RegisterType((*ResourceAttribute)(nil), "crude.crude.ResourceAttribute")
+	proto.RegisterType((*ResourceValueBounds)(nil), "crude.crude.ResourceValueBounds")
+	proto.RegisterType((*ResourceSchema)(nil), "crude.crude.ResourceSchema")
+	proto.RegisterType((*ResourceSchemaRef)(nil), "crude.crude.ResourceSchemaRef")
+	proto.RegisterType((*Namespace)(nil), "crude.crude.Namespace")
+	proto.RegisterType((*NamespaceRoleGrant)(nil), "crude.crude.NamespaceRoleGrant")
+	proto.RegisterType((*ResourceRoleGrant)(nil), "crude.crude.ResourceRoleGrant")
+	proto.RegisterType((*ResourceTransferOffer)(nil), "crude.crude.ResourceTransferOffer")
+	proto.RegisterType((*ResourceRevision)(nil), "crude.crude.ResourceRevision")
+	proto.RegisterType((*ResourceTotals)(nil), "crude.crude.ResourceTotals")
+	proto.RegisterType((*CreatorResourceCount)(nil), "crude.crude.CreatorResourceCount")
+	proto.RegisterType((*ResourceReplication)(nil), "crude.crude.ResourceReplication")
+	proto.RegisterType((*ResourceReplica)(nil), "crude.crude.ResourceReplica")
+}
+
+func init() { proto.RegisterFile("crude/crude/resource.proto", fileDescriptor_a4e983fdb4b8595a) }
+
+var fileDescriptor_a4e983fdb4b8595a = []byte{
+	// 1368 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x57, 0xcf, 0x6f, 0x1b, 0xc5,
+	0x17, 0xf7, 0x7a, 0x37, 0xb1, 0xfd, 0x9c, 0x26, 0xce, 0xd4, 0xed, 0x77, 0xe3, 0x6f, 0xb0, 0x2d,
+	0x9f, 0xa2, 0xa2, 0xd8, 0xb4, 0x54, 0x45, 0x80, 0x90, 0x5a, 0xc7, 0x6e, 0xe3, 0xaa, 0xc4, 0xd5,
+	0x24, 0x05, 0x09, 0x09, 0xaa, 0xf1, 0xee, 0xd8, 0x59, 0xe2, 0xdd, 0xb1, 0xf6, 0x47, 0x48, 0x24,
+	0x24, 0xae, 0x3d, 0xf6, 0xc8, 0x1d, 0xb8, 0x70, 0x42, 0x88, 0x1b, 0xfc, 0x01, 0x3d, 0x56, 0x9c,
+	0x50, 0x0f, 0x2d, 0x6a, 0x0f, 0x1c, 0xf8, 0x1f, 0x10, 0x9a, 0x1f, 0xbb, 0xde, 0x4d, 0x02, 0x29,
+	0x17, 0x67, 0xdf, 0x9b, 0xf7, 0x7b, 0x3e, 0xef, 0xbd, 0x09, 0xd4, 0x2c, 0x3f, 0xb2, 0x69, 0x47,
+	0xfe, 0xfa, 0x34, 0x60, 0x91, 0x6f, 0xd1, 0xf6, 0xcc, 0x67, 0x21, 0x43, 0x65, 0xc1, 0x6d, 0x8b,
+	0xdf, 0xda, 0x2a, 0x71, 0x1d, 0x8f, 0x75, 0xc4, 0xaf, 0x3c, 0xaf, 0xad, 0x59, 0x2c, 0x70, 0x59,
+	0xf0, 0x50, 0x50, 0x1d, 0x49, 0xa8, 0xa3, 0xea, 0x84, 0x4d, 0x98, 0xe4, 0xf3, 0x2f, 0xc5, 0xad,
+	0x4f, 0x18, 0x9b, 0x4c, 0x69, 0x47, 0x50, 0xa3, 0x68, 0xdc, 0xb1, 0x23, 0x9f, 0x84, 0x0e, 0xf3,
+	0xd4, 0x79, 0xe3, 0xe4, 0x79, 0xe8, 0xb8, 0x34, 0x08, 0x89, 0x3b, 0x8b, 0x0d, 0x48, 0x27, 0x9d,
+	0x11, 0x09, 0x68, 0xe7, 0xf0, 0xea, 0x88, 0x86, 0xe4, 0x6a, 0xc7, 0x62, 0x8e, 0x32, 0xd0, 0xfa,
+	0xcb, 0x80, 0x22, 0x56, 0x49, 0xa0, 0x65, 0xc8, 0x3b, 0xb6, 0xa9, 0x35, 0xb5, 0x0d, 0x03, 0xe7,
+	0x1d, 0x1b, 0x21, 0x30, 0x3c, 0xe2, 0x52, 0x33, 0xdf, 0xd4, 0x36, 0x4a, 0x58, 0x7c, 0xa3, 0x2a,
+	0x2c, 0xb0, 0x2f, 0x3c, 0xea, 0x9b, 0x86, 0x60, 0x4a, 0x02, 0x99, 0x50, 0xb0, 0x7c, 0x4a, 0x42,
+	0xe6, 0x9b, 0x0b, 0x82, 0x1f, 0x93, 0x88, 0x42, 0xc1, 0xa6, 0x33, 0x16, 0x38, 0xa1, 0xb9, 0xd8,
+	0xd4, 0x37, 0xca, 0xd7, 0xd6, 0xda, 0x2a, 0x6f, 0x1e, 0x52, 0x5b, 0x85, 0xd4, 0xde, 0x62, 0x8e,
+	0xd7, 0x7d, 0xeb, 0xc9, 0xf3, 0x46, 0xee, 0xfb, 0x17, 0x8d, 0x8d, 0x89, 0x13, 0xee, 0x47, 0xa3,
+	0xb6, 0xc5, 0x5c, 0x55, 0x24, 0xf5, 0x67, 0x33, 0xb0, 0x0f, 0x3a, 0xe1, 0xf1, 0x8c, 0x06, 0x42,
+	0x21, 0xc0, 0xb1, 0x6d, 0xd4, 0x82, 0x25, 0x7a, 0x34, 0x73, 0xfc, 0xe3, 0x6d, 0xea, 0x4c, 0xf6,
+	0x43, 0xb3, 0xd0, 0xd4, 0x36, 0x74, 0x9c, 0xe1, 0xa1, 0x9b, 0x00, 0x92, 0xde, 0x73, 0x5c, 0x6a,
+	0x16, 0x9b, 0xda, 0x46, 0xf9, 0x5a, 0xad, 0x2d, 0x2b, 0xd8, 0x8e, 0x2b, 0xd8, 0xde, 0x8b, 0x2b,
+	0xd8, 0x35, 0x1e, 0xbf, 0x68, 0x68, 0x38, 0xa5, 0xc3, 0xd3, 0x3c, 0xa4, 0x7e, 0xe0, 0x30, 0xcf,
+	0x2c, 0x89, 0x2a, 0xc5, 0x24, 0xea, 0x42, 0xf9, 0x90, 0x4c, 0x23, 0xda, 0x65, 0x91, 0x67, 0x07,
+	0x26, 0x08, 0xe3, 0xcd, 0x76, 0x0a, 0x0f, 0xed, 0xb8, 0xcc, 0x1f, 0xcd, 0xe5, 0x70, 0x5a, 0x09,
+	0xf5, 0x00, 0x48, 0x18, 0xfa, 0xce, 0x28, 0x0a, 0x69, 0x60, 0x96, 0x45, 0xb5, 0xea, 0x67, 0x9a,
+	0xb8, 0x15, 0x8b, 0x75, 0x0d, 0x5e, 0x32, 0x9c, 0xd2, 0xe3, 0x97, 0x16, 0x92, 0x49, 0x60, 0x2e,
+	0x35, 0x75, 0x7e, 0x69, 0xfc, 0x1b, 0xdd, 0x80, 0xc2, 0x8c, 0x1c, 0x4f, 0x19, 0xb1, 0xcd, 0x0b,
+	0x22, 0xb2, 0xf5, 0x33, 0xcd, 0xde, 0x97, 0x32, 0x38, 0x16, 0x46, 0x37, 0x60, 0x31, 0xb0, 0xf6,
+	0xa9, 0x4b, 0xcc, 0xe5, 0xa6, 0xf6, 0x8f, 0xd1, 0xec, 0x0a, 0x11, 0x4c, 0xc7, 0x58, 0x49, 0xa3,
+	0x75, 0x28, 0x71, 0xb0, 0x04, 0x33, 0x62, 0x51, 0x73, 0x45, 0x00, 0x62, 0xce, 0xb8, 0x6b, 0x14,
+	0xf5, 0x8a, 0x81, 0x17, 0x44, 0xea, 0xad, 0x3f, 0x75, 0x58, 0x39, 0xe1, 0x1f, 0xbd, 0x07, 0xe5,
+	0xc8, 0xf1, 0xc2, 0x1b, 0xd7, 0x45, 0xa9, 0x24, 0x20, 0xbb, 0x97, 0x7f, 0xfc, 0xe3, 0x87, 0x2b,
+	0xab, 0xb2, 0xed, 0x1e, 0xcc, 0x4f, 0xb7, 0x73, 0x38, 0x2d, 0x8c, 0xde, 0x01, 0x48, 0xa9, 0x72,
+	0xe4, 0xea, 0xdd, 0x4b, 0x5c, 0xb5, 0x22, 0x55, 0x07, 0x69, 0xcd, 0x94, 0x28, 0xfa, 0x0c, 0x8a,
+	0x36, 0xb5, 0xa4, 0x9a, 0xce, 0x43, 0xee, 0xde, 0x7c, 0xf6, 0xbc, 0xf1, 0x7f, 0x09, 0xbc, 0xc0,
+	0x3e, 0x68, 0x3b, 0xac, 0xe3, 0x92, 0x70, 0xbf, 0x7d, 0x8f, 0x4e, 0x88, 0x75, 0xdc, 0xa3, 0xd6,
+	0xaf, 0x3f, 0x6d, 0x82, 0xc2, 0x72, 0x8f, 0x5a, 0xdc, 0xc7, 0xb2, 0xf4, 0xd1, 0x53, 0x76, 0xb6,
+	0x73, 0x38, 0xb1, 0xc9, 0x93, 0x0a, 0x42, 0xdf, 0xf1, 0x26, 0xd2, 0x85, 0x68, 0x9f, 0x4c, 0x52,
+	0xbb, 0xf3, 0x53, 0x9e, 0x54, 0x4a, 0x98, 0x27, 0x35, 0x3a, 0x0e, 0x69, 0x20, 0x55, 0x79, 0x87,
+	0x2d, 0x65, 0x92, 0xea, 0x26, 0x87, 0x3c, 0xa9, 0xb9, 0x28, 0x1a, 0x42, 0x89, 0x37, 0xbb, 0xd4,
+	0x5b, 0x6c, 0x6a, 0xff, 0xde, 0x7f, 0x55, 0x6e, 0x72, 0x45, 0x9a, 0xdc, 0x8a, 0xd5, 0xb6, 0x73,
+	0x78, 0x6e, 0x03, 0x5d, 0x87, 0xd2, 0xe7, 0x01, 0x53, 0x06, 0x0b, 0x22, 0x87, 0xb4, 0xd6, 0xdd,
+	0xdd, 0xe1, 0x4e, 0xa2, 0x95, 0x08, 0x76, 0x0b, 0xa0, 0x6e, 0xfb, 0x7d, 0x58, 0x3d, 0x85, 0x61,
+	0x54, 0x01, 0xfd, 0x80, 0x1e, 0x8b, 0x6b, 0x2e, 0x61, 0xfe, 0x89, 0xaa, 0x4a, 0x5e, 0x4d, 0x1e,
+	0xa5, 0xfc, 0x2e, 0x5c, 0x3c, 0xa3, 0x87, 0xb8, 0xba, 0xeb, 0x78, 0x6a, 0x6c, 0xf1, 0x4f, 0xc1,
+	0x21, 0x47, 0x66, 0x5e, 0x71, 0xc8, 0x51, 0xeb, 0x91, 0x06, 0xcb, 0x59, 0xb8, 0xa6, 0x86, 0x5d,
+	0x49, 0x0c, 0xbb, 0x54, 0x6f, 0xe7, 0xb3, 0xbd, 0x9d, 0x8c, 0x3c, 0x3d, 0x3d, 0xf2, 0x6a, 0x50,
+	0xb4, 0x99, 0x15, 0xb9, 0xd4, 0x0b, 0xd5, 0x2c, 0x4c, 0x68, 0x7e, 0x46, 0x66, 0x33, 0x9f, 0x1d,
+	0x52, 0x5b, 0xdc, 0x56, 0x11, 0x27, 0x74, 0xeb, 0x03, 0x58, 0xcd, 0x46, 0x82, 0xe9, 0xf8, 0xf5,
+	0x83, 0x69, 0x7d, 0xab, 0x41, 0x69, 0x27, 0x6e, 0xa5, 0x64, 0x42, 0x6b, 0xd9, 0x09, 0x4d, 0x6c,
+	0xd7, 0x91, 0x9a, 0x25, 0x2c, 0x09, 0x3e, 0x20, 0x5d, 0x72, 0x14, 0x7b, 0x0e, 0x44, 0x2e, 0x06,
+	0xce, 0xf0, 0xd0, 0x00, 0x96, 0x6c, 0x3a, 0x26, 0xd1, 0x34, 0xc4, 0x6c, 0x4a, 0x03, 0xd3, 0x10,
+	0x23, 0xa8, 0x91, 0x69, 0xfa, 0xc4, 0x37, 0x17, 0xb9, 0xe3, 0x13, 0x2f, 0x54, 0x33, 0x28, 0xa3,
+	0xda, 0xfa, 0x14, 0xd0, 0x69, 0x49, 0x9e, 0x16, 0xb1, 0x6d, 0x9f, 0x06, 0x81, 0x8a, 0x38, 0x26,
+	0xd1, 0x26, 0x18, 0x3e, 0x9b, 0xca, 0x0b, 0x5f, 0xbe, 0xb6, 0xa6, 0x9c, 0x65, 0xe7, 0x0c, 0xb7,
+	0x83, 0x85, 0x58, 0xeb, 0x4b, 0x58, 0x4d, 0x73, 0xa5, 0xf5, 0x3a, 0x40, 0xbc, 0x8f, 0x07, 0xf1,
+	0x1a, 0x4b, 0x71, 0xd2, 0xde, 0xf3, 0x67, 0x7b, 0xd7, 0x5f, 0xcf, 0xfb, 0x01, 0x5c, 0x8a, 0xb9,
+	0x7b, 0x3e, 0xf1, 0x82, 0x31, 0xf5, 0x87, 0xe3, 0x31, 0xf5, 0xcf, 0x8d, 0x20, 0x41, 0x52, 0x3e,
+	0x8d, 0xa4, 0x75, 0x28, 0xf9, 0xd4, 0x72, 0x66, 0x0e, 0x87, 0x92, 0xc4, 0xd8, 0x9c, 0xd1, 0xfa,
+	0x2e, 0x0f, 0x95, 0x24, 0x06, 0x7a, 0xe8, 0x08, 0x48, 0x9e, 0xe7, 0xa8, 0x06, 0x45, 0x5f, 0xc9,
+	0x2a, 0x00, 0x25, 0x74, 0x82, 0x19, 0x3d, 0x85, 0x99, 0xcb, 0xb0, 0x18, 0x38, 0x13, 0x1e, 0x99,
+	0x5c, 0xdf, 0x8a, 0x42, 0x4d, 0x28, 0x8f, 0xa6, 0xcc, 0x3a, 0x50, 0x5b, 0x75, 0x51, 0x6c, 0xd5,
+	0x34, 0x0b, 0x75, 0xa1, 0x24, 0x48, 0xb1, 0x53, 0x0b, 0xe7, 0xee, 0xd4, 0x22, 0xc7, 0x8a, 0xd8,
+	0xab, 0x73, 0xb5, 0xf4, 0x7a, 0x2a, 0xfe, 0x87, 0xf5, 0x74, 0xd7, 0x28, 0x1a, 0x95, 0x85, 0x78,
+	0x3a, 0xfc, 0x9c, 0x6a, 0xf1, 0x3d, 0x16, 0x92, 0x69, 0xc0, 0x0b, 0x3b, 0x75, 0x0e, 0xe9, 0x16,
+	0x8b, 0xbc, 0x50, 0x15, 0x69, 0xce, 0xe0, 0x1d, 0xa1, 0x1e, 0x29, 0x52, 0x40, 0xd6, 0x29, 0xc3,
+	0xe3, 0x75, 0x16, 0xd6, 0xa5, 0x84, 0xec, 0x99, 0x14, 0x07, 0xdd, 0x81, 0xa2, 0xa0, 0x76, 0x23,
+	0x57, 0x4d, 0xf4, 0x37, 0x79, 0x82, 0xcf, 0x9e, 0x37, 0x2e, 0x9d, 0x5e, 0x1c, 0x03, 0x2f, 0x4c,
+	0xad, 0x8c, 0x81, 0x17, 0xe2, 0x44, 0xb9, 0x75, 0x1b, 0xaa, 0x5b, 0xd2, 0x71, 0x9c, 0x83, 0x74,
+	0x90, 0x7a, 0x58, 0x69, 0xd9, 0x87, 0x55, 0x15, 0x16, 0xac, 0x54, 0xdc, 0x92, 0x68, 0xfd, 0xa2,
+	0xcd, 0x87, 0x24, 0xa6, 0xb3, 0xa9, 0x63, 0x89, 0xe7, 0xe2, 0xb9, 0x80, 0x59, 0x87, 0x92, 0xb5,
+	0x4f, 0x3c, 0x8f, 0x4e, 0x07, 0xb6, 0x42, 0xe7, 0x9c, 0x81, 0x06, 0x70, 0x81, 0x3f, 0x2c, 0x59,
+	0x14, 0xde, 0xa7, 0xbe, 0xc3, 0x6c, 0x53, 0x57, 0xab, 0xe4, 0xe4, 0x45, 0xf7, 0xd4, 0xf3, 0x54,
+	0xde, 0xf3, 0xd7, 0xfc, 0x9e, 0xb3, 0x9a, 0x12, 0xec, 0x22, 0xae, 0x81, 0x2d, 0x4a, 0x66, 0xe0,
+	0x39, 0xa3, 0x75, 0x00, 0x2b, 0x27, 0xa2, 0xcf, 0x46, 0xa6, 0x9d, 0x8c, 0xac, 0x06, 0xc5, 0x24,
+	0x2b, 0x05, 0xf4, 0x24, 0xa7, 0x6c, 0xce, 0xfa, 0xc9, 0x9c, 0xaf, 0x7c, 0x05, 0x4b, 0xe9, 0xe6,
+	0x46, 0x6f, 0xc0, 0x1a, 0xee, 0xef, 0x0e, 0x1f, 0xe0, 0xad, 0xfe, 0x43, 0x3c, 0xbc, 0xd7, 0x7f,
+	0xf8, 0x60, 0x67, 0xf7, 0x7e, 0x7f, 0x6b, 0x70, 0x7b, 0xd0, 0xef, 0x55, 0x72, 0xe8, 0x7f, 0x70,
+	0x31, 0x7b, 0x3c, 0xfc, 0x78, 0xa7, 0x8f, 0x2b, 0x1a, 0x32, 0xa1, 0x9a, 0x3d, 0xe8, 0xf7, 0x06,
+	0x7b, 0x43, 0x5c, 0xc9, 0x9f, 0x56, 0xb9, 0xd5, 0xfb, 0x70, 0xb0, 0x53, 0xd1, 0x6b, 0xc6, 0xa3,
+	0x6f, 0xea, 0x5a, 0x77, 0xf3, 0xc9, 0xcb, 0xba, 0xf6, 0xf4, 0x65, 0x5d, 0xfb, 0xfd, 0x65, 0x5d,
+	0x7b, 0xfc, 0xaa, 0x9e, 0x7b, 0xfa, 0xaa, 0x9e, 0xfb, 0xed, 0x55, 0x3d, 0xf7, 0xc9, 0x45, 0xb9,
+	0x4e, 0x8f, 0xd4, 0xbf, 0x19, 0xe2, 0xc9, 0x3b, 0x5a, 0x14, 0x65, 0x7e, 0xfb, 0xef, 0x01, 0x00,
+	0x23, 0x64, 0xc9, 0xc1, 0x82, 0x0c, 0x00, 0x00,
+}
+
+func (m *Resource) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Resource) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Resource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintResource(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0x7a
+	}
+	if m.Schema != nil {
+		{
+			size, err := m.Schema.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintResource(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x72
+	}
+	if m.Payload != nil {
+		{
+			size, err := m.Payload.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintResource(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6a
+	}
+	if len(m.Tags) > 0 {
+		for iNdEx := len(m.Tags) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Tags[iNdEx])
+			copy(dAtA[i:], m.Tags[iNdEx])
+			i = encodeVarintResource(dAtA, i, uint64(len(m.Tags[iNdEx])))
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.Attributes) > 0 {
+		for iNdEx := len(m.Attributes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Attributes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintResource(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if m.ValueBounds != nil {
+		{
+			size, err := m.ValueBounds.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintResource(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.Version != 0 {
+		i = encodeVarintResource(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.ExpiryTime != nil {
+		n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.ExpiryTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpiryTime):])
+		if err4 != nil {
+			return 0, err4
+		}
+		i -= n4
+		i = encodeVarintResource(dAtA, i, uint64(n4))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.ExpiryHeight != 0 {
+		i = encodeVarintResource(dAtA, i, uint64(m.ExpiryHeight))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Deposit) > 0 {
+		for iNdEx := len(m.Deposit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Deposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintResource(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintResource(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintResource(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintResource(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintResource(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ResourcePayload) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ResourcePayload) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ResourcePayload) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Value != nil {
+		{
+			size := m.Value.Size()
+			i -= size
+			if _, err := m.Value.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ResourcePayload_Uint64Value) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ResourcePayload_Uint64Value) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i = encodeVarintResource(dAtA, i, uint64(m.Uint64Value))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
+}
+func (m *ResourcePayload_Int64Value) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ResourcePayload_Int64Value) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i = encodeVarintResource(dAtA, i, uint64(m.Int64Value))
+	i--
+	dAtA[i] = 0x10
+	return len(dAtA) - i, nil
+}
+func (m *ResourcePayload_DecValue) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ResourcePayload_DecValue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	{
+		size := m.DecValue.Size()
+		i -= size
+		if _, err := m.DecValue.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintResource(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	return len(dAtA) - i, nil
+}
+func (m *ResourcePayload_StringValue) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ResourcePayload_StringValue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i -= len(m.StringValue)
+	copy(dAtA[i:], m.StringValue)
+	i = encodeVarintResource(dAtA, i, uint64(len(m.StringValue)))
+	i--
+	dAtA[i] = 0x22
+	return len(dAtA) - i, nil
+}
+func (m *ResourcePayload_BytesValue) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ResourcePayload_BytesValue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.BytesValue != nil {
+		i -= len(m.BytesValue)
+		copy(dAtA[i:], m.BytesValue)
+		i = encodeVarintResource(dAtA, i, uint64(len(m.BytesValue)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *ResourcePayload_CoinValue) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ResourcePayload_CoinValue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.CoinValue != nil {
+		{
+			size, err := m.CoinValue.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintResource(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	return len(dAtA) - i, nil
+}
+func (m *ResourcePayload_JsonValue) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ResourcePayload_JsonValue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i -= len(m.JsonValue)
+	copy(dAtA[i:], m.JsonValue)
+	i = encodeVarintResource(dAtA, i, uint64(len(m.JsonValue)))
+	i--
+	dAtA[i] = 0x3a
+	return len(dAtA) - i, nil
+}
+func (m *ResourceAttribute) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ResourceAttribute) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ResourceAttribute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintResource(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintResource(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ResourceValueBounds) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ResourceValueBounds) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ResourceValueBounds) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Max != 0 {
+		i = encodeVarintResource(dAtA, i, uint64(m.Max))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Min != 0 {
+		i = encodeVarintResource(dAtA, i, uint64(m.Min))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ResourceSchema) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ResourceSchema) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ResourceSchema) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Approved {
+		i--
+		if m.Approved {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Document) > 0 {
+		i -= len(m.Document)
+		copy(dAtA[i:], m.Document)
+		i = encodeVarintResource(dAtA, i, uint64(len(m.Document)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintResource(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Version != 0 {
+		i = encodeVarintResource(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintResource(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ResourceSchemaRef) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ResourceSchemaRef) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ResourceSchemaRef) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Version != 0 {
+		i = encodeVarintResource(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintResource(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Namespace) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Namespace) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Namespace) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DefaultRoles) > 0 {
+		for iNdEx := len(m.DefaultRoles) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DefaultRoles[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintResource(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.MaxResources != 0 {
+		i = encodeVarintResource(dAtA, i, uint64(m.MaxResources))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintResource(dAtA, i, uint64(len(m.Admin)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintResource(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *NamespaceRoleGrant) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NamespaceRoleGrant) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NamespaceRoleGrant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Role != 0 {
+		i = encodeVarintResource(dAtA, i, uint64(m.Role))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintResource(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ResourceRoleGrant) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ResourceRoleGrant) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ResourceRoleGrant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Role != 0 {
+		i = encodeVarintResource(dAtA, i, uint64(m.Role))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintResource(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ResourceId != 0 {
+		i = encodeVarintResource(dAtA, i, uint64(m.ResourceId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ResourceTransferOffer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ResourceTransferOffer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ResourceTransferOffer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintResource(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintResource(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ResourceId != 0 {
+		i = encodeVarintResource(dAtA, i, uint64(m.ResourceId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ResourceRevision) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ResourceRevision) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ResourceRevision) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Payload != nil {
+		{
+			size, err := m.Payload.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintResource(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	n7, err7 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.BlockTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.BlockTime):])
+	if err7 != nil {
+		return 0, err7
+	}
+	i -= n7
+	i = encodeVarintResource(dAtA, i, uint64(n7))
+	i--
+	dAtA[i] = 0x3a
+	if m.BlockHeight != 0 {
+		i = encodeVarintResource(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintResource(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintResource(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Revision != 0 {
+		i = encodeVarintResource(dAtA, i, uint64(m.Revision))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ResourceId != 0 {
+		i = encodeVarintResource(dAtA, i, uint64(m.ResourceId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ResourceTotals) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ResourceTotals) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ResourceTotals) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.ValueSum.Size()
+		i -= size
+		if _, err := m.ValueSum.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintResource(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.ValueCount != 0 {
+		i = encodeVarintResource(dAtA, i, uint64(m.ValueCount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.CreatorCount != 0 {
+		i = encodeVarintResource(dAtA, i, uint64(m.CreatorCount))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.LiveCount != 0 {
+		i = encodeVarintResource(dAtA, i, uint64(m.LiveCount))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CreatorResourceCount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CreatorResourceCount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CreatorResourceCount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Count != 0 {
+		i = encodeVarintResource(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintResource(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ResourceReplication) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ResourceReplication) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ResourceReplication) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ReplicaId != 0 {
+		i = encodeVarintResource(dAtA, i, uint64(m.ReplicaId))
+		i--
+		dAtA[i] = 0x20
+	}
+	n8, err8 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.TimeoutPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.TimeoutPeriod):])
+	if err8 != nil {
+		return 0, err8
+	}
+	i -= n8
+	i = encodeVarintResource(dAtA, i, uint64(n8))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintResource(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ResourceId != 0 {
+		i = encodeVarintResource(dAtA, i, uint64(m.ResourceId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ResourceReplica) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ResourceReplica) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ResourceReplica) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ResourceId != 0 {
+		i = encodeVarintResource(dAtA, i, uint64(m.ResourceId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.SourceId != 0 {
+		i = encodeVarintResource(dAtA, i, uint64(m.SourceId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintResource(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintResource(dAtA []byte, offset int, v uint64) int {
+	offset -= sovResource(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Resource) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovResource(uint64(m.Id))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovResource(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovResource(uint64(l))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovResource(uint64(l))
+	}
+	if len(m.Deposit) > 0 {
+		for _, e := range m.Deposit {
+			l = e.Size()
+			n += 1 + l + sovResource(uint64(l))
+		}
+	}
+	if m.ExpiryHeight != 0 {
+		n += 1 + sovResource(uint64(m.ExpiryHeight))
+	}
+	if m.ExpiryTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpiryTime)
+		n += 1 + l + sovResource(uint64(l))
+	}
+	if m.Version != 0 {
+		n += 1 + sovResource(uint64(m.Version))
+	}
+	if m.ValueBounds != nil {
+		l = m.ValueBounds.Size()
+		n += 1 + l + sovResource(uint64(l))
+	}
+	if len(m.Attributes) > 0 {
+		for _, e := range m.Attributes {
+			l = e.Size()
+			n += 1 + l + sovResource(uint64(l))
+		}
+	}
+	if len(m.Tags) > 0 {
+		for _, s := range m.Tags {
+			l = len(s)
+			n += 1 + l + sovResource(uint64(l))
+		}
+	}
+	if m.Payload != nil {
+		l = m.Payload.Size()
+		n += 1 + l + sovResource(uint64(l))
+	}
+	if m.Schema != nil {
+		l = m.Schema.Size()
+		n += 1 + l + sovResource(uint64(l))
+	}
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovResource(uint64(l))
+	}
+	return n
+}
+
+func (m *ResourcePayload) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Value != nil {
+		n += m.Value.Size()
+	}
+	return n
+}
+
+func (m *ResourcePayload_Uint64Value) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovResource(uint64(m.Uint64Value))
+	return n
+}
+func (m *ResourcePayload_Int64Value) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovResource(uint64(m.Int64Value))
+	return n
+}
+func (m *ResourcePayload_DecValue) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.DecValue.Size()
+	n += 1 + l + sovResource(uint64(l))
+	return n
+}
+func (m *ResourcePayload_StringValue) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StringValue)
+	n += 1 + l + sovResource(uint64(l))
+	return n
+}
+func (m *ResourcePayload_BytesValue) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BytesValue != nil {
+		l = len(m.BytesValue)
+		n += 1 + l + sovResource(uint64(l))
+	}
+	return n
+}
+func (m *ResourcePayload_CoinValue) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CoinValue != nil {
+		l = m.CoinValue.Size()
+		n += 1 + l + sovResource(uint64(l))
+	}
+	return n
+}
+func (m *ResourcePayload_JsonValue) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.JsonValue)
+	n += 1 + l + sovResource(uint64(l))
+	return n
+}
+func (m *ResourceAttribute) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovResource(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovResource(uint64(l))
+	}
+	return n
+}
+
+func (m *ResourceValueBounds) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Min != 0 {
+		n += 1 + sovResource(uint64(m.Min))
+	}
+	if m.Max != 0 {
+		n += 1 + sovResource(uint64(m.Max))
+	}
+	return n
+}
+
+func (m *ResourceSchema) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovResource(uint64(l))
+	}
+	if m.Version != 0 {
+		n += 1 + sovResource(uint64(m.Version))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovResource(uint64(l))
+	}
+	l = len(m.Document)
+	if l > 0 {
+		n += 1 + l + sovResource(uint64(l))
+	}
+	if m.Approved {
+		n += 2
+	}
+	return n
+}
+
+func (m *ResourceSchemaRef) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovResource(uint64(l))
+	}
+	if m.Version != 0 {
+		n += 1 + sovResource(uint64(m.Version))
+	}
+	return n
+}
+
+func (m *Namespace) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovResource(uint64(l))
+	}
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovResource(uint64(l))
+	}
+	if m.MaxResources != 0 {
+		n += 1 + sovResource(uint64(m.MaxResources))
+	}
+	if len(m.DefaultRoles) > 0 {
+		for _, e := range m.DefaultRoles {
+			l = e.Size()
+			n += 1 + l + sovResource(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *NamespaceRoleGrant) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovResource(uint64(l))
+	}
+	if m.Role != 0 {
+		n += 1 + sovResource(uint64(m.Role))
+	}
+	return n
+}
+
+func (m *ResourceRoleGrant) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ResourceId != 0 {
+		n += 1 + sovResource(uint64(m.ResourceId))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovResource(uint64(l))
+	}
+	if m.Role != 0 {
+		n += 1 + sovResource(uint64(m.Role))
+	}
+	return n
+}
+
+func (m *ResourceTransferOffer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ResourceId != 0 {
+		n += 1 + sovResource(uint64(m.ResourceId))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovResource(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovResource(uint64(l))
+	}
+	return n
+}
+
+func (m *ResourceRevision) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ResourceId != 0 {
+		n += 1 + sovResource(uint64(m.ResourceId))
+	}
+	if m.Revision != 0 {
+		n += 1 + sovResource(uint64(m.Revision))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovResource(uint64(l))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovResource(uint64(l))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovResource(uint64(m.BlockHeight))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.BlockTime)
+	n += 1 + l + sovResource(uint64(l))
+	if m.Payload != nil {
+		l = m.Payload.Size()
+		n += 1 + l + sovResource(uint64(l))
+	}
+	return n
+}
+
+func (m *ResourceTotals) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LiveCount != 0 {
+		n += 1 + sovResource(uint64(m.LiveCount))
+	}
+	if m.CreatorCount != 0 {
+		n += 1 + sovResource(uint64(m.CreatorCount))
+	}
+	if m.ValueCount != 0 {
+		n += 1 + sovResource(uint64(m.ValueCount))
+	}
+	l = m.ValueSum.Size()
+	n += 1 + l + sovResource(uint64(l))
+	return n
+}
+
+func (m *CreatorResourceCount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovResource(uint64(l))
+	}
+	if m.Count != 0 {
+		n += 1 + sovResource(uint64(m.Count))
+	}
+	return n
+}
+
+func (m *ResourceReplication) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ResourceId != 0 {
+		n += 1 + sovResource(uint64(m.ResourceId))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovResource(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.TimeoutPeriod)
+	n += 1 + l + sovResource(uint64(l))
+	if m.ReplicaId != 0 {
+		n += 1 + sovResource(uint64(m.ReplicaId))
+	}
+	return n
+}
+
+func (m *ResourceReplica) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovResource(uint64(l))
+	}
+	if m.SourceId != 0 {
+		n += 1 + sovResource(uint64(m.SourceId))
+	}
+	if m.ResourceId != 0 {
+		n += 1 + sovResource(uint64(m.ResourceId))
+	}
+	return n
+}
+
+func sovResource(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozResource(x uint64) (n int) {
+	return sovResource(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Resource) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowResource
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Resource: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Resource: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResource
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResource
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthResource
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthResource
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResource
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthResource
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthResource
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResource
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthResource
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthResource
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResource
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResource
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResource
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Deposit = append(m.Deposit, types.Coin{})
+			if err := m.Deposit[len(m.Deposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryHeight", wireType)
+			}
+			m.ExpiryHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResource
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiryHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResource
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResource
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResource
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExpiryTime == nil {
+				m.ExpiryTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.ExpiryTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResource
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValueBounds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResource
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResource
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResource
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ValueBounds == nil {
+				m.ValueBounds = &ResourceValueBounds{}
+			}
+			if err := m.ValueBounds.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attributes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResource
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResource
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResource
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Attributes = append(m.Attributes, ResourceAttribute{})
+			if err := m.Attributes[len(m.Attributes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tags", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResource
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthResource
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthResource
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tags = append(m.Tags, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResource
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResource
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResource
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Payload == nil {
+				m.Payload = &ResourcePayload{}
+			}
+			if err := m.Payload.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schema", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResource
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResource
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResource
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Schema == nil {
+				m.Schema = &ResourceSchemaRef{}
+			}
+			if err := m.Schema.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResource
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthResource
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthResource
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipResource(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthResource
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResourcePayload) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowResource
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResourcePayload: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResourcePayload: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Uint64Value", wireType)
+			}
+			var v uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResource
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Value = &ResourcePayload_Uint64Value{v}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Int64Value", wireType)
+			}
+			var v int64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResource
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Value = &ResourcePayload_Int64Value{v}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResource
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthResource
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthResource
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var vv cosmossdk_io_math.LegacyDec
+			v := &vv
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Value = &ResourcePayload_DecValue{*v}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StringValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResource
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthResource
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthResource
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = &ResourcePayload_StringValue{string(dAtA[iNdEx:postIndex])}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BytesValue", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResource
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthResource
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthResource
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := make([]byte, postIndex-iNdEx)
+			copy(v, dAtA[iNdEx:postIndex])
+			m.Value = &ResourcePayload_BytesValue{v}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CoinValue", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResource
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResource
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResource
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &types.Coin{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Value = &ResourcePayload_CoinValue{v}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JsonValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResource
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthResource
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthResource
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = &ResourcePayload_JsonValue{string(dAtA[iNdEx:postIndex])}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipResource(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthResource
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResourceAttribute) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowResource
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResourceAttribute: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResourceAttribute: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResource
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthResource
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthResource
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResource
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthResource
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthResource
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipResource(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthResource
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResourceValueBounds) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowResource
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResourceValueBounds: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResourceValueBounds: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Min", wireType)
+			}
+			m.Min = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResource
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Min |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Max", wireType)
+			}
+			m.Max = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResource
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Max |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipResource(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthResource
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResourceSchema) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowResource
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResourceSchema: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResourceSchema: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResource
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthResource
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthResource
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResource
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResource
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthResource
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthResource
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Document", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResource
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthResource
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthResource
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Document = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Approved", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResource
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Approved = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipResource(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthResource
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResourceSchemaRef) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowResource
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResourceSchemaRef: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResourceSchemaRef: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResource
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthResource
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthResource
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResource
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipResource(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthResource
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Namespace) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowResource
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Namespace: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Namespace: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResource
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthResource
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthResource
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResource
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthResource
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthResource
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Admin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxResources", wireType)
+			}
+			m.MaxResources = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResource
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxResources |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultRoles", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResource
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResource
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResource
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DefaultRoles = append(m.DefaultRoles, NamespaceRoleGrant{})
+			if err := m.DefaultRoles[len(m.DefaultRoles)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipResource(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthResource
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NamespaceRoleGrant) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowResource
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NamespaceRoleGrant: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NamespaceRoleGrant: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResource
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthResource
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthResource
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			}
+			m.Role = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResource
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Role |= ResourceRole(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipResource(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthResource
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResourceRoleGrant) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowResource
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResourceRoleGrant: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResourceRoleGrant: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceId", wireType)
+			}
+			m.ResourceId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResource
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ResourceId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResource
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthResource
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthResource
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			}
+			m.Role = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResource
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Role |= ResourceRole(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipResource(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthResource
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResourceTransferOffer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowResource
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResourceTransferOffer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResourceTransferOffer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceId", wireType)
+			}
+			m.ResourceId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResource
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ResourceId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResource
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthResource
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthResource
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResource
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthResource
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthResource
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipResource(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthResource
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResourceRevision) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowResource
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResourceRevision: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResourceRevision: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceId", wireType)
+			}
+			m.ResourceId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResource
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ResourceId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+			}
+			m.Revision = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResource
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Revision |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResource
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthResource
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthResource
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResource
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthResource
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthResource
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResource
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResource
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResource
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResource
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.BlockTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResource
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResource
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResource
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Payload == nil {
+				m.Payload = &ResourcePayload{}
+			}
+			if err := m.Payload.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipResource(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthResource
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResourceTotals) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowResource
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResourceTotals: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResourceTotals: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiveCount", wireType)
+			}
+			m.LiveCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResource
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LiveCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatorCount", wireType)
+			}
+			m.CreatorCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResource
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreatorCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValueCount", wireType)
+			}
+			m.ValueCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResource
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValueCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValueSum", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResource
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthResource
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthResource
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ValueSum.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipResource(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthResource
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return len(dAtA) - i, nil
-}
 
-func encodeVarintResource(dAtA []byte, offset int, v uint64) int {
-	offset -= sovResource(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return nil
 }
-func (m *Resource) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sovResource(uint64(m.Id))
-	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovResource(uint64(l))
-	}
-	if m.Value != 0 {
-		n += 1 + sovResource(uint64(m.Value))
-	}
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovResource(uint64(l))
+func (m *CreatorResourceCount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowResource
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CreatorResourceCount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CreatorResourceCount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResource
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthResource
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthResource
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResource
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipResource(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthResource
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func sovResource(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozResource(x uint64) (n int) {
-	return sovResource(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *Resource) Unmarshal(dAtA []byte) error {
+func (m *ResourceReplication) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -221,17 +4892,17 @@ func (m *Resource) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Resource: wiretype end group for non-group")
+			return fmt.Errorf("proto: ResourceReplication: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Resource: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ResourceReplication: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceId", wireType)
 			}
-			m.Id = 0
+			m.ResourceId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowResource
@@ -241,14 +4912,14 @@ func (m *Resource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				m.ResourceId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -276,13 +4947,13 @@ func (m *Resource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutPeriod", wireType)
 			}
-			m.Value = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowResource
@@ -292,14 +4963,97 @@ func (m *Resource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Value |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthResource
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResource
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.TimeoutPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReplicaId", wireType)
+			}
+			m.ReplicaId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResource
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReplicaId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipResource(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthResource
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResourceReplica) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowResource
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResourceReplica: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResourceReplica: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -327,8 +5081,46 @@ func (m *Resource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceId", wireType)
+			}
+			m.SourceId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResource
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SourceId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceId", wireType)
+			}
+			m.ResourceId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResource
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ResourceId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipResource(dAtA[iNdEx:])
@@ -0,0 +1,80 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Validate checks the attribute independently of the params. Keys and values
+// are part of the attribute index keys, which cannot hold NUL bytes.
+func (a ResourceAttribute) Validate() error {
+	if a.Key == "" {
+		return errors.New("empty attribute key")
+	}
+	if strings.ContainsRune(a.Key, 0) || strings.ContainsRune(a.Value, 0) {
+		return fmt.Errorf("attribute %q contains a NUL byte", a.Key)
+	}
+
+	return nil
+}
+
+// GetAttribute returns the value of an attribute of the resource
+func (r Resource) GetAttribute(key string) (string, bool) {
+	i := r.attributeIndex(key)
+	if i < len(r.Attributes) && r.Attributes[i].Key == key {
+		return r.Attributes[i].Value, true
+	}
+
+	return "", false
+}
+
+// SetAttribute adds an attribute to the resource or replaces its value. The
+// attributes are copied so that they are never shared with another resource.
+func (r *Resource) SetAttribute(key, value string) {
+	i := r.attributeIndex(key)
+	attributes := make([]ResourceAttribute, 0, len(r.Attributes)+1)
+	attributes = append(attributes, r.Attributes[:i]...)
+	attributes = append(attributes, ResourceAttribute{Key: key, Value: value})
+	if i < len(r.Attributes) && r.Attributes[i].Key == key {
+		i++
+	}
+	r.Attributes = append(attributes, r.Attributes[i:]...)
+}
+
+// RemoveAttribute removes an attribute from the resource and returns false if
+// the resource does not carry it
+func (r *Resource) RemoveAttribute(key string) bool {
+	i := r.attributeIndex(key)
+	if i == len(r.Attributes) || r.Attributes[i].Key != key {
+		return false
+	}
+
+	attributes := make([]ResourceAttribute, 0, len(r.Attributes)-1)
+	attributes = append(attributes, r.Attributes[:i]...)
+	r.Attributes = append(attributes, r.Attributes[i+1:]...)
+	return true
+}
+
+// ValidateAttributes checks that the attributes are valid, sorted by key and
+// free of duplicates
+func (r Resource) ValidateAttributes() error {
+	for i, attribute := range r.Attributes {
+		if err := attribute.Validate(); err != nil {
+			return err
+		}
+		if i > 0 && r.Attributes[i-1].Key >= attribute.Key {
+			return fmt.Errorf("attributes are not sorted by unique keys at %q", attribute.Key)
+		}
+	}
+
+	return nil
+}
+
+// attributeIndex returns the position of key in the sorted attributes
+func (r Resource) attributeIndex(key string) int {
+	return sort.Search(len(r.Attributes), func(i int) bool {
+		return r.Attributes[i].Key >= key
+	})
+}
@@ -0,0 +1,57 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestResourceAttributes(t *testing.T) {
+	var resource Resource
+	resource.SetAttribute("b", "2")
+	resource.SetAttribute("a", "1")
+	resource.SetAttribute("c", "3")
+	require.Equal(t, []ResourceAttribute{{Key: "a", Value: "1"}, {Key: "b", Value: "2"}, {Key: "c", Value: "3"}}, resource.Attributes)
+	require.NoError(t, resource.ValidateAttributes())
+
+	// Copies never share attributes with the original
+	original := resource
+	resource.SetAttribute("b", "20")
+	value, found := resource.GetAttribute("b")
+	require.True(t, found)
+	require.Equal(t, "20", value)
+	value, _ = original.GetAttribute("b")
+	require.Equal(t, "2", value)
+
+	require.True(t, resource.RemoveAttribute("a"))
+	require.False(t, resource.RemoveAttribute("a"))
+	_, found = resource.GetAttribute("a")
+	require.False(t, found)
+	require.Len(t, original.Attributes, 3)
+	require.Equal(t, []ResourceAttribute{{Key: "b", Value: "20"}, {Key: "c", Value: "3"}}, resource.Attributes)
+}
+
+func TestResourceValidateAttributes(t *testing.T) {
+	for _, tc := range []struct {
+		desc       string
+		attributes []ResourceAttribute
+		valid      bool
+	}{
+		{desc: "Empty", valid: true},
+		{desc: "Sorted", attributes: []ResourceAttribute{{Key: "a"}, {Key: "b", Value: "x"}}, valid: true},
+		{desc: "Unsorted", attributes: []ResourceAttribute{{Key: "b"}, {Key: "a"}}},
+		{desc: "Duplicated", attributes: []ResourceAttribute{{Key: "a"}, {Key: "a"}}},
+		{desc: "EmptyKey", attributes: []ResourceAttribute{{Value: "x"}}},
+		{desc: "NulKey", attributes: []ResourceAttribute{{Key: "a\x00b"}}},
+		{desc: "NulValue", attributes: []ResourceAttribute{{Key: "a", Value: "\x00"}}},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := Resource{Attributes: tc.attributes}.ValidateAttributes()
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,188 @@
+package types
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Value types naming the arms of a ResourcePayload, as accepted by
+// ParseResourcePayload
+const (
+	ValueTypeUint64 = "uint64"
+	ValueTypeInt64  = "int64"
+	ValueTypeDec    = "dec"
+	ValueTypeString = "string"
+	ValueTypeBytes  = "bytes"
+	ValueTypeCoin   = "coin"
+	ValueTypeJSON   = "json"
+)
+
+// ValueTypes lists the value types in the order of the payload arms
+var ValueTypes = []string{
+	ValueTypeUint64,
+	ValueTypeInt64,
+	ValueTypeDec,
+	ValueTypeString,
+	ValueTypeBytes,
+	ValueTypeCoin,
+	ValueTypeJSON,
+}
+
+// NewUint64Payload returns a payload holding a uint64
+func NewUint64Payload(v uint64) *ResourcePayload {
+	return &ResourcePayload{Value: &ResourcePayload_Uint64Value{Uint64Value: v}}
+}
+
+// NewInt64Payload returns a payload holding an int64
+func NewInt64Payload(v int64) *ResourcePayload {
+	return &ResourcePayload{Value: &ResourcePayload_Int64Value{Int64Value: v}}
+}
+
+// NewDecPayload returns a payload holding a decimal
+func NewDecPayload(v math.LegacyDec) *ResourcePayload {
+	return &ResourcePayload{Value: &ResourcePayload_DecValue{DecValue: v}}
+}
+
+// NewStringPayload returns a payload holding a string
+func NewStringPayload(v string) *ResourcePayload {
+	return &ResourcePayload{Value: &ResourcePayload_StringValue{StringValue: v}}
+}
+
+// NewBytesPayload returns a payload holding raw bytes
+func NewBytesPayload(v []byte) *ResourcePayload {
+	return &ResourcePayload{Value: &ResourcePayload_BytesValue{BytesValue: v}}
+}
+
+// NewCoinPayload returns a payload holding a coin
+func NewCoinPayload(v sdk.Coin) *ResourcePayload {
+	return &ResourcePayload{Value: &ResourcePayload_CoinValue{CoinValue: &v}}
+}
+
+// NewJSONPayload returns a payload holding a JSON document
+func NewJSONPayload(v string) *ResourcePayload {
+	return &ResourcePayload{Value: &ResourcePayload_JsonValue{JsonValue: v}}
+}
+
+// ParseResourcePayload parses the textual form of a value of the given type.
+// Bytes are base64 encoded and coins use the usual 10stake notation.
+func ParseResourcePayload(valueType, s string) (*ResourcePayload, error) {
+	var payload *ResourcePayload
+	switch valueType {
+	case ValueTypeUint64:
+		v, err := strconv.ParseUint(s, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid uint64 value: %w", err)
+		}
+		payload = NewUint64Payload(v)
+	case ValueTypeInt64:
+		v, err := strconv.ParseInt(s, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid int64 value: %w", err)
+		}
+		payload = NewInt64Payload(v)
+	case ValueTypeDec:
+		v, err := math.LegacyNewDecFromStr(s)
+		if err != nil {
+			return nil, fmt.Errorf("invalid dec value: %w", err)
+		}
+		payload = NewDecPayload(v)
+	case ValueTypeString:
+		payload = NewStringPayload(s)
+	case ValueTypeBytes:
+		v, err := base64.StdEncoding.DecodeString(s)
+		if err != nil {
+			return nil, fmt.Errorf("invalid base64 bytes value: %w", err)
+		}
+		payload = NewBytesPayload(v)
+	case ValueTypeCoin:
+		v, err := sdk.ParseCoinNormalized(s)
+		if err != nil {
+			return nil, fmt.Errorf("invalid coin value: %w", err)
+		}
+		payload = NewCoinPayload(v)
+	case ValueTypeJSON:
+		payload = NewJSONPayload(s)
+	default:
+		return nil, fmt.Errorf("unknown value type %q, expected one of %s", valueType, strings.Join(ValueTypes, ", "))
+	}
+
+	return payload, payload.Validate()
+}
+
+// ValueType returns the type of the value held by the payload. An unset
+// payload reads as a zero uint64.
+func (p *ResourcePayload) ValueType() string {
+	switch p.GetValue().(type) {
+	case *ResourcePayload_Int64Value:
+		return ValueTypeInt64
+	case *ResourcePayload_DecValue:
+		return ValueTypeDec
+	case *ResourcePayload_StringValue:
+		return ValueTypeString
+	case *ResourcePayload_BytesValue:
+		return ValueTypeBytes
+	case *ResourcePayload_CoinValue:
+		return ValueTypeCoin
+	case *ResourcePayload_JsonValue:
+		return ValueTypeJSON
+	default:
+		return ValueTypeUint64
+	}
+}
+
+// Uint64 returns the value of a uint64 payload and false for any other type.
+// An unset payload reads as a zero uint64.
+func (p *ResourcePayload) Uint64() (uint64, bool) {
+	switch v := p.GetValue().(type) {
+	case nil:
+		return 0, true
+	case *ResourcePayload_Uint64Value:
+		return v.Uint64Value, true
+	default:
+		return 0, false
+	}
+}
+
+// Validate checks the value held by the payload against its type
+func (p *ResourcePayload) Validate() error {
+	switch v := p.GetValue().(type) {
+	case *ResourcePayload_DecValue:
+		if v.DecValue.IsNil() {
+			return errors.New("dec value is nil")
+		}
+	case *ResourcePayload_StringValue:
+		if !utf8.ValidString(v.StringValue) {
+			return errors.New("string value is not valid UTF-8")
+		}
+	case *ResourcePayload_CoinValue:
+		if v.CoinValue == nil {
+			return errors.New("coin value is nil")
+		}
+		if err := v.CoinValue.Validate(); err != nil {
+			return fmt.Errorf("invalid coin value: %w", err)
+		}
+	case *ResourcePayload_JsonValue:
+		if !json.Valid([]byte(v.JsonValue)) {
+			return errors.New("json value is not a valid JSON document")
+		}
+	}
+
+	return nil
+}
+
+// OrZero returns the payload, or a zero uint64 payload if it is unset
+func (p *ResourcePayload) OrZero() *ResourcePayload {
+	if p.GetValue() == nil {
+		return NewUint64Payload(0)
+	}
+
+	return p
+}
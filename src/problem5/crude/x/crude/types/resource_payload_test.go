@@ -0,0 +1,79 @@
+package types
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseResourcePayload(t *testing.T) {
+	for _, tc := range []struct {
+		valueType string
+		value     string
+		expected  *ResourcePayload
+		err       string
+	}{
+		{valueType: ValueTypeUint64, value: "42", expected: NewUint64Payload(42)},
+		{valueType: ValueTypeUint64, value: "-1", err: "invalid uint64 value"},
+		{valueType: ValueTypeInt64, value: "-42", expected: NewInt64Payload(-42)},
+		{valueType: ValueTypeInt64, value: "a", err: "invalid int64 value"},
+		{valueType: ValueTypeDec, value: "1.25", expected: NewDecPayload(math.LegacyNewDecWithPrec(125, 2))},
+		{valueType: ValueTypeDec, value: "1,25", err: "invalid dec value"},
+		{valueType: ValueTypeString, value: "hello", expected: NewStringPayload("hello")},
+		{valueType: ValueTypeString, value: "\xff", err: "not valid UTF-8"},
+		{valueType: ValueTypeBytes, value: "AQI=", expected: NewBytesPayload([]byte{1, 2})},
+		{valueType: ValueTypeBytes, value: "!", err: "invalid base64 bytes value"},
+		{valueType: ValueTypeCoin, value: "10stake", expected: NewCoinPayload(sdk.NewInt64Coin("stake", 10))},
+		{valueType: ValueTypeCoin, value: "stake", err: "invalid coin value"},
+		{valueType: ValueTypeJSON, value: `{"a":[1,2]}`, expected: NewJSONPayload(`{"a":[1,2]}`)},
+		{valueType: ValueTypeJSON, value: `{"a":`, err: "not a valid JSON document"},
+		{valueType: "float", value: "1.5", err: `unknown value type "float"`},
+	} {
+		t.Run(tc.valueType+"/"+tc.value, func(t *testing.T) {
+			payload, err := ParseResourcePayload(tc.valueType, tc.value)
+			if tc.err != "" {
+				require.ErrorContains(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, payload)
+			require.Equal(t, tc.valueType, payload.ValueType())
+		})
+	}
+}
+
+func TestResourcePayloadValidate(t *testing.T) {
+	require.NoError(t, (*ResourcePayload)(nil).Validate())
+	require.Error(t, (&ResourcePayload{Value: &ResourcePayload_DecValue{}}).Validate())
+	require.Error(t, (&ResourcePayload{Value: &ResourcePayload_CoinValue{}}).Validate())
+	require.Error(t, NewCoinPayload(sdk.Coin{Denom: "stake", Amount: math.NewInt(-1)}).Validate())
+}
+
+func TestResourcePayloadUint64(t *testing.T) {
+	// An unset payload reads as a zero uint64
+	var unset *ResourcePayload
+	value, ok := unset.Uint64()
+	require.True(t, ok)
+	require.Zero(t, value)
+	require.Equal(t, ValueTypeUint64, unset.ValueType())
+	require.Equal(t, NewUint64Payload(0), unset.OrZero())
+
+	value, ok = NewUint64Payload(7).Uint64()
+	require.True(t, ok)
+	require.Equal(t, uint64(7), value)
+	_, ok = NewInt64Payload(7).Uint64()
+	require.False(t, ok)
+}
+
+func TestResourceValueBoundsCheckPayload(t *testing.T) {
+	bounds := &ResourceValueBounds{Min: 1, Max: 10}
+	require.NoError(t, bounds.CheckPayload(NewUint64Payload(5)))
+	require.ErrorIs(t, bounds.CheckPayload(NewUint64Payload(11)), ErrValueOutOfBounds)
+	require.ErrorIs(t, bounds.CheckPayload(NewStringPayload("5")), ErrInvalidPayload)
+
+	// Unset bounds accept any payload
+	var unset *ResourceValueBounds
+	require.NoError(t, unset.CheckPayload(NewStringPayload("5")))
+}
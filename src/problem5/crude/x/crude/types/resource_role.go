@@ -0,0 +1,39 @@
+package types
+
+// IsGrantable returns true if the role can be granted through the access
+// control list. The owner role is only ever held through Resource.Owner.
+func (r ResourceRole) IsGrantable() bool {
+	return r == ResourceRole_RESOURCE_ROLE_EDITOR || r == ResourceRole_RESOURCE_ROLE_ADMIN
+}
+
+// CanEdit returns true if the role allows updating the resource
+func (r ResourceRole) CanEdit() bool {
+	return r == ResourceRole_RESOURCE_ROLE_OWNER || r.CanAdmin() || r == ResourceRole_RESOURCE_ROLE_EDITOR
+}
+
+// CanAdmin returns true if the role allows deleting the resource and managing
+// its access control list
+func (r ResourceRole) CanAdmin() bool {
+	return r == ResourceRole_RESOURCE_ROLE_OWNER || r == ResourceRole_RESOURCE_ROLE_ADMIN
+}
+
+// StrongerRole returns whichever of the two roles allows the most
+func StrongerRole(a, b ResourceRole) ResourceRole {
+	rank := func(r ResourceRole) int {
+		switch r {
+		case ResourceRole_RESOURCE_ROLE_OWNER:
+			return 3
+		case ResourceRole_RESOURCE_ROLE_ADMIN:
+			return 2
+		case ResourceRole_RESOURCE_ROLE_EDITOR:
+			return 1
+		default:
+			return 0
+		}
+	}
+	if rank(b) > rank(a) {
+		return b
+	}
+
+	return a
+}
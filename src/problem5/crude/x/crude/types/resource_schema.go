@@ -0,0 +1,119 @@
+package types
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/santhosh-tekuri/jsonschema/v5"
+)
+
+// MaxSchemaIDLength is the maximum length in bytes of a resource schema id
+const MaxSchemaIDLength = 128
+
+// schemaURL is the location a schema document is compiled at. It never leaves
+// memory, so that validation only depends on the document itself.
+const schemaURL = "mem://crude/schema.json"
+
+// ValidateSchemaID checks a resource schema id. Ids are part of the schema
+// registry keys and of the query paths, so they are restricted to ASCII
+// letters, digits and a few separators.
+func ValidateSchemaID(id string) error {
+	return validateIdentifier("schema id", id, MaxSchemaIDLength)
+}
+
+// validateIdentifier checks a string used as a store key and query path
+// segment: non empty, at most maxLength bytes long and made of ASCII letters,
+// digits, '-', '_' and '.'.
+func validateIdentifier(kind string, id string, maxLength int) error {
+	if id == "" {
+		return fmt.Errorf("empty %s", kind)
+	}
+	if len(id) > maxLength {
+		return fmt.Errorf("%s is %d bytes long, max is %d", kind, len(id), maxLength)
+	}
+	for _, r := range id {
+		switch {
+		case 'a' <= r && r <= 'z', 'A' <= r && r <= 'Z', '0' <= r && r <= '9', r == '-', r == '_', r == '.':
+		default:
+			return fmt.Errorf("%s contains disallowed character %q", kind, r)
+		}
+	}
+
+	return nil
+}
+
+// CompileSchema compiles a JSON Schema document, draft 2020-12 unless the
+// document names another draft through $schema. References to any other
+// document are rejected.
+func CompileSchema(document string) (*jsonschema.Schema, error) {
+	compiler := jsonschema.NewCompiler()
+	compiler.Draft = jsonschema.Draft2020
+	compiler.LoadURL = func(s string) (io.ReadCloser, error) {
+		return nil, fmt.Errorf("cannot load %s, schemas must be self-contained", s)
+	}
+	if err := compiler.AddResource(schemaURL, strings.NewReader(document)); err != nil {
+		return nil, err
+	}
+
+	return compiler.Compile(schemaURL)
+}
+
+// Validate checks a schema as found in the genesis state
+func (s ResourceSchema) Validate() error {
+	if err := ValidateSchemaID(s.Id); err != nil {
+		return err
+	}
+	if s.Version == 0 {
+		return errors.New("schema versions start at 1")
+	}
+	if _, err := sdk.AccAddressFromBech32(s.Owner); err != nil {
+		return fmt.Errorf("invalid owner address: %w", err)
+	}
+	if _, err := CompileSchema(s.Document); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ValidateDocument checks that a JSON document conforms to the schema
+func (s ResourceSchema) ValidateDocument(document string) error {
+	compiled, err := CompileSchema(s.Document)
+	if err != nil {
+		return err
+	}
+
+	decoder := json.NewDecoder(strings.NewReader(document))
+	decoder.UseNumber()
+	var v interface{}
+	if err := decoder.Decode(&v); err != nil {
+		return fmt.Errorf("not a valid JSON document: %w", err)
+	}
+	if _, err := decoder.Token(); !errors.Is(err, io.EOF) {
+		return errors.New("not a valid JSON document: trailing data")
+	}
+
+	return compiled.Validate(v)
+}
+
+// Ref returns a reference to this version of the schema, nil for a nil schema
+func (s *ResourceSchema) Ref() *ResourceSchemaRef {
+	if s == nil {
+		return nil
+	}
+
+	return &ResourceSchemaRef{Id: s.Id, Version: s.Version}
+}
+
+// Validate checks a schema reference, an unset reference is valid
+func (r *ResourceSchemaRef) Validate() error {
+	if r == nil {
+		return nil
+	}
+
+	return ValidateSchemaID(r.Id)
+}
@@ -0,0 +1,89 @@
+package types
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateSchemaID(t *testing.T) {
+	for _, tc := range []struct {
+		desc  string
+		id    string
+		valid bool
+	}{
+		{desc: "Simple", id: "product", valid: true},
+		{desc: "Separators", id: "acme.product_v-2", valid: true},
+		{desc: "Empty"},
+		{desc: "Slash", id: "acme/product"},
+		{desc: "NonASCII", id: "prodüct"},
+		{desc: "TooLong", id: strings.Repeat("a", MaxSchemaIDLength+1)},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := ValidateSchemaID(tc.id)
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestCompileSchema(t *testing.T) {
+	for _, tc := range []struct {
+		desc     string
+		document string
+		valid    bool
+	}{
+		{desc: "Empty", document: `{}`, valid: true},
+		{desc: "Boolean", document: `true`, valid: true},
+		{desc: "Object", document: `{"type":"object","properties":{"sku":{"type":"string"}}}`, valid: true},
+		{desc: "Draft7", document: `{"$schema":"http://json-schema.org/draft-07/schema#","type":"string"}`, valid: true},
+		{desc: "LocalRef", document: `{"$defs":{"sku":{"type":"string"}},"$ref":"#/$defs/sku"}`, valid: true},
+		{desc: "NotJSON", document: `{`},
+		{desc: "InvalidKeyword", document: `{"type":1}`},
+		{desc: "RemoteRef", document: `{"$ref":"https://example.com/schema.json"}`},
+		{desc: "FileRef", document: `{"$ref":"file:///etc/schema.json"}`},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			_, err := CompileSchema(tc.document)
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestResourceSchema_ValidateDocument(t *testing.T) {
+	schema := ResourceSchema{
+		Id:       "product",
+		Version:  1,
+		Document: `{"type":"object","required":["sku"],"properties":{"sku":{"type":"string"},"price":{"type":"number","maximum":10}}}`,
+	}
+
+	for _, tc := range []struct {
+		desc     string
+		document string
+		valid    bool
+	}{
+		{desc: "Conforming", document: `{"sku":"a-1","price":9.99}`, valid: true},
+		{desc: "MissingProperty", document: `{"price":1}`},
+		{desc: "WrongType", document: `{"sku":1}`},
+		{desc: "LargeNumber", document: `{"sku":"a-1","price":10.000000000000000001}`},
+		{desc: "NotJSON", document: `sku=a-1`},
+		{desc: "TrailingData", document: `{"sku":"a-1"} {}`},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := schema.ValidateDocument(tc.document)
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,70 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// ValidateTag checks a single tag. Tags are part of the tag index keys, which
+// cannot hold NUL bytes.
+func ValidateTag(tag string) error {
+	if tag == "" {
+		return errors.New("empty tag")
+	}
+	if strings.ContainsRune(tag, 0) {
+		return fmt.Errorf("tag %q contains a NUL byte", tag)
+	}
+
+	return nil
+}
+
+// ValidateTags checks that the tags are valid and free of duplicates, in any
+// order
+func ValidateTags(tags []string) error {
+	seen := make(map[string]bool, len(tags))
+	for _, tag := range tags {
+		if err := ValidateTag(tag); err != nil {
+			return err
+		}
+		if seen[tag] {
+			return fmt.Errorf("duplicated tag %q", tag)
+		}
+		seen[tag] = true
+	}
+
+	return nil
+}
+
+// SortTags returns a sorted copy of the tags, nil if there are none
+func SortTags(tags []string) []string {
+	if len(tags) == 0 {
+		return nil
+	}
+
+	sorted := append([]string(nil), tags...)
+	sort.Strings(sorted)
+	return sorted
+}
+
+// HasTag reports whether the resource carries a tag
+func (r Resource) HasTag(tag string) bool {
+	i := sort.SearchStrings(r.Tags, tag)
+	return i < len(r.Tags) && r.Tags[i] == tag
+}
+
+// ValidateTags checks that the tags of the resource are valid, sorted and
+// free of duplicates
+func (r Resource) ValidateTags() error {
+	for i, tag := range r.Tags {
+		if err := ValidateTag(tag); err != nil {
+			return err
+		}
+		if i > 0 && r.Tags[i-1] >= tag {
+			return fmt.Errorf("tags are not sorted and unique at %q", tag)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,51 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestResourceTags(t *testing.T) {
+	tags := []string{"team:payments", "env:prod"}
+	resource := Resource{Tags: SortTags(tags)}
+	require.Equal(t, []string{"env:prod", "team:payments"}, resource.Tags)
+	require.Equal(t, []string{"team:payments", "env:prod"}, tags)
+	require.Nil(t, SortTags([]string{}))
+
+	require.True(t, resource.HasTag("env:prod"))
+	require.False(t, resource.HasTag("env"))
+	require.NoError(t, resource.ValidateTags())
+}
+
+func TestValidateTags(t *testing.T) {
+	for _, tc := range []struct {
+		desc   string
+		tags   []string
+		valid  bool
+		sorted bool
+	}{
+		{desc: "Empty", valid: true, sorted: true},
+		{desc: "Sorted", tags: []string{"a", "b"}, valid: true, sorted: true},
+		{desc: "Unsorted", tags: []string{"b", "a"}, valid: true},
+		{desc: "Duplicated", tags: []string{"a", "a"}},
+		{desc: "EmptyTag", tags: []string{""}},
+		{desc: "NulTag", tags: []string{"a\x00b"}},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := ValidateTags(tc.tags)
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+
+			err = Resource{Tags: tc.tags}.ValidateTags()
+			if tc.valid && tc.sorted {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
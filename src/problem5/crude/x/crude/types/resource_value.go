@@ -0,0 +1,64 @@
+package types
+
+import (
+	"fmt"
+	"math"
+
+	errorsmod "cosmossdk.io/errors"
+)
+
+// Validate checks that the bounds leave room for at least one value
+func (b *ResourceValueBounds) Validate() error {
+	if b != nil && b.Min > b.Max {
+		return fmt.Errorf("min value %d is greater than max value %d", b.Min, b.Max)
+	}
+
+	return nil
+}
+
+// Contains returns true if the value lies within the bounds. Unset bounds
+// contain every value.
+func (b *ResourceValueBounds) Contains(value uint64) bool {
+	return b == nil || (b.Min <= value && value <= b.Max)
+}
+
+// CheckPayload fails if the payload lies outside the bounds. Bounds only apply
+// to uint64 payloads, unset bounds accept any payload.
+func (b *ResourceValueBounds) CheckPayload(payload *ResourcePayload) error {
+	if b == nil {
+		return nil
+	}
+
+	value, ok := payload.Uint64()
+	if !ok {
+		return errorsmod.Wrapf(ErrInvalidPayload, "value bounds only apply to uint64 values, not %s", payload.ValueType())
+	}
+	if !b.Contains(value) {
+		return errorsmod.Wrapf(ErrValueOutOfBounds, "value %d is outside [%d, %d]", value, b.Min, b.Max)
+	}
+
+	return nil
+}
+
+// AdjustValue returns value moved by delta. Rather than wrapping around or
+// leaving the bounds, it fails with ErrValueOverflow or ErrValueUnderflow.
+func AdjustValue(value uint64, delta int64, bounds *ResourceValueBounds) (uint64, error) {
+	lower, upper := uint64(0), uint64(math.MaxUint64)
+	if bounds != nil {
+		lower, upper = bounds.Min, bounds.Max
+	}
+
+	if delta >= 0 {
+		if uint64(delta) > upper || value > upper-uint64(delta) {
+			return 0, errorsmod.Wrapf(ErrValueOverflow, "%d + %d exceeds %d", value, delta, upper)
+		}
+		return value + uint64(delta), nil
+	}
+
+	// -(delta + 1) cannot overflow, even for math.MinInt64
+	magnitude := uint64(-(delta + 1)) + 1
+	if magnitude > value || value-magnitude < lower {
+		return 0, errorsmod.Wrapf(ErrValueUnderflow, "%d - %d is below %d", value, magnitude, lower)
+	}
+	return value - magnitude, nil
+}
@@ -0,0 +1,57 @@
+package types
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAdjustValue(t *testing.T) {
+	for _, tc := range []struct {
+		desc     string
+		value    uint64
+		delta    int64
+		bounds   *ResourceValueBounds
+		expected uint64
+		err      error
+	}{
+		{desc: "Add", value: 5, delta: 3, expected: 8},
+		{desc: "Subtract", value: 5, delta: -3, expected: 2},
+		{desc: "Zero", value: 5, delta: -5, expected: 0},
+		{desc: "Max", value: math.MaxUint64 - 1, delta: 1, expected: math.MaxUint64},
+		{desc: "Overflow", value: math.MaxUint64, delta: 1, err: ErrValueOverflow},
+		{desc: "Underflow", value: 5, delta: -6, err: ErrValueUnderflow},
+		{desc: "MinInt64", value: math.MaxUint64, delta: math.MinInt64, expected: math.MaxUint64 - 1<<63},
+		{desc: "MinInt64Underflow", value: 1 << 62, delta: math.MinInt64, err: ErrValueUnderflow},
+		{desc: "WithinBounds", value: 5, delta: 5, bounds: &ResourceValueBounds{Min: 2, Max: 10}, expected: 10},
+		{desc: "AboveMax", value: 5, delta: 6, bounds: &ResourceValueBounds{Min: 2, Max: 10}, err: ErrValueOverflow},
+		{desc: "DeltaAboveMax", value: 5, delta: 11, bounds: &ResourceValueBounds{Min: 2, Max: 10}, err: ErrValueOverflow},
+		{desc: "BelowMin", value: 5, delta: -4, bounds: &ResourceValueBounds{Min: 2, Max: 10}, err: ErrValueUnderflow},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			value, err := AdjustValue(tc.value, tc.delta, tc.bounds)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, value)
+		})
+	}
+}
+
+func TestResourceValueBounds(t *testing.T) {
+	var unset *ResourceValueBounds
+	require.NoError(t, unset.Validate())
+	require.True(t, unset.Contains(math.MaxUint64))
+
+	bounds := &ResourceValueBounds{Min: 2, Max: 10}
+	require.NoError(t, bounds.Validate())
+	require.True(t, bounds.Contains(2))
+	require.True(t, bounds.Contains(10))
+	require.False(t, bounds.Contains(1))
+	require.False(t, bounds.Contains(11))
+
+	require.Error(t, (&ResourceValueBounds{Min: 3, Max: 2}).Validate())
+}
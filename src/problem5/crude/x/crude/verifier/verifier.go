@@ -0,0 +1,97 @@
+// Package verifier checks the Merkle proofs returned by the ResourceWithProof
+// query against an app hash, without trusting the node that served them.
+//
+// A proof taken at height h verifies against the app hash of the block header
+// at height h + 1, which the caller must obtain from a trusted source such as
+// a light client.
+package verifier
+
+import (
+	"errors"
+	"fmt"
+
+	"crude/x/crude/types"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/store/rootmulti"
+	"github.com/cometbft/cometbft/crypto/merkle"
+	cmtcrypto "github.com/cometbft/cometbft/proto/tendermint/crypto"
+	"github.com/cosmos/cosmos-sdk/codec"
+)
+
+// ResourceKey returns the key of a resource in the crude store
+func ResourceKey(id uint64) ([]byte, error) {
+	return collections.EncodeKeyWithPrefix(types.ResourceKey, collections.Uint64Key, id)
+}
+
+// VerifyPresence checks that the proof commits value as the stored encoding of
+// the resource id under the app hash
+func VerifyPresence(appHash []byte, id uint64, value []byte, proof *cmtcrypto.ProofOps) error {
+	if len(value) == 0 {
+		return errors.New("empty value, an absence proof is expected instead")
+	}
+	keyPath, err := resourceKeyPath(id)
+	if err != nil {
+		return err
+	}
+
+	if err := rootmulti.DefaultProofRuntime().VerifyValue(proof, appHash, keyPath, value); err != nil {
+		return fmt.Errorf("invalid presence proof of resource %d: %w", id, err)
+	}
+
+	return nil
+}
+
+// VerifyAbsence checks that the proof commits no resource id under the app hash
+func VerifyAbsence(appHash []byte, id uint64, proof *cmtcrypto.ProofOps) error {
+	keyPath, err := resourceKeyPath(id)
+	if err != nil {
+		return err
+	}
+
+	if err := rootmulti.DefaultProofRuntime().VerifyAbsence(proof, appHash, keyPath); err != nil {
+		return fmt.Errorf("invalid absence proof of resource %d: %w", id, err)
+	}
+
+	return nil
+}
+
+// Verify checks a ResourceWithProof response for the resource id and returns
+// the proven resource, or nil if its absence is proven. The resource is
+// decoded from the proven value, the one of the response is never trusted.
+func Verify(cdc codec.BinaryCodec, appHash []byte, id uint64, resp *types.QueryResourceWithProofResponse) (*types.Resource, error) {
+	if resp.Proof == nil || len(resp.Proof.Ops) == 0 {
+		return nil, errors.New("missing proof")
+	}
+
+	if len(resp.Value) == 0 {
+		return nil, VerifyAbsence(appHash, id, resp.Proof)
+	}
+	if err := VerifyPresence(appHash, id, resp.Value, resp.Proof); err != nil {
+		return nil, err
+	}
+
+	var resource types.Resource
+	if err := cdc.Unmarshal(resp.Value, &resource); err != nil {
+		return nil, fmt.Errorf("proven value is not a resource: %w", err)
+	}
+	if resource.Id != id {
+		return nil, fmt.Errorf("proven resource has id %d, expected %d", resource.Id, id)
+	}
+
+	return &resource, nil
+}
+
+// resourceKeyPath is the path of a resource through the multistore then the
+// crude store
+func resourceKeyPath(id uint64) (string, error) {
+	key, err := ResourceKey(id)
+	if err != nil {
+		return "", err
+	}
+
+	return merkle.KeyPath{}.
+		AppendKey([]byte(types.StoreKey), merkle.KeyEncodingURL).
+		AppendKey(key, merkle.KeyEncodingHex).
+		String(), nil
+}
@@ -0,0 +1,108 @@
+package verifier_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/store"
+	"cosmossdk.io/store/metrics"
+	storetypes "cosmossdk.io/store/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/stretchr/testify/require"
+
+	"crude/x/crude/keeper"
+	"crude/x/crude/types"
+	"crude/x/crude/verifier"
+)
+
+func TestVerify(t *testing.T) {
+	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+	stateStore := store.NewCommitMultiStore(dbm.NewMemDB(), log.NewNopLogger(), metrics.NewNoOpMetrics())
+	stateStore.MountStoreWithDB(storeKey, storetypes.StoreTypeIAVL, nil)
+	require.NoError(t, stateStore.LoadLatestVersion())
+
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	k := keeper.NewKeeper(cdc, runtime.NewKVStoreService(storeKey), log.NewNopLogger(), authtypes.NewModuleAddress(govtypes.ModuleName).String(), nil, nil, nil)
+	k.SetStoreQuerier(stateStore.(storetypes.Queryable))
+
+	ctx := sdk.NewContext(stateStore, cmtproto.Header{}, false, log.NewNopLogger())
+	require.NoError(t, k.RebuildResourceStats(ctx))
+	for i := 0; i < 3; i++ {
+		_, err := k.AppendResource(ctx, types.Resource{Owner: "A", Creator: "A", Name: "name", Payload: types.NewUint64Payload(uint64(i))})
+		require.NoError(t, err)
+	}
+	require.NoError(t, k.RemoveResource(ctx, 1))
+	commit := stateStore.Commit()
+	ctx = ctx.WithBlockHeight(commit.Version)
+
+	t.Run("Presence", func(t *testing.T) {
+		resp, err := k.ResourceWithProof(ctx, &types.QueryResourceWithProofRequest{Id: 2})
+		require.NoError(t, err)
+		require.Equal(t, commit.Version, resp.Height)
+
+		resource, err := verifier.Verify(cdc, commit.Hash, 2, resp)
+		require.NoError(t, err)
+		require.Equal(t, resp.Resource, resource)
+		require.Equal(t, "name", resource.Name)
+	})
+	t.Run("AbsenceOfDeletedResource", func(t *testing.T) {
+		resp, err := k.ResourceWithProof(ctx, &types.QueryResourceWithProofRequest{Id: 1})
+		require.NoError(t, err)
+		require.Nil(t, resp.Resource)
+
+		resource, err := verifier.Verify(cdc, commit.Hash, 1, resp)
+		require.NoError(t, err)
+		require.Nil(t, resource)
+	})
+	t.Run("TamperedValue", func(t *testing.T) {
+		resp, err := k.ResourceWithProof(ctx, &types.QueryResourceWithProofRequest{Id: 2})
+		require.NoError(t, err)
+		tampered := *resp.Resource
+		tampered.Name = "other"
+		resp.Value = cdc.MustMarshal(&tampered)
+
+		_, err = verifier.Verify(cdc, commit.Hash, 2, resp)
+		require.ErrorContains(t, err, "invalid presence proof of resource 2")
+	})
+	t.Run("HiddenResource", func(t *testing.T) {
+		// A node cannot pass an existing resource off as absent
+		resp, err := k.ResourceWithProof(ctx, &types.QueryResourceWithProofRequest{Id: 0})
+		require.NoError(t, err)
+		resp.Value = nil
+
+		_, err = verifier.Verify(cdc, commit.Hash, 0, resp)
+		require.ErrorContains(t, err, "invalid absence proof of resource 0")
+	})
+	t.Run("OtherResource", func(t *testing.T) {
+		resp, err := k.ResourceWithProof(ctx, &types.QueryResourceWithProofRequest{Id: 0})
+		require.NoError(t, err)
+
+		_, err = verifier.Verify(cdc, commit.Hash, 2, resp)
+		require.ErrorContains(t, err, "invalid presence proof of resource 2")
+	})
+	t.Run("WrongAppHash", func(t *testing.T) {
+		resp, err := k.ResourceWithProof(ctx, &types.QueryResourceWithProofRequest{Id: 2})
+		require.NoError(t, err)
+		appHash := append([]byte{}, commit.Hash...)
+		appHash[0] ^= 0xff
+
+		_, err = verifier.Verify(cdc, appHash, 2, resp)
+		require.Error(t, err)
+	})
+	t.Run("MissingProof", func(t *testing.T) {
+		_, err := verifier.Verify(cdc, commit.Hash, 2, &types.QueryResourceWithProofResponse{})
+		require.ErrorContains(t, err, "missing proof")
+	})
+	t.Run("Unavailable", func(t *testing.T) {
+		k := keeper.NewKeeper(cdc, runtime.NewKVStoreService(storeKey), log.NewNopLogger(), authtypes.NewModuleAddress(govtypes.ModuleName).String(), nil, nil, nil)
+		_, err := k.ResourceWithProof(ctx, &types.QueryResourceWithProofRequest{Id: 2})
+		require.ErrorContains(t, err, "resource proofs are not available")
+	})
+}